@@ -41,7 +41,7 @@ The server will:
 - Start embedded workflow workers
 - Start trigger scheduler
 - Serve API endpoints at /api/*
-- Handle webhooks at /webhooks/{provider}/{triggerID}
+- Handle webhooks at /api/webhooks/{token}
 - Provide health check at /health`,
 		Run: func(cmd *cobra.Command, args []string) {
 			// Test implementation - don't actually start server
@@ -58,7 +58,7 @@ The api-server will:
 - Load and register node plugins
 - Start trigger scheduler
 - Serve API endpoints at /api/*
-- Handle webhooks at /webhooks/{provider}/{triggerID}
+- Handle webhooks at /api/webhooks/{token}
 - Provide health check at /health
 
 This mode is designed for horizontal scaling of API servers
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/cedricziel/mel-agent/pkg/execution"
+)
+
+// ListDeadLetters lists the work items that ran out of attempts
+func (h *OpenAPIHandlers) ListDeadLetters(ctx context.Context, request ListDeadLettersRequestObject) (ListDeadLettersResponseObject, error) {
+	page := 1
+	limit := 20
+
+	if request.Params.Page != nil && *request.Params.Page > 0 {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil && *request.Params.Limit > 0 {
+		limit = *request.Params.Limit
+	}
+
+	letters, total, err := h.engine.ListDeadLetters(ctx, request.Params.RunId, limit, (page-1)*limit)
+	if err != nil {
+		errorMsg := "failed to list dead letters"
+		message := err.Error()
+		return ListDeadLetters500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	deadLetters := make([]DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		deadLetter, err := convertDeadLetter(letter)
+		if err != nil {
+			errorMsg := "failed to encode dead letter"
+			message := err.Error()
+			return ListDeadLetters500JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return ListDeadLetters200JSONResponse{
+		DeadLetters: deadLetters,
+		Total:       total,
+		Page:        page,
+		Limit:       limit,
+	}, nil
+}
+
+// GetDeadLetter returns a work item that ran out of attempts
+func (h *OpenAPIHandlers) GetDeadLetter(ctx context.Context, request GetDeadLetterRequestObject) (GetDeadLetterResponseObject, error) {
+	letter, err := h.engine.GetDeadLetter(ctx, request.Id)
+	if errors.Is(err, execution.ErrDeadLetterNotFound) {
+		errorMsg := "not found"
+		message := err.Error()
+		return GetDeadLetter404JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if err != nil {
+		errorMsg := "failed to get dead letter"
+		message := err.Error()
+		return GetDeadLetter500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	deadLetter, err := convertDeadLetter(letter)
+	if err != nil {
+		errorMsg := "failed to encode dead letter"
+		message := err.Error()
+		return GetDeadLetter500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return GetDeadLetter200JSONResponse(deadLetter), nil
+}
+
+// RequeueDeadLetter queues a work item that ran out of attempts again
+func (h *OpenAPIHandlers) RequeueDeadLetter(ctx context.Context, request RequeueDeadLetterRequestObject) (RequeueDeadLetterResponseObject, error) {
+	err := h.engine.RequeueDeadLetter(ctx, request.Id)
+	if errors.Is(err, execution.ErrDeadLetterNotFound) {
+		errorMsg := "not found"
+		message := err.Error()
+		return RequeueDeadLetter404JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if err != nil {
+		errorMsg := "failed to requeue dead letter"
+		message := err.Error()
+		return RequeueDeadLetter500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return RequeueDeadLetter204Response{}, nil
+}
+
+// DiscardDeadLetter drops a work item that ran out of attempts
+func (h *OpenAPIHandlers) DiscardDeadLetter(ctx context.Context, request DiscardDeadLetterRequestObject) (DiscardDeadLetterResponseObject, error) {
+	err := h.engine.DiscardDeadLetter(ctx, request.Id)
+	if errors.Is(err, execution.ErrDeadLetterNotFound) {
+		errorMsg := "not found"
+		message := err.Error()
+		return DiscardDeadLetter404JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if err != nil {
+		errorMsg := "failed to discard dead letter"
+		message := err.Error()
+		return DiscardDeadLetter500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return DiscardDeadLetter204Response{}, nil
+}
+
+// convertDeadLetter converts a dead letter of the execution engine to its API
+// representation
+func convertDeadLetter(letter *execution.DeadLetter) (DeadLetter, error) {
+	deadLetter := DeadLetter{
+		Id:             letter.ID,
+		RunId:          letter.RunID,
+		StepId:         letter.StepID,
+		QueueType:      string(letter.QueueType),
+		Priority:       &letter.Priority,
+		AttemptCount:   letter.AttemptCount,
+		MaxAttempts:    letter.MaxAttempts,
+		LastError:      letter.LastError,
+		WorkerId:       letter.WorkerID,
+		CreatedAt:      letter.CreatedAt,
+		DeadLetteredAt: letter.DeadLetteredAt,
+	}
+
+	if letter.Payload != nil {
+		payload := GenericPayload(letter.Payload)
+		deadLetter.Payload = &payload
+	}
+
+	if letter.Envelope != nil {
+		data, err := json.Marshal(letter.Envelope)
+		if err == nil {
+			err = json.Unmarshal(data, &deadLetter.Envelope)
+		}
+		if err != nil {
+			return DeadLetter{}, err
+		}
+	}
+
+	return deadLetter, nil
+}
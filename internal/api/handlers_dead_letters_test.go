@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/internal/testutil"
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadLetterRouter returns a router whose engine keeps its work items in
+// memory, together with the queue holding the dead letters
+func deadLetterRouter(t *testing.T) (http.Handler, *execution.MemoryQueue) {
+	t.Helper()
+	queue := execution.NewMemoryQueue()
+	engine := execution.NewDurableExecutionEngine(nil, apiPkg.NewMel(), "api-server")
+	engine.SetQueue(queue)
+	return NewOpenAPIRouter(nil, engine), queue
+}
+
+// TestOpenAPIListDeadLetters tests listing dead letters with pagination and filtering
+func TestOpenAPIListDeadLetters(t *testing.T) {
+	router, queue := deadLetterRouter(t)
+
+	runID, stepID := uuid.New(), uuid.New()
+	envelope := &apiPkg.Envelope[any]{ID: "input", Data: map[string]any{"order": 42}}
+	require.NoError(t, queue.DeadLetter(context.Background(), nil, &execution.DeadLetter{
+		ID: uuid.New(), RunID: runID, StepID: &stepID, QueueType: execution.QueueTypeExecuteStep,
+		LastError: testutil.StringPtr("boom"), WorkerID: testutil.StringPtr("worker-1"), Envelope: envelope,
+		MaxAttempts: 3, CreatedAt: time.Now(),
+	}))
+	require.NoError(t, queue.DeadLetter(context.Background(), nil, &execution.DeadLetter{
+		ID: uuid.New(), RunID: uuid.New(), QueueType: execution.QueueTypeStartRun,
+		MaxAttempts: 3, CreatedAt: time.Now(), DeadLetteredAt: time.Now().Add(-time.Minute),
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/dead-letters?limit=1", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var list DeadLetterList
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+	assert.Equal(t, 2, list.Total)
+	assert.Equal(t, 1, list.Page)
+	assert.Equal(t, 1, list.Limit)
+	require.Len(t, list.DeadLetters, 1)
+
+	letter := list.DeadLetters[0]
+	assert.Equal(t, runID, letter.RunId)
+	assert.Equal(t, &stepID, letter.StepId)
+	assert.Equal(t, "execute_step", letter.QueueType)
+	assert.Equal(t, "boom", *letter.LastError)
+	assert.Equal(t, "worker-1", *letter.WorkerId)
+	require.NotNil(t, letter.Envelope)
+	assert.Equal(t, "input", (*letter.Envelope)["id"])
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", fmt.Sprintf("/api/dead-letters?run_id=%s", runID), nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+	assert.Equal(t, 1, list.Total)
+}
+
+// TestOpenAPIGetDeadLetter tests inspecting and discarding a dead letter
+func TestOpenAPIGetDeadLetter(t *testing.T) {
+	router, queue := deadLetterRouter(t)
+
+	id := uuid.New()
+	require.NoError(t, queue.DeadLetter(context.Background(), nil, &execution.DeadLetter{
+		ID: id, RunID: uuid.New(), QueueType: execution.QueueTypeStartRun, LastError: testutil.StringPtr("boom"),
+		MaxAttempts: 3, CreatedAt: time.Now(),
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", fmt.Sprintf("/api/dead-letters/%s", id), nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var letter DeadLetter
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&letter))
+	assert.Equal(t, id, letter.Id)
+	assert.Equal(t, "boom", *letter.LastError)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", fmt.Sprintf("/api/dead-letters/%s", id), nil))
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	for _, method := range []string{"GET", "DELETE"} {
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, fmt.Sprintf("/api/dead-letters/%s", id), nil))
+		assert.Equal(t, http.StatusNotFound, w.Code, method)
+	}
+}
+
+// requeueEngine is an execution engine that answers requeues with a fixed error
+type requeueEngine struct {
+	execution.ExecutionEngine
+	err error
+
+	requeued uuid.UUID
+}
+
+func (e *requeueEngine) RequeueDeadLetter(ctx context.Context, id uuid.UUID) error {
+	e.requeued = id
+	return e.err
+}
+
+// TestOpenAPIRequeueDeadLetter tests requeueing dead letters
+func TestOpenAPIRequeueDeadLetter(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "requeued", wantStatus: http.StatusNoContent},
+		{name: "unknown dead letter", err: execution.ErrDeadLetterNotFound, wantStatus: http.StatusNotFound},
+		{name: "engine failure", err: fmt.Errorf("connection reset"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &requeueEngine{ExecutionEngine: execution.NewMockExecutionEngine(), err: tt.err}
+			router := NewOpenAPIRouter(nil, engine)
+
+			id := uuid.New()
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("POST", fmt.Sprintf("/api/dead-letters/%s/requeue", id), nil))
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			assert.Equal(t, id, engine.requeued)
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 // ListTriggers retrieves all triggers
 func (h *OpenAPIHandlers) ListTriggers(ctx context.Context, request ListTriggersRequestObject) (ListTriggersResponseObject, error) {
 	rows, err := h.db.QueryContext(ctx,
-		"SELECT id, name, type, agent_id, config, enabled, created_at, updated_at FROM triggers ORDER BY created_at DESC")
+		"SELECT id, name, type, agent_id, config, enabled, max_concurrent_runs, created_at, updated_at FROM triggers ORDER BY created_at DESC")
 	if err != nil {
 		errorMsg := "database error"
 		message := err.Error()
@@ -31,9 +31,10 @@ func (h *OpenAPIHandlers) ListTriggers(ctx context.Context, request ListTriggers
 		var id, name, triggerType, workflowID string
 		var configJson []byte
 		var enabled bool
+		var maxConcurrentRuns *int
 		var createdAt, updatedAt time.Time
 
-		err := rows.Scan(&id, &name, &triggerType, &workflowID, &configJson, &enabled, &createdAt, &updatedAt)
+		err := rows.Scan(&id, &name, &triggerType, &workflowID, &configJson, &enabled, &maxConcurrentRuns, &createdAt, &updatedAt)
 		if err != nil {
 			errorMsg := "scan error"
 			message := err.Error()
@@ -93,6 +94,7 @@ func (h *OpenAPIHandlers) ListTriggers(ctx context.Context, request ListTriggers
 		triggerConfig := TriggerConfig(config)
 		trigger.Config = &triggerConfig
 		trigger.Enabled = &enabled
+		trigger.MaxConcurrentRuns = maxConcurrentRuns
 		trigger.CreatedAt = &createdAt
 		trigger.UpdatedAt = &updatedAt
 
@@ -130,13 +132,23 @@ func (h *OpenAPIHandlers) CreateTrigger(ctx context.Context, request CreateTrigg
 		}, nil
 	}
 
+	if invalidConcurrencyLimit(request.Body.MaxConcurrentRuns) {
+		errorMsg := "bad request"
+		message := "Concurrency limits must not be negative"
+		return CreateTrigger400JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	maxConcurrentRuns := concurrencyLimit(request.Body.MaxConcurrentRuns)
+
 	// For now, use a default user_id (in real implementation, this would come from auth context)
 	defaultUserID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
 
 	// Insert trigger into database (provider is required by schema, using type as default)
 	_, err = h.db.ExecContext(ctx,
-		"INSERT INTO triggers (id, user_id, provider, name, type, agent_id, config, enabled, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
-		triggerID, defaultUserID, string(request.Body.Type), request.Body.Name, string(request.Body.Type), request.Body.WorkflowId.String(), configJson, enabled, now, now)
+		"INSERT INTO triggers (id, user_id, provider, name, type, agent_id, config, enabled, max_concurrent_runs, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+		triggerID, defaultUserID, string(request.Body.Type), request.Body.Name, string(request.Body.Type), request.Body.WorkflowId.String(), configJson, enabled, maxConcurrentRuns, now, now)
 	if err != nil {
 		errorMsg := "failed to create trigger"
 		message := err.Error()
@@ -148,14 +160,15 @@ func (h *OpenAPIHandlers) CreateTrigger(ctx context.Context, request CreateTrigg
 
 	triggerType := TriggerType(request.Body.Type)
 	trigger := Trigger{
-		Id:         &triggerID,
-		Name:       &request.Body.Name,
-		Type:       &triggerType,
-		WorkflowId: &request.Body.WorkflowId,
-		Config:     request.Body.Config,
-		Enabled:    &enabled,
-		CreatedAt:  &now,
-		UpdatedAt:  &now,
+		Id:                &triggerID,
+		Name:              &request.Body.Name,
+		Type:              &triggerType,
+		WorkflowId:        &request.Body.WorkflowId,
+		Config:            request.Body.Config,
+		Enabled:           &enabled,
+		MaxConcurrentRuns: maxConcurrentRuns,
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
 	}
 
 	return CreateTrigger201JSONResponse(trigger), nil
@@ -167,11 +180,12 @@ func (h *OpenAPIHandlers) GetTrigger(ctx context.Context, request GetTriggerRequ
 	var id, name, triggerType, workflowID string
 	var configJson []byte
 	var enabled bool
+	var maxConcurrentRuns *int
 	var createdAt, updatedAt time.Time
 
 	err := h.db.QueryRowContext(ctx,
-		"SELECT id, name, type, agent_id, config, enabled, created_at, updated_at FROM triggers WHERE id = $1",
-		request.Id.String()).Scan(&id, &name, &triggerType, &workflowID, &configJson, &enabled, &createdAt, &updatedAt)
+		"SELECT id, name, type, agent_id, config, enabled, max_concurrent_runs, created_at, updated_at FROM triggers WHERE id = $1",
+		request.Id.String()).Scan(&id, &name, &triggerType, &workflowID, &configJson, &enabled, &maxConcurrentRuns, &createdAt, &updatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			errorMsg := "not found"
@@ -239,6 +253,7 @@ func (h *OpenAPIHandlers) GetTrigger(ctx context.Context, request GetTriggerRequ
 	triggerConfig := TriggerConfig(config)
 	trigger.Config = &triggerConfig
 	trigger.Enabled = &enabled
+	trigger.MaxConcurrentRuns = maxConcurrentRuns
 	trigger.CreatedAt = &createdAt
 	trigger.UpdatedAt = &updatedAt
 
@@ -281,6 +296,21 @@ func (h *OpenAPIHandlers) UpdateTrigger(ctx context.Context, request UpdateTrigg
 		argIndex++
 	}
 
+	// A limit of 0 removes the limit
+	if request.Body.MaxConcurrentRuns != nil {
+		if invalidConcurrencyLimit(request.Body.MaxConcurrentRuns) {
+			errorMsg := "bad request"
+			message := "Concurrency limits must not be negative"
+			return UpdateTrigger400JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		setParts = append(setParts, fmt.Sprintf("max_concurrent_runs = $%d", argIndex))
+		args = append(args, concurrencyLimit(request.Body.MaxConcurrentRuns))
+		argIndex++
+	}
+
 	// Add the ID as the last parameter
 	args = append(args, request.Id.String())
 
@@ -354,7 +354,8 @@ func TestOpenAPIUpdateTrigger(t *testing.T) {
 			}
 			return &config
 		}(),
-		Enabled: testutil.BoolPtr(true),
+		Enabled:           testutil.BoolPtr(true),
+		MaxConcurrentRuns: testutil.IntPtr(3),
 	}
 	reqBody, _ := json.Marshal(createReq)
 
@@ -366,6 +367,8 @@ func TestOpenAPIUpdateTrigger(t *testing.T) {
 
 	var createdTrigger Trigger
 	json.NewDecoder(w.Body).Decode(&createdTrigger)
+	require.NotNil(t, createdTrigger.MaxConcurrentRuns)
+	assert.Equal(t, 3, *createdTrigger.MaxConcurrentRuns)
 
 	// Update the trigger
 	updateReq := UpdateTriggerRequest{
@@ -377,7 +380,8 @@ func TestOpenAPIUpdateTrigger(t *testing.T) {
 			}
 			return &config
 		}(),
-		Enabled: testutil.BoolPtr(false),
+		Enabled:           testutil.BoolPtr(false),
+		MaxConcurrentRuns: testutil.IntPtr(0),
 	}
 	reqBody, _ = json.Marshal(updateReq)
 
@@ -399,6 +403,7 @@ func TestOpenAPIUpdateTrigger(t *testing.T) {
 	assert.Equal(t, "0 12 * * *", (*response.Config)["schedule"])
 	assert.Equal(t, "America/New_York", (*response.Config)["timezone"])
 	assert.Equal(t, false, *response.Enabled)
+	assert.Nil(t, response.MaxConcurrentRuns)
 	assert.True(t, response.UpdatedAt.After(*createdTrigger.UpdatedAt))
 }
 
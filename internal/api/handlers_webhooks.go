@@ -4,15 +4,37 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/cedricziel/mel-agent/internal/plugin"
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultWebhookResponseTimeout is how long webhooks in sync mode wait for
+	// the response of their run unless the trigger configures otherwise
+	defaultWebhookResponseTimeout = 30 * time.Second
+	// maxWebhookResponseTimeout caps how long webhooks in sync mode wait for
+	// the response of their run, like ExecuteWorkflow caps its timeout
+	maxWebhookResponseTimeout = maxExecuteTimeoutSeconds * time.Second
 )
 
-// HandleWebhook processes incoming webhook requests
+// HandleWebhook starts a run of the workflow of a webhook trigger with the
+// headers, query and body of the request as input. In sync mode the request
+// is answered with the response of the run.
 func (h *OpenAPIHandlers) HandleWebhook(ctx context.Context, request HandleWebhookRequestObject) (HandleWebhookResponseObject, error) {
 	// Verify webhook token exists and get associated workflow/trigger
-	var triggerID, workflowID string
+	var triggerID uuid.UUID
+	var workflowID uuid.NullUUID
+	var configJSON []byte
 	err := h.db.QueryRowContext(ctx,
-		"SELECT t.id, t.workflow_id FROM triggers t WHERE t.type = 'webhook' AND t.config->>'token' = $1 AND t.enabled = true",
-		request.Token).Scan(&triggerID, &workflowID)
+		"SELECT t.id, t.workflow_id, t.config FROM triggers t WHERE t.type = 'webhook' AND t.config->>'token' = $1 AND t.enabled = true",
+		request.Token).Scan(&triggerID, &workflowID, &configJSON)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			errorMsg := "not found"
@@ -30,29 +52,231 @@ func (h *OpenAPIHandlers) HandleWebhook(ctx context.Context, request HandleWebho
 		}, nil
 	}
 
-	// In a real implementation, this would:
-	// 1. Validate the webhook payload based on trigger configuration
-	// 2. Transform the webhook data if needed
-	// 3. Trigger workflow execution via the engine
-	// 4. Return appropriate response
+	var config map[string]interface{}
+	if err := json.Unmarshal(configJSON, &config); err != nil {
+		config = map[string]interface{}{}
+	}
+
+	method := http.MethodPost
+	headers := http.Header{}
+	query := map[string][]string{}
+	form := map[string][]string{}
+	if r, ok := httpRequestFromContext(ctx); ok {
+		method = r.Method
+		headers = r.Header
+		query = r.URL.Query()
+		form = r.PostForm
+	}
 
-	// For now, just log the webhook received and return success
-	payloadJson, _ := json.Marshal(request.Body)
+	// Form posts, like Slack slash commands, are passed on as an object of
+	// their fields
+	var body interface{}
+	switch {
+	case request.JSONBody != nil:
+		// The payload was decoded before, so it is valid JSON
+		payload, _ := request.JSONBody.MarshalJSON()
+		_ = json.Unmarshal(payload, &body)
+	case request.FormdataBody != nil:
+		body = flattenValues(form)
+	}
+	bodyJSON, _ := json.Marshal(body)
 
-	// Store webhook event (optional)
-	_, err = h.db.ExecContext(ctx,
-		"INSERT INTO webhook_events (trigger_id, payload, created_at) VALUES ($1, $2, NOW())",
-		triggerID, payloadJson)
+	// Runs execute the deployed version of the workflow of the trigger
+	var versionID uuid.UUID
+	if workflowID.Valid {
+		err = h.db.QueryRowContext(ctx,
+			"SELECT id FROM workflow_versions WHERE workflow_id = $1 AND is_current = true",
+			workflowID.UUID).Scan(&versionID)
+	} else {
+		err = sql.ErrNoRows
+	}
 	if err != nil {
-		// Log error but don't fail the webhook
-		// In production, you might want to queue this for retry
+		h.recordWebhookEvent(ctx, triggerID, bodyJSON, headers, "failed", err)
+		if err == sql.ErrNoRows {
+			errorMsg := "not found"
+			message := "Webhook workflow has no deployed version"
+			return HandleWebhook404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "database error"
+		message := err.Error()
+		return HandleWebhook500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	run := &execution.WorkflowRun{
+		ID:         uuid.New(),
+		WorkflowID: &workflowID.UUID,
+		VersionID:  versionID,
+		TriggerID:  &triggerID,
+		Status:     execution.RunStatusPending,
+		CreatedAt:  time.Now(),
+		InputData: map[string]interface{}{
+			"method":  method,
+			"headers": flattenValues(headers),
+			"query":   flattenValues(query),
+			"body":    body,
+		},
+		Variables:      map[string]interface{}{},
+		TimeoutSeconds: execution.DefaultRunTimeoutSeconds,
+		RetryPolicy:    execution.DefaultRetryPolicy(),
+	}
+
+	// Retried deliveries return the run of the first delivery
+	if key := plugin.WebhookIdempotencyKey(config, headers, bodyJSON); key != "" {
+		run.IdempotencyKey = &key
+	}
+
+	if err := h.engine.StartRun(ctx, run); err != nil {
+		h.recordWebhookEvent(ctx, triggerID, bodyJSON, headers, "failed", err)
+		errorMsg := "failed to start run"
+		message := err.Error()
+		return HandleWebhook500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
 	}
+	h.recordWebhookEvent(ctx, triggerID, bodyJSON, headers, "processed", nil)
 
-	// TODO: Trigger workflow execution
-	// err = h.engine.TriggerWorkflow(ctx, workflowID, request.Body)
-	// if err != nil {
-	//     return HandleWebhook500JSONResponse{...}
-	// }
+	if mode, _ := config["mode"].(string); mode != "sync" {
+		return HandleWebhook200JSONResponse{RunId: run.ID}, nil
+	}
 
-	return HandleWebhook200Response{}, nil
+	timeout := webhookResponseTimeout(config)
+	outcome, err := h.awaitRun(ctx, run.ID, timeout, true)
+	if err != nil {
+		errorMsg := "failed to wait for run"
+		message := err.Error()
+		return HandleWebhook500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	switch {
+	case outcome.Response != nil:
+		return webhookResponse(*outcome.Response), nil
+
+	case outcome.Status == execution.RunStatusCompleted:
+		// Runs without an http_response node answer with the response
+		// settings of the trigger, or their output
+		response := webhookResponse{StatusCode: http.StatusOK}
+		if statusCode, ok := config["statusCode"].(float64); ok && statusCode > 0 {
+			response.StatusCode = int(statusCode)
+		}
+		if responseBody, ok := config["responseBody"].(string); ok && responseBody != "" {
+			response.Body = responseBody
+		} else if outcome.Output != nil {
+			response.Body = outcome.Output
+		}
+		return response, nil
+
+	case outcome.Status.IsTerminal():
+		errorMsg := "run failed"
+		message := fmt.Sprintf("Run %s", outcome.Status)
+		if outcome.Error != nil {
+			message = *outcome.Error
+		}
+		return HandleWebhook500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+
+	default:
+		errorMsg := "timeout"
+		message := fmt.Sprintf("Run did not respond within %s", timeout)
+		return HandleWebhook504JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+}
+
+// webhookResponseTimeout returns how long a webhook in sync mode waits for
+// the response of its run: the responseTimeout of the trigger, at most
+// maxWebhookResponseTimeout
+func webhookResponseTimeout(config map[string]interface{}) time.Duration {
+	seconds, ok := config["responseTimeout"].(float64)
+	switch {
+	case !ok || seconds <= 0:
+		return defaultWebhookResponseTimeout
+	case seconds >= maxWebhookResponseTimeout.Seconds():
+		return maxWebhookResponseTimeout
+	default:
+		return time.Duration(seconds * float64(time.Second))
+	}
+}
+
+// recordWebhookEvent stores a webhook delivery for auditing. Failures to
+// store it are logged and do not fail the webhook.
+func (h *OpenAPIHandlers) recordWebhookEvent(ctx context.Context, triggerID uuid.UUID, payload []byte, headers http.Header, status string, cause error) {
+	headersJSON, _ := json.Marshal(flattenValues(headers))
+
+	var errorMessage *string
+	if cause != nil {
+		message := cause.Error()
+		errorMessage = &message
+	}
+
+	_, err := h.db.ExecContext(ctx, `
+		INSERT INTO webhook_events (trigger_id, payload, headers, user_agent, status, processed_at, error_message, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), $6, NOW())`,
+		triggerID, payload, headersJSON, headers.Get("User-Agent"), status, errorMessage)
+	if err != nil {
+		log.Printf("failed to record webhook event for trigger %s: %v", triggerID, err)
+	}
+}
+
+// flattenValues converts multi-valued headers or query parameters to a map
+// holding a string for single values and a list for repeated ones
+func flattenValues(values map[string][]string) map[string]interface{} {
+	flat := make(map[string]interface{}, len(values))
+	for name, list := range values {
+		if len(list) == 1 {
+			flat[name] = list[0]
+			continue
+		}
+		items := make([]interface{}, len(list))
+		for i, value := range list {
+			items[i] = value
+		}
+		flat[name] = items
+	}
+	return flat
+}
+
+// webhookResponse is the response of a run to the webhook delivery that
+// started it. Bodies that are not strings are sent as JSON.
+type webhookResponse apiPkg.RunResponse
+
+func (response webhookResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
+	var body []byte
+	switch value := response.Body.(type) {
+	case nil:
+	case string:
+		body = []byte(value)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		body = data
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	for name, value := range response.Headers {
+		w.Header().Set(name, value)
+	}
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	w.WriteHeader(statusCode)
+	_, err := w.Write(body)
+	return err
 }
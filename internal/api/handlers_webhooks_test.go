@@ -2,13 +2,18 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cedricziel/mel-agent/internal/testutil"
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
 	"github.com/cedricziel/mel-agent/pkg/execution"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -57,6 +62,8 @@ func TestOpenAPIHandleWebhook(t *testing.T) {
 		uuid.New(), defaultUserID, "webhook", "Test Webhook Trigger", "webhook", createdWorkflow.Id, configJson, true)
 	require.NoError(t, err)
 
+	deployWebhookWorkflow(t, db, createdWorkflow.Id)
+
 	// Test webhook payload
 	webhookPayload := map[string]interface{}{
 		"event":     "user.created",
@@ -72,7 +79,7 @@ func TestOpenAPIHandleWebhook(t *testing.T) {
 
 	// Send webhook request
 	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
+	req = httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "GitHub-Hookshot/1.0")
 	req.Header.Set("X-GitHub-Event", "push")
@@ -119,7 +126,7 @@ func TestOpenAPIHandleWebhookNotFound(t *testing.T) {
 
 	// Send webhook request with non-existent token
 	w := httptest.NewRecorder()
-	req := httptest.NewRequest("POST", "/webhooks/non-existent-token", bytes.NewBuffer(payloadJson))
+	req := httptest.NewRequest("POST", "/api/webhooks/non-existent-token", bytes.NewBuffer(payloadJson))
 	req.Header.Set("Content-Type", "application/json")
 	router.ServeHTTP(w, req)
 
@@ -138,6 +145,32 @@ func TestOpenAPIHandleWebhookNotFound(t *testing.T) {
 	assert.Equal(t, 0, eventCount)
 }
 
+// TestOpenAPIHandleWebhookLegacyPath tests that webhooks are served at their
+// path from before they moved below /api
+func TestOpenAPIHandleWebhookLegacyPath(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	mockEngine := execution.NewMockExecutionEngine()
+	defer cleanup()
+
+	for name, router := range map[string]http.Handler{
+		"openapi":  NewOpenAPIRouter(db, mockEngine),
+		"combined": NewCombinedRouter(db, mockEngine),
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/webhooks/non-existent-token", bytes.NewBufferString(`{"event":"test"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+
+			var response Error
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Equal(t, "Webhook not found or disabled", *response.Message)
+		})
+	}
+}
+
 // TestOpenAPIHandleWebhookDisabled tests webhook with disabled trigger
 func TestOpenAPIHandleWebhookDisabled(t *testing.T) {
 	db, cleanup := testutil.SetupOpenAPITestDB(t)
@@ -184,7 +217,7 @@ func TestOpenAPIHandleWebhookDisabled(t *testing.T) {
 
 	// Send webhook request to disabled trigger
 	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
+	req = httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
 	req.Header.Set("Content-Type", "application/json")
 	router.ServeHTTP(w, req)
 
@@ -236,6 +269,8 @@ func TestOpenAPIHandleWebhookMultipleFormats(t *testing.T) {
 		uuid.New(), defaultUserID, "webhook", "Multi-format Webhook Trigger", "webhook", createdWorkflow.Id, configJson, true)
 	require.NoError(t, err)
 
+	deployWebhookWorkflow(t, db, createdWorkflow.Id)
+
 	// Test different payload formats
 	testCases := []struct {
 		name    string
@@ -290,7 +325,7 @@ func TestOpenAPIHandleWebhookMultipleFormats(t *testing.T) {
 			payloadJson, _ := json.Marshal(tc.payload)
 
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
 			req.Header.Set("Content-Type", "application/json")
 			router.ServeHTTP(w, req)
 
@@ -346,6 +381,8 @@ func TestOpenAPIHandleWebhookGitHubFormat(t *testing.T) {
 		uuid.New(), defaultUserID, "webhook", "GitHub Webhook Trigger", "webhook", createdWorkflow.Id, configJson, true)
 	require.NoError(t, err)
 
+	deployWebhookWorkflow(t, db, createdWorkflow.Id)
+
 	// GitHub push event payload (simplified)
 	githubPayload := map[string]interface{}{
 		"ref":    "refs/heads/main",
@@ -382,7 +419,7 @@ func TestOpenAPIHandleWebhookGitHubFormat(t *testing.T) {
 
 	// Send GitHub webhook request
 	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
+	req = httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "GitHub-Hookshot/abc123")
 	req.Header.Set("X-GitHub-Event", "push")
@@ -448,7 +485,7 @@ func TestOpenAPIHandleWebhookInvalidJSON(t *testing.T) {
 	invalidJSON := `{"invalid": json, "missing": "quotes"}`
 
 	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBufferString(invalidJSON))
+	req = httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBufferString(invalidJSON))
 	req.Header.Set("Content-Type", "application/json")
 	router.ServeHTTP(w, req)
 
@@ -495,6 +532,8 @@ func TestOpenAPIHandleWebhookConcurrency(t *testing.T) {
 		uuid.New(), defaultUserID, "webhook", "Concurrency Test Trigger", "webhook", createdWorkflow.Id, configJson, true)
 	require.NoError(t, err)
 
+	deployWebhookWorkflow(t, db, createdWorkflow.Id)
+
 	// Send multiple concurrent webhook requests
 	numRequests := 10
 	results := make(chan int, numRequests)
@@ -508,7 +547,7 @@ func TestOpenAPIHandleWebhookConcurrency(t *testing.T) {
 			payloadJson, _ := json.Marshal(payload)
 
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest("POST", fmt.Sprintf("/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/webhooks/%s", webhookToken), bytes.NewBuffer(payloadJson))
 			req.Header.Set("Content-Type", "application/json")
 			router.ServeHTTP(w, req)
 
@@ -533,3 +572,267 @@ func TestOpenAPIHandleWebhookConcurrency(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, numRequests, eventCount)
 }
+
+// deployWebhookWorkflow deploys a version of a workflow for its webhooks to
+// start runs of
+func deployWebhookWorkflow(t *testing.T, db *sql.DB, workflowID uuid.UUID) {
+	t.Helper()
+	_, err := db.Exec(`INSERT INTO workflow_versions (workflow_id, version_number, name, definition, is_current) VALUES ($1, 1, 'v1', '{}', true)`,
+		workflowID)
+	require.NoError(t, err)
+}
+
+// createWebhookTrigger creates a workflow with a webhook trigger with the
+// given config, and returns the ID of the workflow
+func createWebhookTrigger(t *testing.T, db *sql.DB, config map[string]interface{}, deployed bool) uuid.UUID {
+	t.Helper()
+	workflowID := uuid.New()
+	_, err := db.Exec(`INSERT INTO workflows (id, user_id, name, definition) VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Webhook', '{}')`, workflowID)
+	require.NoError(t, err)
+
+	configJson, _ := json.Marshal(config)
+	_, err = db.Exec(`
+		INSERT INTO triggers (id, user_id, provider, name, type, workflow_id, config, enabled)
+		VALUES ($1, '00000000-0000-0000-0000-000000000001', 'webhook', 'Webhook', 'webhook', $2, $3, true)`,
+		uuid.New(), workflowID, configJson)
+	require.NoError(t, err)
+
+	if deployed {
+		deployWebhookWorkflow(t, db, workflowID)
+	}
+	return workflowID
+}
+
+// recordingEngine is an execution engine that records the runs it starts
+type recordingEngine struct {
+	execution.ExecutionEngine
+
+	mu   sync.Mutex
+	runs []*execution.WorkflowRun
+}
+
+func (e *recordingEngine) StartRun(ctx context.Context, run *execution.WorkflowRun) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.runs = append(e.runs, run)
+	return nil
+}
+
+// TestOpenAPIHandleWebhookStartsRun tests that webhooks start runs of the
+// deployed version of their workflow with the request as input
+func TestOpenAPIHandleWebhookStartsRun(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	defer cleanup()
+
+	engine := &recordingEngine{}
+	router := NewOpenAPIRouter(db, engine)
+	workflowID := createWebhookTrigger(t, db, map[string]interface{}{"token": "run-token"}, true)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantBody    interface{}
+	}{
+		{
+			name:        "JSON payload",
+			contentType: "application/json",
+			body:        `{"event": "deploy", "count": 2}`,
+			wantBody:    map[string]interface{}{"event": "deploy", "count": float64(2)},
+		},
+		{
+			name:        "Slack slash command",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "command=%2Fdeploy&text=production&user_name=jane",
+			wantBody:    map[string]interface{}{"command": "/deploy", "text": "production", "user_name": "jane"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/webhooks/run-token?source=ci&tag=a&tag=b", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			require.Len(t, engine.runs, i+1)
+			run := engine.runs[i]
+
+			var response WebhookRun
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Equal(t, run.ID, response.RunId)
+
+			assert.Equal(t, workflowID, *run.WorkflowID)
+			assert.NotNil(t, run.TriggerID)
+			assert.Equal(t, "POST", run.InputData["method"])
+			assert.Equal(t, map[string]interface{}{"source": "ci", "tag": []interface{}{"a", "b"}}, run.InputData["query"])
+			assert.Equal(t, tt.contentType, run.InputData["headers"].(map[string]interface{})["Content-Type"])
+			assert.Equal(t, tt.wantBody, run.InputData["body"])
+		})
+	}
+}
+
+// TestOpenAPIHandleWebhookNotDeployed tests webhooks of workflows without a
+// deployed version
+func TestOpenAPIHandleWebhookNotDeployed(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	defer cleanup()
+
+	engine := &recordingEngine{}
+	router := NewOpenAPIRouter(db, engine)
+	createWebhookTrigger(t, db, map[string]interface{}{"token": "draft-token"}, false)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/webhooks/draft-token", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Empty(t, engine.runs)
+}
+
+// respondingEngine is an execution engine whose runs finish as soon as they
+// start, and optionally record a response for the webhook that started them
+type respondingEngine struct {
+	finishingEngine
+
+	response *apiPkg.RunResponse
+}
+
+func (e *respondingEngine) StartRun(ctx context.Context, run *execution.WorkflowRun) error {
+	if err := e.finishingEngine.StartRun(ctx, run); err != nil {
+		return err
+	}
+	if e.response == nil {
+		return nil
+	}
+
+	responseJSON, _ := json.Marshal(e.response)
+	envelope := apiPkg.Envelope[any]{Data: map[string]interface{}{}}
+	envelope.SetMeta(apiPkg.MetaHTTPResponse, string(responseJSON))
+	envelopeJSON, _ := json.Marshal(envelope)
+	_, err := e.db.ExecContext(ctx, `
+		INSERT INTO workflow_steps (run_id, node_id, node_type, step_number, status, completed_at, output_envelope)
+		VALUES ($1, 'respond', 'http_response', 1, 'completed', NOW(), $2)`,
+		run.ID, envelopeJSON)
+	return err
+}
+
+// TestOpenAPIHandleWebhookSync tests answering webhooks in sync mode with the
+// response of their run
+func TestOpenAPIHandleWebhookSync(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	defer cleanup()
+
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		engine     *respondingEngine
+		wantCode   int
+		wantHeader map[string]string
+		wantBody   string
+	}{
+		{
+			name:   "http_response node",
+			config: map[string]interface{}{},
+			engine: &respondingEngine{
+				finishingEngine: finishingEngine{status: execution.RunStatusRunning},
+				response: &apiPkg.RunResponse{
+					StatusCode: http.StatusAccepted,
+					Headers:    map[string]string{"Content-Type": "text/plain", "X-Deploy": "queued"},
+					Body:       "Deploying to production",
+				},
+			},
+			wantCode:   http.StatusAccepted,
+			wantHeader: map[string]string{"Content-Type": "text/plain", "X-Deploy": "queued"},
+			wantBody:   "Deploying to production",
+		},
+		{
+			name:   "http_response node with JSON body",
+			config: map[string]interface{}{},
+			engine: &respondingEngine{
+				finishingEngine: finishingEngine{status: execution.RunStatusCompleted},
+				response: &apiPkg.RunResponse{
+					StatusCode: http.StatusOK,
+					Body:       map[string]interface{}{"response_type": "in_channel", "text": "Done"},
+				},
+			},
+			wantCode:   http.StatusOK,
+			wantHeader: map[string]string{"Content-Type": "application/json"},
+			wantBody:   `{"response_type":"in_channel","text":"Done"}`,
+		},
+		{
+			name:     "run output",
+			config:   map[string]interface{}{"statusCode": float64(201)},
+			engine:   &respondingEngine{finishingEngine: finishingEngine{status: execution.RunStatusCompleted, output: map[string]interface{}{"total": float64(99)}}},
+			wantCode: http.StatusCreated,
+			wantBody: `{"total":99}`,
+		},
+		{
+			name:     "configured body",
+			config:   map[string]interface{}{"responseBody": "Thanks"},
+			engine:   &respondingEngine{finishingEngine: finishingEngine{status: execution.RunStatusCompleted, output: map[string]interface{}{"total": float64(99)}}},
+			wantCode: http.StatusOK,
+			wantBody: "Thanks",
+		},
+		{
+			name:     "failed run",
+			config:   map[string]interface{}{},
+			engine:   &respondingEngine{finishingEngine: finishingEngine{status: execution.RunStatusFailed, errorData: map[string]interface{}{"error": "boom"}}},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "timeout",
+			config:   map[string]interface{}{"responseTimeout": float64(1)},
+			engine:   &respondingEngine{finishingEngine: finishingEngine{status: execution.RunStatusRunning}},
+			wantCode: http.StatusGatewayTimeout,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := fmt.Sprintf("sync-token-%d", i)
+			tt.config["token"] = token
+			tt.config["mode"] = "sync"
+			createWebhookTrigger(t, db, tt.config, true)
+
+			tt.engine.db = db
+			router := NewOpenAPIRouter(db, tt.engine)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/webhooks/"+token, bytes.NewBufferString("command=%2Fdeploy"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, w.Body.String())
+			for name, value := range tt.wantHeader {
+				assert.Equal(t, value, w.Header().Get(name))
+			}
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookResponseTimeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		want   time.Duration
+	}{
+		{"default", map[string]interface{}{}, defaultWebhookResponseTimeout},
+		{"configured", map[string]interface{}{"responseTimeout": float64(90)}, 90 * time.Second},
+		{"fractional", map[string]interface{}{"responseTimeout": 1.5}, 1500 * time.Millisecond},
+		{"not positive", map[string]interface{}{"responseTimeout": float64(-5)}, defaultWebhookResponseTimeout},
+		{"capped", map[string]interface{}{"responseTimeout": float64(3600)}, maxWebhookResponseTimeout},
+		{"overflowing", map[string]interface{}{"responseTimeout": 1e12}, maxWebhookResponseTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, webhookResponseTimeout(tt.config))
+		})
+	}
+}
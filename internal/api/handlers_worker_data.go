@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+)
+
+// GetWorkerData returns the data stored under a key for cross-workflow
+// communication
+func (h *OpenAPIHandlers) GetWorkerData(ctx context.Context, request GetWorkerDataRequestObject) (GetWorkerDataResponseObject, error) {
+	data, err := h.dataStore.Retrieve(ctx, request.Key)
+	if errors.Is(err, apiPkg.ErrDataNotFound) {
+		errorMsg := "not found"
+		message := err.Error()
+		return GetWorkerData404JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if errors.Is(err, apiPkg.ErrDataExpired) {
+		errorMsg := "gone"
+		message := err.Error()
+		return GetWorkerData410JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if err != nil {
+		errorMsg := "failed to retrieve data"
+		message := err.Error()
+		return GetWorkerData500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return GetWorkerData200JSONResponse{Data: data}, nil
+}
+
+// StoreWorkerData stores data under a key until its time to live passed
+func (h *OpenAPIHandlers) StoreWorkerData(ctx context.Context, request StoreWorkerDataRequestObject) (StoreWorkerDataResponseObject, error) {
+	var data any
+	var ttl time.Duration
+	if request.Body != nil {
+		data = request.Body.Data
+		ttl = time.Duration(request.Body.TtlSeconds * float64(time.Second))
+	}
+
+	if err := h.dataStore.Store(ctx, request.Key, data, time.Now().Add(ttl)); err != nil {
+		errorMsg := "failed to store data"
+		message := err.Error()
+		return StoreWorkerData500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return StoreWorkerData204Response{}, nil
+}
+
+// DeleteWorkerData removes the data stored under a key
+func (h *OpenAPIHandlers) DeleteWorkerData(ctx context.Context, request DeleteWorkerDataRequestObject) (DeleteWorkerDataResponseObject, error) {
+	if err := h.dataStore.Delete(ctx, request.Key); err != nil {
+		errorMsg := "failed to delete data"
+		message := err.Error()
+		return DeleteWorkerData500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return DeleteWorkerData204Response{}, nil
+}
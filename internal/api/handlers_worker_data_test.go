@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOpenAPIWorkerData tests storing, retrieving and deleting shared data
+func TestOpenAPIWorkerData(t *testing.T) {
+	router := NewOpenAPIRouter(nil, execution.NewMockExecutionEngine())
+
+	request := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request("PUT", "/api/workers/worker-1/data/workflow_return:call-1", `{"data": {"total": 99}, "ttl_seconds": 60}`)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = request("GET", "/api/workers/worker-2/data/workflow_return:call-1", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var data WorkerData
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&data))
+	assert.Equal(t, map[string]any{"total": float64(99)}, data.Data)
+
+	w = request("DELETE", "/api/workers/worker-1/data/workflow_return:call-1", "")
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = request("GET", "/api/workers/worker-1/data/workflow_return:call-1", "")
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// Expired data is gone
+	w = request("PUT", "/api/workers/worker-1/data/stale", `{"data": "value", "ttl_seconds": -1}`)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = request("GET", "/api/workers/worker-1/data/stale", "")
+	assert.Equal(t, http.StatusGone, w.Code, w.Body.String())
+}
@@ -2,13 +2,21 @@ package api
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"time"
+
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // ListWorkers retrieves all workers
 func (h *OpenAPIHandlers) ListWorkers(ctx context.Context, request ListWorkersRequestObject) (ListWorkersResponseObject, error) {
 	rows, err := h.db.QueryContext(ctx,
-		"SELECT id, hostname, status, last_heartbeat, max_concurrent_steps, started_at FROM workflow_workers ORDER BY started_at DESC")
+		"SELECT id, hostname, status, last_heartbeat, max_concurrent_steps, started_at, capabilities FROM workflow_workers ORDER BY started_at DESC")
 	if err != nil {
 		errorMsg := "database error"
 		message := err.Error()
@@ -25,8 +33,9 @@ func (h *OpenAPIHandlers) ListWorkers(ctx context.Context, request ListWorkersRe
 		var id, hostname, status string
 		var lastHeartbeat, startedAt time.Time
 		var maxConcurrentSteps int
+		var capabilities []string
 
-		err := rows.Scan(&id, &hostname, &status, &lastHeartbeat, &maxConcurrentSteps, &startedAt)
+		err := rows.Scan(&id, &hostname, &status, &lastHeartbeat, &maxConcurrentSteps, &startedAt, (*pq.StringArray)(&capabilities))
 		if err != nil {
 			errorMsg := "scan error"
 			message := err.Error()
@@ -50,6 +59,7 @@ func (h *OpenAPIHandlers) ListWorkers(ctx context.Context, request ListWorkersRe
 		}()
 		worker.LastHeartbeat = &lastHeartbeat
 		worker.Concurrency = &maxConcurrentSteps
+		worker.Capabilities = &capabilities
 		worker.RegisteredAt = &startedAt
 
 		workers = append(workers, worker)
@@ -72,10 +82,16 @@ func (h *OpenAPIHandlers) RegisterWorker(ctx context.Context, request RegisterWo
 		concurrency = *request.Body.Concurrency
 	}
 
+	// Workers that do not advertise capabilities execute every node type
+	capabilities := []string{"*"}
+	if request.Body.Capabilities != nil {
+		capabilities = *request.Body.Capabilities
+	}
+
 	// Insert worker into database
 	_, err := h.db.ExecContext(ctx,
-		"INSERT INTO workflow_workers (id, hostname, status, last_heartbeat, max_concurrent_steps, started_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (id) DO UPDATE SET hostname = $2, status = $3, last_heartbeat = $4, max_concurrent_steps = $5",
-		request.Body.Id, hostname, "active", now, concurrency, now)
+		"INSERT INTO workflow_workers (id, hostname, status, last_heartbeat, max_concurrent_steps, started_at, capabilities) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO UPDATE SET hostname = $2, status = $3, last_heartbeat = $4, max_concurrent_steps = $5, capabilities = $7",
+		request.Body.Id, hostname, "active", now, concurrency, now, pq.Array(capabilities))
 	if err != nil {
 		errorMsg := "failed to register worker"
 		message := err.Error()
@@ -92,6 +108,7 @@ func (h *OpenAPIHandlers) RegisterWorker(ctx context.Context, request RegisterWo
 		Status:        &status,
 		LastHeartbeat: &now,
 		Concurrency:   &concurrency,
+		Capabilities:  &capabilities,
 		RegisteredAt:  &now,
 	}
 
@@ -172,24 +189,380 @@ func (h *OpenAPIHandlers) UpdateWorkerHeartbeat(ctx context.Context, request Upd
 
 // ClaimWork allows a worker to claim work items
 func (h *OpenAPIHandlers) ClaimWork(ctx context.Context, request ClaimWorkRequestObject) (ClaimWorkResponseObject, error) {
-	// For now, return empty array as work queue implementation would be complex
-	// In a real implementation, this would:
-	// 1. Query for available work items
-	// 2. Mark them as claimed by this worker
-	// 3. Return the work items
+	maxItems := 10
+	if request.Body != nil && request.Body.MaxItems != nil && *request.Body.MaxItems > 0 {
+		maxItems = *request.Body.MaxItems
+	}
+
+	wait := 0
+	if request.Body != nil && request.Body.WaitSeconds != nil && *request.Body.WaitSeconds > 0 {
+		wait = min(*request.Body.WaitSeconds, maxClaimWaitSeconds)
+	}
+
+	items, err := h.claimWork(ctx, request.Id, maxItems, time.Duration(wait)*time.Second)
+	if err != nil {
+		errorMsg := "failed to claim work"
+		message := err.Error()
+		return ClaimWork500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	workItems := make([]WorkItem, 0, len(items))
+	for _, item := range items {
+		payload := GenericPayload{}
+		for k, v := range item.Payload {
+			payload[k] = v
+		}
+		payload["run_id"] = item.RunID.String()
+		payload["priority"] = item.Priority
+		if item.StepID != nil {
+			payload["step_id"] = item.StepID.String()
+		}
+
+		id := item.ID.String()
+		itemType := string(item.QueueType)
+		createdAt := item.CreatedAt
+		lease := item.LeaseEpoch
+		workItems = append(workItems, WorkItem{
+			Id:         &id,
+			Type:       &itemType,
+			Payload:    &payload,
+			CreatedAt:  &createdAt,
+			LeaseEpoch: &lease,
+		})
+	}
 
-	var workItems []WorkItem
 	return ClaimWork200JSONResponse(workItems), nil
 }
 
+// maxClaimWaitSeconds caps how long a claim waits for work
+const maxClaimWaitSeconds = 60
+
+// claimWork claims work for a worker. When there is none, it waits up to the
+// given duration for work to be enqueued and tries again.
+func (h *OpenAPIHandlers) claimWork(ctx context.Context, workerID string, maxItems int, wait time.Duration) ([]*execution.QueueItem, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	for {
+		items, err := h.engine.ClaimWork(ctx, workerID, maxItems)
+		if err != nil || len(items) > 0 || waitCtx.Err() != nil {
+			return items, err
+		}
+		if err := h.engine.WaitForWork(waitCtx); err != nil {
+			// Waiting until the deadline without work is not an error
+			return items, nil
+		}
+	}
+}
+
 // CompleteWork marks a work item as completed
 func (h *OpenAPIHandlers) CompleteWork(ctx context.Context, request CompleteWorkRequestObject) (CompleteWorkResponseObject, error) {
-	// For now, just return success
-	// In a real implementation, this would:
-	// 1. Verify the work item belongs to this worker
-	// 2. Update the work item status
-	// 3. Store the result/error
-	// 4. Potentially trigger follow-up actions
+	itemID, err := uuid.Parse(request.ItemId)
+	if err != nil {
+		errorMsg := "invalid item id"
+		message := err.Error()
+		return CompleteWork400JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	var lease int64
+	result := &execution.WorkResult{Success: true}
+	if request.Body != nil {
+		lease = request.Body.LeaseEpoch
+		result.Success = request.Body.Error == nil
+		result.Error = request.Body.Error
+		if request.Body.Result != nil {
+			result.OutputData = *request.Body.Result
+		}
+		if request.Body.NextSteps != nil {
+			result.NextSteps = *request.Body.NextSteps
+		}
+		if request.Body.ShouldRetry != nil {
+			result.ShouldRetry = *request.Body.ShouldRetry
+		}
+		if request.Body.RetryDelayMs != nil {
+			delay := time.Duration(*request.Body.RetryDelayMs) * time.Millisecond
+			result.RetryDelay = &delay
+		}
+	}
+
+	if err := h.engine.CompleteWork(ctx, request.Id, itemID, lease, result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errorMsg := "not found"
+			message := "Work item not found"
+			return CompleteWork404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		if errors.Is(err, execution.ErrLeaseLost) {
+			errorMsg := "conflict"
+			message := err.Error()
+			return CompleteWork409JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to complete work"
+		message := err.Error()
+		return CompleteWork500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
 
 	return CompleteWork200Response{}, nil
 }
+
+// InitializeWorkerRun creates the steps of a run claimed by a worker
+func (h *OpenAPIHandlers) InitializeWorkerRun(ctx context.Context, request InitializeWorkerRunRequestObject) (InitializeWorkerRunResponseObject, error) {
+	nextSteps, err := h.engine.InitializeRun(ctx, request.RunId, request.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errorMsg := "not found"
+			message := "Workflow run not found"
+			return InitializeWorkerRun404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		if errors.Is(err, execution.ErrRunNotActive) {
+			errorMsg := "gone"
+			message := err.Error()
+			return InitializeWorkerRun410JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to initialize run"
+		message := err.Error()
+		return InitializeWorkerRun500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	if nextSteps == nil {
+		nextSteps = []uuid.UUID{}
+	}
+
+	return InitializeWorkerRun200JSONResponse{NextSteps: nextSteps}, nil
+}
+
+// FinalizeWorkerRun marks a run as completed once all of its steps finished
+func (h *OpenAPIHandlers) FinalizeWorkerRun(ctx context.Context, request FinalizeWorkerRunRequestObject) (FinalizeWorkerRunResponseObject, error) {
+	if err := h.engine.FinalizeRun(ctx, request.RunId); err != nil {
+		errorMsg := "failed to finalize run"
+		message := err.Error()
+		return FinalizeWorkerRun500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return FinalizeWorkerRun204Response{}, nil
+}
+
+// StartWorkerStep leases a step to the worker and returns it with its node
+// configuration and resolved input
+func (h *OpenAPIHandlers) StartWorkerStep(ctx context.Context, request StartWorkerStepRequestObject) (StartWorkerStepResponseObject, error) {
+	step, err := h.engine.PrepareStep(ctx, request.StepId, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			errorMsg := "not found"
+			message := "Step not found"
+			return StartWorkerStep404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrStepNotReady):
+			errorMsg := "conflict"
+			message := err.Error()
+			return StartWorkerStep409JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrRunNotActive), errors.Is(err, execution.ErrStepFinished):
+			errorMsg := "gone"
+			message := err.Error()
+			return StartWorkerStep410JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to prepare step"
+		message := err.Error()
+		return StartWorkerStep500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	var input *Envelope
+	if step.InputEnvelope != nil {
+		data, err := json.Marshal(step.InputEnvelope)
+		if err == nil {
+			err = json.Unmarshal(data, &input)
+		}
+		if err != nil {
+			errorMsg := "failed to encode input envelope"
+			message := err.Error()
+			return StartWorkerStep500JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+	}
+
+	config := NodeConfig(step.NodeConfig)
+	return StartWorkerStep200JSONResponse{
+		Id:            step.ID,
+		RunId:         step.RunID,
+		NodeId:        step.NodeID,
+		NodeType:      step.NodeType,
+		NodeConfig:    &config,
+		InputEnvelope: input,
+		AttemptCount:  &step.AttemptCount,
+		MaxAttempts:   &step.MaxAttempts,
+		LeaseEpoch:    step.LeaseEpoch,
+	}, nil
+}
+
+// ReportStepResult records the outcome of a step executed by a worker
+func (h *OpenAPIHandlers) ReportStepResult(ctx context.Context, request ReportStepResultRequestObject) (ReportStepResultResponseObject, error) {
+	var output *apiPkg.Envelope[any]
+	var stepErr error
+	var lease int64
+	if request.Body != nil {
+		lease = request.Body.LeaseEpoch
+		if request.Body.Error != nil {
+			nodeErr := &apiPkg.NodeError{Message: *request.Body.Error}
+			if request.Body.ErrorCode != nil {
+				nodeErr.Code = *request.Body.ErrorCode
+			}
+			stepErr = nodeErr
+		}
+		if request.Body.OutputEnvelope != nil {
+			data, err := json.Marshal(request.Body.OutputEnvelope)
+			if err == nil {
+				err = json.Unmarshal(data, &output)
+			}
+			if err != nil {
+				errorMsg := "invalid output envelope"
+				message := err.Error()
+				return ReportStepResult500JSONResponse{
+					Error:   &errorMsg,
+					Message: &message,
+				}, nil
+			}
+		}
+	}
+
+	result, err := h.engine.RecordStepResult(ctx, request.StepId, lease, output, stepErr)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errorMsg := "not found"
+			message := "Step not found"
+			return ReportStepResult404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		if errors.Is(err, execution.ErrRunNotActive) {
+			errorMsg := "gone"
+			message := err.Error()
+			return ReportStepResult410JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		if errors.Is(err, execution.ErrLeaseLost) {
+			errorMsg := "conflict"
+			message := err.Error()
+			return ReportStepResult409JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to record step result"
+		message := err.Error()
+		return ReportStepResult500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	response := ReportStepResult200JSONResponse{
+		Success:     result.Success,
+		Error:       result.Error,
+		ShouldRetry: &result.ShouldRetry,
+	}
+	if result.NextSteps != nil {
+		response.NextSteps = &result.NextSteps
+	}
+	if result.RetryDelay != nil {
+		delayMs := result.RetryDelay.Milliseconds()
+		response.RetryDelayMs = &delayMs
+	}
+
+	return response, nil
+}
+
+// ReportStepHeartbeat renews the lease of a step executed by a worker
+func (h *OpenAPIHandlers) ReportStepHeartbeat(ctx context.Context, request ReportStepHeartbeatRequestObject) (ReportStepHeartbeatResponseObject, error) {
+	var lease int64
+	if request.Body != nil {
+		lease = request.Body.LeaseEpoch
+	}
+
+	err := h.engine.RenewStepLease(ctx, request.Id, request.StepId, lease)
+	if errors.Is(err, execution.ErrLeaseLost) {
+		errorMsg := "conflict"
+		message := err.Error()
+		return ReportStepHeartbeat409JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+	if err != nil {
+		errorMsg := "failed to renew step lease"
+		message := err.Error()
+		return ReportStepHeartbeat500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return ReportStepHeartbeat204Response{}, nil
+}
+
+// ListInactiveWorkerRuns reports which of the runs a worker executes steps for
+// are no longer active
+func (h *OpenAPIHandlers) ListInactiveWorkerRuns(ctx context.Context, request ListInactiveWorkerRunsRequestObject) (ListInactiveWorkerRunsResponseObject, error) {
+	var runIDs []uuid.UUID
+	if request.Body != nil {
+		runIDs = request.Body.RunIds
+	}
+
+	inactive, err := h.engine.InactiveRuns(ctx, runIDs)
+	if err != nil {
+		errorMsg := "failed to check runs"
+		message := err.Error()
+		return ListInactiveWorkerRuns500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	if inactive == nil {
+		inactive = []uuid.UUID{}
+	}
+
+	return ListInactiveWorkerRuns200JSONResponse{RunIds: inactive}, nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,7 +10,10 @@ import (
 	"testing"
 
 	"github.com/cedricziel/mel-agent/internal/testutil"
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
 	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,9 +27,10 @@ func TestOpenAPIRegisterWorker(t *testing.T) {
 	router := NewOpenAPIRouter(db, mockEngine)
 
 	registerReq := RegisterWorkerRequest{
-		Id:          "worker-test-001",
-		Name:        testutil.StringPtr("Test Worker"),
-		Concurrency: testutil.IntPtr(10),
+		Id:           "worker-test-001",
+		Name:         testutil.StringPtr("Test Worker"),
+		Concurrency:  testutil.IntPtr(10),
+		Capabilities: &[]string{"code", "has-python"},
 	}
 
 	reqBody, err := json.Marshal(registerReq)
@@ -50,6 +55,7 @@ func TestOpenAPIRegisterWorker(t *testing.T) {
 	assert.Equal(t, "Test Worker", *response.Name)
 	assert.Equal(t, WorkerStatusActive, *response.Status)
 	assert.Equal(t, 10, *response.Concurrency)
+	assert.Equal(t, []string{"code", "has-python"}, *response.Capabilities)
 	assert.NotNil(t, response.LastHeartbeat)
 	assert.NotNil(t, response.RegisteredAt)
 
@@ -58,6 +64,11 @@ func TestOpenAPIRegisterWorker(t *testing.T) {
 	err = db.QueryRow("SELECT COUNT(*) FROM workflow_workers WHERE id = $1", response.Id).Scan(&count)
 	require.NoError(t, err)
 	assert.Equal(t, 1, count)
+
+	var capabilities []string
+	err = db.QueryRow("SELECT capabilities FROM workflow_workers WHERE id = $1", response.Id).Scan((*pq.StringArray)(&capabilities))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"code", "has-python"}, capabilities)
 }
 
 // TestOpenAPIRegisterWorkerMinimal tests registering a worker with minimal data
@@ -94,6 +105,7 @@ func TestOpenAPIRegisterWorkerMinimal(t *testing.T) {
 	assert.Equal(t, "worker-minimal-001", *response.Name) // Should default to ID
 	assert.Equal(t, WorkerStatusActive, *response.Status)
 	assert.Equal(t, 5, *response.Concurrency) // Default concurrency
+	assert.Equal(t, []string{"*"}, *response.Capabilities)
 }
 
 // TestOpenAPIRegisterWorkerUpdate tests updating an existing worker via re-registration
@@ -352,6 +364,67 @@ func TestOpenAPIClaimWork(t *testing.T) {
 	assert.Len(t, response, 0)
 }
 
+// waitingEngine is an execution engine whose queue receives work after the
+// given number of waits
+type waitingEngine struct {
+	execution.ExecutionEngine
+	workAfter int
+
+	claims int
+	waits  int
+}
+
+func (e *waitingEngine) ClaimWork(ctx context.Context, workerID string, maxItems int) ([]*execution.QueueItem, error) {
+	e.claims++
+	if e.workAfter < 0 || e.waits < e.workAfter {
+		return nil, nil
+	}
+	return []*execution.QueueItem{{ID: uuid.New(), RunID: uuid.New(), QueueType: execution.QueueTypeStartRun}}, nil
+}
+
+func (e *waitingEngine) WaitForWork(ctx context.Context) error {
+	if e.workAfter < 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	e.waits++
+	return nil
+}
+
+// TestOpenAPIClaimWorkWaitsForWork tests long-polling for work
+func TestOpenAPIClaimWorkWaitsForWork(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		workAfter  int
+		wantItems  int
+		wantClaims int
+	}{
+		{name: "no wait", body: `{"maxItems": 1}`, workAfter: 1, wantItems: 0, wantClaims: 1},
+		{name: "work enqueued while waiting", body: `{"maxItems": 1, "waitSeconds": 30}`, workAfter: 2, wantItems: 1, wantClaims: 3},
+		{name: "no work until deadline", body: `{"maxItems": 1, "waitSeconds": 1}`, workAfter: -1, wantItems: 0, wantClaims: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &waitingEngine{ExecutionEngine: execution.NewMockExecutionEngine(), workAfter: tt.workAfter}
+			router := NewOpenAPIRouter(nil, engine)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/workers/worker-wait/claim-work", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			var response []WorkItem
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Len(t, response, tt.wantItems)
+			assert.Equal(t, tt.wantClaims, engine.claims)
+		})
+	}
+}
+
 // TestOpenAPICompleteWork tests completing work items
 func TestOpenAPICompleteWork(t *testing.T) {
 	db, cleanup := testutil.SetupOpenAPITestDB(t)
@@ -387,9 +460,88 @@ func TestOpenAPICompleteWork(t *testing.T) {
 	reqBody, _ = json.Marshal(completeReq)
 
 	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", "/api/workers/worker-complete-001/complete-work/work-item-123", bytes.NewBuffer(reqBody))
+	req = httptest.NewRequest("POST", "/api/workers/worker-complete-001/complete-work/"+uuid.New().String(), bytes.NewBuffer(reqBody))
 	req.Header.Set("Content-Type", "application/json")
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestOpenAPICompleteWorkInvalidItemID(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	mockEngine := execution.NewMockExecutionEngine()
+	defer cleanup()
+
+	router := NewOpenAPIRouter(db, mockEngine)
+
+	reqBody, _ := json.Marshal(CompleteWorkRequest{})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/workers/worker-complete-001/complete-work/work-item-123", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// leaseEngine is an execution engine that only accepts writes under its
+// current lease
+type leaseEngine struct {
+	execution.ExecutionEngine
+	lease int64
+}
+
+func (e *leaseEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, lease int64, result *execution.WorkResult) error {
+	if lease != e.lease {
+		return execution.ErrLeaseLost
+	}
+	return nil
+}
+
+func (e *leaseEngine) RecordStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *apiPkg.Envelope[any], stepErr error) (*execution.WorkResult, error) {
+	if lease != e.lease {
+		return nil, execution.ErrLeaseLost
+	}
+	return &execution.WorkResult{Success: true}, nil
+}
+
+func (e *leaseEngine) RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error {
+	if lease != e.lease {
+		return execution.ErrLeaseLost
+	}
+	return nil
+}
+
+// TestOpenAPIWorkerLeases tests that results reported under a stale lease
+// are rejected
+func TestOpenAPIWorkerLeases(t *testing.T) {
+	router := NewOpenAPIRouter(nil, &leaseEngine{ExecutionEngine: execution.NewMockExecutionEngine(), lease: 2})
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		lease      int64
+		wantStatus int
+	}{
+		{name: "complete work", method: "POST", path: "/api/workers/worker-1/complete-work/" + uuid.New().String(), lease: 2, wantStatus: http.StatusOK},
+		{name: "complete work with stale lease", method: "POST", path: "/api/workers/worker-1/complete-work/" + uuid.New().String(), lease: 1, wantStatus: http.StatusConflict},
+		{name: "step result", method: "POST", path: "/api/workers/worker-1/steps/" + uuid.New().String() + "/result", lease: 2, wantStatus: http.StatusOK},
+		{name: "step result with stale lease", method: "POST", path: "/api/workers/worker-1/steps/" + uuid.New().String() + "/result", lease: 1, wantStatus: http.StatusConflict},
+		{name: "step heartbeat", method: "PUT", path: "/api/workers/worker-1/steps/" + uuid.New().String() + "/heartbeat", lease: 2, wantStatus: http.StatusNoContent},
+		{name: "step heartbeat with stale lease", method: "PUT", path: "/api/workers/worker-1/steps/" + uuid.New().String() + "/heartbeat", lease: 1, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]any{"lease_epoch": tt.lease})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBuffer(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+		})
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/execution"
 	"github.com/google/uuid"
 )
 
@@ -125,6 +128,9 @@ func (h *OpenAPIHandlers) ListWorkflowRuns(ctx context.Context, request ListWork
 			case "running":
 				s := WorkflowRunStatusRunning
 				return &s
+			case "paused":
+				s := WorkflowRunStatusPaused
+				return &s
 			case "completed":
 				s := WorkflowRunStatusCompleted
 				return &s
@@ -229,6 +235,9 @@ func (h *OpenAPIHandlers) GetWorkflowRun(ctx context.Context, request GetWorkflo
 		case "running":
 			s := WorkflowRunStatusRunning
 			return &s
+		case "paused":
+			s := WorkflowRunStatusPaused
+			return &s
 		case "completed":
 			s := WorkflowRunStatusCompleted
 			return &s
@@ -340,6 +349,9 @@ func (h *OpenAPIHandlers) GetWorkflowRunSteps(ctx context.Context, request GetWo
 			case "skipped":
 				s := WorkflowStepStatusSkipped
 				return &s
+			case "waiting":
+				s := WorkflowStepStatusWaiting
+				return &s
 			}
 			return nil
 		}()
@@ -390,3 +402,113 @@ func (h *OpenAPIHandlers) GetWorkflowRunSteps(ctx context.Context, request GetWo
 
 	return GetWorkflowRunSteps200JSONResponse(steps), nil
 }
+
+// SignalWorkflowRun sends a signal to the step of a run waiting for it
+func (h *OpenAPIHandlers) SignalWorkflowRun(ctx context.Context, request SignalWorkflowRunRequestObject) (SignalWorkflowRunResponseObject, error) {
+	var payload map[string]any
+	if request.Body != nil {
+		payload = *request.Body
+	}
+
+	stepID, err := h.engine.SignalRun(ctx, request.Id, request.Name, request.Params.Token, payload)
+	if err != nil {
+		message := err.Error()
+		switch {
+		case errors.Is(err, execution.ErrInvalidSignalToken):
+			errorMsg := "forbidden"
+			return SignalWorkflowRun403JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrSignalNotAwaited):
+			errorMsg := "not found"
+			return SignalWorkflowRun404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrRunNotActive):
+			errorMsg := "gone"
+			return SignalWorkflowRun410JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to signal workflow run"
+		return SignalWorkflowRun500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	return SignalWorkflowRun200JSONResponse{
+		RunId:  request.Id,
+		StepId: stepID,
+		Signal: request.Name,
+	}, nil
+}
+
+// ReplayWorkflowRun starts a new run that executes a run again from one of its nodes
+func (h *OpenAPIHandlers) ReplayWorkflowRun(ctx context.Context, request ReplayWorkflowRunRequestObject) (ReplayWorkflowRunResponseObject, error) {
+	if request.Body == nil || request.Body.NodeId == "" {
+		errorMsg := "bad request"
+		message := "node_id is required"
+		return ReplayWorkflowRun400JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	var input *apiPkg.Envelope[any]
+	if request.Body.InputEnvelope != nil {
+		data, err := json.Marshal(request.Body.InputEnvelope)
+		if err == nil {
+			err = json.Unmarshal(data, &input)
+		}
+		if err != nil {
+			errorMsg := "invalid input envelope"
+			message := err.Error()
+			return ReplayWorkflowRun400JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+	}
+
+	run, err := h.engine.ReplayRun(ctx, request.Id, request.Body.NodeId, input)
+	if err != nil {
+		message := err.Error()
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			errorMsg := "not found"
+			message = "Workflow run not found"
+			return ReplayWorkflowRun404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrNodeNotFound):
+			errorMsg := "not found"
+			return ReplayWorkflowRun404JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		case errors.Is(err, execution.ErrReplayNotPossible):
+			errorMsg := "bad request"
+			return ReplayWorkflowRun400JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "failed to replay workflow run"
+		return ReplayWorkflowRun500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	status := WorkflowRunStatusPending
+	return ReplayWorkflowRun201JSONResponse{
+		Id:         &run.ID,
+		WorkflowId: run.WorkflowID,
+		Status:     &status,
+	}, nil
+}
@@ -2,6 +2,8 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cedricziel/mel-agent/internal/testutil"
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
 	"github.com/cedricziel/mel-agent/pkg/execution"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -682,3 +685,132 @@ func TestOpenAPIWorkflowRunLegacyCompatibility(t *testing.T) {
 	assert.Equal(t, runID, *legacyRun.Id)
 	assert.Equal(t, agentID, *legacyRun.WorkflowId)
 }
+
+// signalEngine is an execution engine that answers signals with a fixed result
+type signalEngine struct {
+	execution.ExecutionEngine
+	stepID uuid.UUID
+	err    error
+
+	signal  string
+	token   string
+	payload map[string]any
+}
+
+func (e *signalEngine) SignalRun(ctx context.Context, runID uuid.UUID, signal, token string, payload map[string]any) (uuid.UUID, error) {
+	e.signal, e.token, e.payload = signal, token, payload
+	return e.stepID, e.err
+}
+
+// TestOpenAPISignalWorkflowRun tests delivering signals to waiting runs
+func TestOpenAPISignalWorkflowRun(t *testing.T) {
+	runID, stepID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "delivered", wantStatus: http.StatusOK},
+		{name: "invalid token", err: execution.ErrInvalidSignalToken, wantStatus: http.StatusForbidden},
+		{name: "not awaited", err: execution.ErrSignalNotAwaited, wantStatus: http.StatusNotFound},
+		{name: "inactive run", err: execution.ErrRunNotActive, wantStatus: http.StatusGone},
+		{name: "engine failure", err: fmt.Errorf("connection reset"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &signalEngine{ExecutionEngine: execution.NewMockExecutionEngine(), stepID: stepID, err: tt.err}
+			router := NewOpenAPIRouter(nil, engine)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST",
+				fmt.Sprintf("/api/workflow-runs/%s/signals/approval?token=secret", runID),
+				bytes.NewBufferString(`{"approved": true}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			assert.Equal(t, "approval", engine.signal)
+			assert.Equal(t, "secret", engine.token)
+			assert.Equal(t, map[string]any{"approved": true}, engine.payload)
+
+			if tt.err == nil {
+				var response SignalResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				assert.Equal(t, runID, response.RunId)
+				assert.Equal(t, stepID, response.StepId)
+				assert.Equal(t, "approval", response.Signal)
+			}
+		})
+	}
+}
+
+// replayEngine is an execution engine that answers replays with a fixed result
+type replayEngine struct {
+	execution.ExecutionEngine
+	err error
+
+	nodeID string
+	input  *apiPkg.Envelope[any]
+}
+
+func (e *replayEngine) ReplayRun(ctx context.Context, runID uuid.UUID, nodeID string, input *apiPkg.Envelope[any]) (*execution.WorkflowRun, error) {
+	e.nodeID, e.input = nodeID, input
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &execution.WorkflowRun{ID: uuid.New(), ReplaySourceRunID: &runID, Status: execution.RunStatusPending}, nil
+}
+
+// TestOpenAPIReplayWorkflowRun tests replaying workflow runs from a node
+func TestOpenAPIReplayWorkflowRun(t *testing.T) {
+	runID := uuid.New()
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "replayed", body: `{"node_id": "charge"}`, wantStatus: http.StatusCreated},
+		{name: "edited input", body: `{"node_id": "charge", "input_envelope": {"id": "edited", "data": {"order": 43}}}`, wantStatus: http.StatusCreated},
+		{name: "missing node id", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown run", body: `{"node_id": "charge"}`, err: sql.ErrNoRows, wantStatus: http.StatusNotFound},
+		{name: "unknown node", body: `{"node_id": "charge"}`, err: execution.ErrNodeNotFound, wantStatus: http.StatusNotFound},
+		{name: "not possible", body: `{"node_id": "charge"}`, err: execution.ErrReplayNotPossible, wantStatus: http.StatusBadRequest},
+		{name: "engine failure", body: `{"node_id": "charge"}`, err: fmt.Errorf("connection reset"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &replayEngine{ExecutionEngine: execution.NewMockExecutionEngine(), err: tt.err}
+			router := NewOpenAPIRouter(nil, engine)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST",
+				fmt.Sprintf("/api/workflow-runs/%s/replay", runID),
+				bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+
+			if tt.wantStatus == http.StatusCreated {
+				assert.Equal(t, "charge", engine.nodeID)
+
+				var response WorkflowRun
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				require.NotNil(t, response.Id)
+				assert.NotEqual(t, runID, *response.Id)
+				require.NotNil(t, response.Status)
+				assert.Equal(t, WorkflowRunStatusPending, *response.Status)
+			}
+			if tt.name == "edited input" {
+				require.NotNil(t, engine.input)
+				assert.Equal(t, "edited", engine.input.ID)
+				assert.Equal(t, map[string]any{"order": float64(43)}, engine.input.Data)
+			}
+		})
+	}
+}
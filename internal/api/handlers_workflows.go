@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cedricziel/mel-agent/pkg/execution"
 	"github.com/google/uuid"
 )
 
@@ -39,7 +40,7 @@ func (h *OpenAPIHandlers) ListWorkflows(ctx context.Context, request ListWorkflo
 
 	// Get workflows with pagination
 	rows, err := h.db.QueryContext(ctx,
-		"SELECT id, name, description, definition, created_at, updated_at FROM workflows ORDER BY created_at DESC LIMIT $1 OFFSET $2",
+		"SELECT id, name, description, definition, error_workflow_id, max_concurrent_runs, max_concurrent_steps, created_at, updated_at FROM workflows ORDER BY created_at DESC LIMIT $1 OFFSET $2",
 		limit, offset)
 	if err != nil {
 		errorMsg := "database error"
@@ -56,10 +57,12 @@ func (h *OpenAPIHandlers) ListWorkflows(ctx context.Context, request ListWorkflo
 		var workflow Workflow
 		var description sql.NullString
 		var definitionJson sql.NullString
+		var errorWorkflowID uuid.NullUUID
+		var maxConcurrentRuns, maxConcurrentSteps *int
 		var id, name string
 		var createdAt, updatedAt time.Time
 
-		err := rows.Scan(&id, &name, &description, &definitionJson, &createdAt, &updatedAt)
+		err := rows.Scan(&id, &name, &description, &definitionJson, &errorWorkflowID, &maxConcurrentRuns, &maxConcurrentSteps, &createdAt, &updatedAt)
 		if err != nil {
 			errorMsg := "scan error"
 			message := err.Error()
@@ -84,6 +87,11 @@ func (h *OpenAPIHandlers) ListWorkflows(ctx context.Context, request ListWorkflo
 		if description.Valid {
 			workflow.Description = &description.String
 		}
+		if errorWorkflowID.Valid {
+			workflow.ErrorWorkflowId = &errorWorkflowID.UUID
+		}
+		workflow.MaxConcurrentRuns = maxConcurrentRuns
+		workflow.MaxConcurrentSteps = maxConcurrentSteps
 		workflow.CreatedAt = createdAt
 		workflow.UpdatedAt = updatedAt
 
@@ -137,13 +145,45 @@ func (h *OpenAPIHandlers) CreateWorkflow(ctx context.Context, request CreateWork
 		}
 	}
 
+	errorWorkflowID := request.Body.ErrorWorkflowId
+	if errorWorkflowID != nil {
+		message, err := h.validateErrorWorkflow(ctx, workflowID, *errorWorkflowID)
+		if err != nil {
+			errorMsg := "database error"
+			message := err.Error()
+			return CreateWorkflow500JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		if message != "" {
+			errorMsg := "invalid error workflow"
+			return CreateWorkflow400JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+	}
+
+	if invalidConcurrencyLimit(request.Body.MaxConcurrentRuns) || invalidConcurrencyLimit(request.Body.MaxConcurrentSteps) {
+		errorMsg := "bad request"
+		message := "Concurrency limits must not be negative"
+		return CreateWorkflow400JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	maxConcurrentRuns := concurrencyLimit(request.Body.MaxConcurrentRuns)
+	maxConcurrentSteps := concurrencyLimit(request.Body.MaxConcurrentSteps)
+
 	// For now, use a default user_id (in real implementation, this would come from auth context)
 	defaultUserID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
 
 	// Insert workflow into database
 	_, err = h.db.ExecContext(ctx,
-		"INSERT INTO workflows (id, user_id, name, description, definition, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
-		workflowID, defaultUserID, request.Body.Name, description, definitionJson, now, now)
+		"INSERT INTO workflows (id, user_id, name, description, definition, error_workflow_id, max_concurrent_runs, max_concurrent_steps, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+		workflowID, defaultUserID, request.Body.Name, description, definitionJson, errorWorkflowID, maxConcurrentRuns, maxConcurrentSteps, now, now)
 	if err != nil {
 		errorMsg := "failed to create workflow"
 		message := err.Error()
@@ -154,12 +194,15 @@ func (h *OpenAPIHandlers) CreateWorkflow(ctx context.Context, request CreateWork
 	}
 
 	workflow := Workflow{
-		Id:          workflowID,
-		Name:        request.Body.Name,
-		Description: description,
-		Definition:  request.Body.Definition,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		Id:                 workflowID,
+		Name:               request.Body.Name,
+		Description:        description,
+		Definition:         request.Body.Definition,
+		ErrorWorkflowId:    errorWorkflowID,
+		MaxConcurrentRuns:  maxConcurrentRuns,
+		MaxConcurrentSteps: maxConcurrentSteps,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	return CreateWorkflow201JSONResponse(workflow), nil
@@ -170,12 +213,14 @@ func (h *OpenAPIHandlers) GetWorkflow(ctx context.Context, request GetWorkflowRe
 	var workflow Workflow
 	var description sql.NullString
 	var definitionJson sql.NullString
+	var errorWorkflowID uuid.NullUUID
+	var maxConcurrentRuns, maxConcurrentSteps *int
 	var id, name string
 	var createdAt, updatedAt time.Time
 
 	err := h.db.QueryRowContext(ctx,
-		"SELECT id, name, description, definition, created_at, updated_at FROM workflows WHERE id = $1",
-		request.Id.String()).Scan(&id, &name, &description, &definitionJson, &createdAt, &updatedAt)
+		"SELECT id, name, description, definition, error_workflow_id, max_concurrent_runs, max_concurrent_steps, created_at, updated_at FROM workflows WHERE id = $1",
+		request.Id.String()).Scan(&id, &name, &description, &definitionJson, &errorWorkflowID, &maxConcurrentRuns, &maxConcurrentSteps, &createdAt, &updatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			errorMsg := "not found"
@@ -208,6 +253,11 @@ func (h *OpenAPIHandlers) GetWorkflow(ctx context.Context, request GetWorkflowRe
 	if description.Valid {
 		workflow.Description = &description.String
 	}
+	if errorWorkflowID.Valid {
+		workflow.ErrorWorkflowId = &errorWorkflowID.UUID
+	}
+	workflow.MaxConcurrentRuns = maxConcurrentRuns
+	workflow.MaxConcurrentSteps = maxConcurrentSteps
 	workflow.CreatedAt = createdAt
 	workflow.UpdatedAt = updatedAt
 
@@ -265,6 +315,55 @@ func (h *OpenAPIHandlers) UpdateWorkflow(ctx context.Context, request UpdateWork
 		argIndex++
 	}
 
+	// The nil UUID removes the error workflow
+	if request.Body.ErrorWorkflowId != nil {
+		var errorWorkflowID *uuid.UUID
+		if *request.Body.ErrorWorkflowId != uuid.Nil {
+			errorWorkflowID = request.Body.ErrorWorkflowId
+			message, err := h.validateErrorWorkflow(ctx, request.Id, *errorWorkflowID)
+			if err != nil {
+				errorMsg := "database error"
+				message := err.Error()
+				return UpdateWorkflow500JSONResponse{
+					Error:   &errorMsg,
+					Message: &message,
+				}, nil
+			}
+			if message != "" {
+				errorMsg := "invalid error workflow"
+				return UpdateWorkflow400JSONResponse{
+					Error:   &errorMsg,
+					Message: &message,
+				}, nil
+			}
+		}
+		setParts = append(setParts, fmt.Sprintf("error_workflow_id = $%d", argIndex))
+		args = append(args, errorWorkflowID)
+		argIndex++
+	}
+
+	if invalidConcurrencyLimit(request.Body.MaxConcurrentRuns) || invalidConcurrencyLimit(request.Body.MaxConcurrentSteps) {
+		errorMsg := "bad request"
+		message := "Concurrency limits must not be negative"
+		return UpdateWorkflow400JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	// A limit of 0 removes the limit
+	if request.Body.MaxConcurrentRuns != nil {
+		setParts = append(setParts, fmt.Sprintf("max_concurrent_runs = $%d", argIndex))
+		args = append(args, concurrencyLimit(request.Body.MaxConcurrentRuns))
+		argIndex++
+	}
+
+	if request.Body.MaxConcurrentSteps != nil {
+		setParts = append(setParts, fmt.Sprintf("max_concurrent_steps = $%d", argIndex))
+		args = append(args, concurrencyLimit(request.Body.MaxConcurrentSteps))
+		argIndex++
+	}
+
 	// Add the ID as the last parameter
 	args = append(args, request.Id.String())
 
@@ -326,6 +425,40 @@ func (h *OpenAPIHandlers) UpdateWorkflow(ctx context.Context, request UpdateWork
 	}, nil
 }
 
+// concurrencyLimit returns the stored value of a concurrency limit, where nil
+// means unlimited
+func concurrencyLimit(limit *int) *int {
+	if limit == nil || *limit == 0 {
+		return nil
+	}
+	return limit
+}
+
+// invalidConcurrencyLimit reports whether a concurrency limit is negative
+func invalidConcurrencyLimit(limit *int) bool {
+	return limit != nil && *limit < 0
+}
+
+// validateErrorWorkflow checks that the error workflow of a workflow exists
+// and is a different workflow. It returns a message describing the problem
+// when it is not valid.
+func (h *OpenAPIHandlers) validateErrorWorkflow(ctx context.Context, workflowID, errorWorkflowID uuid.UUID) (string, error) {
+	if errorWorkflowID == workflowID {
+		return "A workflow cannot be its own error workflow", nil
+	}
+
+	var exists bool
+	err := h.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM workflows WHERE id = $1)", errorWorkflowID).Scan(&exists)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return fmt.Sprintf("Error workflow %s not found", errorWorkflowID), nil
+	}
+	return "", nil
+}
+
 // DeleteWorkflow removes a workflow
 func (h *OpenAPIHandlers) DeleteWorkflow(ctx context.Context, request DeleteWorkflowRequestObject) (DeleteWorkflowResponseObject, error) {
 	result, err := h.db.ExecContext(ctx, "DELETE FROM workflows WHERE id = $1", request.Id.String())
@@ -360,7 +493,16 @@ func (h *OpenAPIHandlers) DeleteWorkflow(ctx context.Context, request DeleteWork
 	return DeleteWorkflow204Response{}, nil
 }
 
-// ExecuteWorkflow executes a workflow
+const (
+	// defaultExecuteTimeout is how long ExecuteWorkflow waits for a run by default
+	defaultExecuteTimeout = 30 * time.Second
+	// maxExecuteTimeoutSeconds caps how long ExecuteWorkflow waits for a run
+	maxExecuteTimeoutSeconds = 300
+)
+
+// ExecuteWorkflow executes a workflow by starting a run of its deployed
+// version. With wait, the request blocks until the run finished or the
+// timeout passed.
 func (h *OpenAPIHandlers) ExecuteWorkflow(ctx context.Context, request ExecuteWorkflowRequestObject) (ExecuteWorkflowResponseObject, error) {
 	// First, verify the workflow exists
 	var workflowName string
@@ -384,30 +526,34 @@ func (h *OpenAPIHandlers) ExecuteWorkflow(ctx context.Context, request ExecuteWo
 		}, nil
 	}
 
-	// Create a workflow execution record
-	executionID := uuid.New()
-	now := time.Now()
-
-	var inputJson interface{}
-	if request.Body != nil && request.Body.Input != nil {
-		inputJson, err = json.Marshal(*request.Body.Input)
-		if err != nil {
-			errorMsg := "failed to marshal input"
-			message := err.Error()
-			return ExecuteWorkflow500JSONResponse{
+	timeout := defaultExecuteTimeout
+	if request.Params.Timeout != nil {
+		if *request.Params.Timeout < 1 || *request.Params.Timeout > maxExecuteTimeoutSeconds {
+			errorMsg := "bad request"
+			message := fmt.Sprintf("timeout must be between 1 and %d seconds", maxExecuteTimeoutSeconds)
+			return ExecuteWorkflow400JSONResponse{
 				Error:   &errorMsg,
 				Message: &message,
 			}, nil
 		}
+		timeout = time.Duration(*request.Params.Timeout) * time.Second
 	}
 
-	// For now, we'll create a simple execution record
-	// In a real implementation, this would trigger the workflow engine
-	_, err = h.db.ExecContext(ctx,
-		"INSERT INTO workflow_executions (id, workflow_id, status, started_at, input) VALUES ($1, $2, $3, $4, $5)",
-		executionID, request.Id.String(), "pending", now, inputJson)
+	// Runs execute the deployed version of the workflow
+	var versionID uuid.UUID
+	err = h.db.QueryRowContext(ctx,
+		"SELECT id FROM workflow_versions WHERE workflow_id = $1 AND is_current = true",
+		request.Id).Scan(&versionID)
 	if err != nil {
-		errorMsg := "failed to create execution"
+		if err == sql.ErrNoRows {
+			errorMsg := "bad request"
+			message := "Workflow has no deployed version"
+			return ExecuteWorkflow400JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+		errorMsg := "database error"
 		message := err.Error()
 		return ExecuteWorkflow500JSONResponse{
 			Error:   &errorMsg,
@@ -415,21 +561,65 @@ func (h *OpenAPIHandlers) ExecuteWorkflow(ctx context.Context, request ExecuteWo
 		}, nil
 	}
 
-	// TODO: Actually trigger the workflow execution via the engine
-	// This would involve calling h.engine.Execute() or similar
+	input := map[string]interface{}{}
+	if request.Body != nil && request.Body.Input != nil {
+		input = *request.Body.Input
+	}
 
-	execution := WorkflowExecution{
-		Id:         &executionID,
+	run := &execution.WorkflowRun{
+		ID:             uuid.New(),
+		WorkflowID:     &request.Id,
+		VersionID:      versionID,
+		Status:         execution.RunStatusPending,
+		CreatedAt:      time.Now(),
+		InputData:      input,
+		Variables:      map[string]interface{}{},
+		TimeoutSeconds: execution.DefaultRunTimeoutSeconds,
+		RetryPolicy:    execution.DefaultRetryPolicy(),
+		IdempotencyKey: request.Params.IdempotencyKey,
+	}
+
+	// A repeated request with the same idempotency key returns the run the
+	// first request started
+	if err := h.engine.StartRun(ctx, run); err != nil {
+		errorMsg := "failed to start run"
+		message := err.Error()
+		return ExecuteWorkflow500JSONResponse{
+			Error:   &errorMsg,
+			Message: &message,
+		}, nil
+	}
+
+	status := WorkflowRunStatus(run.Status)
+	result := WorkflowExecution{
+		Id:         &run.ID,
 		WorkflowId: &request.Id,
-		Status:     func() *WorkflowRunStatus { s := WorkflowRunStatusPending; return &s }(),
-		StartedAt:  &now,
+		Status:     &status,
+		StartedAt:  &run.CreatedAt,
 	}
 
-	if request.Body != nil && request.Body.Input != nil {
-		execution.Result = request.Body.Input
+	// Callers waiting for the run get its output, or its current status
+	// once the timeout passed
+	if request.Params.Wait != nil && *request.Params.Wait {
+		outcome, err := h.awaitRun(ctx, run.ID, timeout, false)
+		if err != nil {
+			errorMsg := "failed to wait for run"
+			message := err.Error()
+			return ExecuteWorkflow500JSONResponse{
+				Error:   &errorMsg,
+				Message: &message,
+			}, nil
+		}
+
+		status = WorkflowRunStatus(outcome.Status)
+		result.CompletedAt = outcome.CompletedAt
+		result.Error = outcome.Error
+		if outcome.Output != nil {
+			result.Result = &outcome.Output
+		}
 	}
 
-	return ExecuteWorkflow200JSONResponse(execution), nil
+	return ExecuteWorkflow200JSONResponse(result), nil
 }
 
 // ListWorkflowNodes lists all nodes in a workflow
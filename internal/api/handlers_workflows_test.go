@@ -2,6 +2,8 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -407,6 +409,108 @@ func TestOpenAPIUpdateWorkflowNotFound(t *testing.T) {
 	assert.Equal(t, "not found", *response.Error)
 }
 
+// TestOpenAPIWorkflowErrorWorkflow tests setting and clearing the error workflow
+func TestOpenAPIWorkflowErrorWorkflow(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	mockEngine := execution.NewMockExecutionEngine()
+	defer cleanup()
+
+	router := NewOpenAPIRouter(db, mockEngine)
+
+	createWorkflow := func(createReq CreateWorkflowRequest) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(createReq)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/api/workflows", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+	updateWorkflow := func(id uuid.UUID, updateReq UpdateWorkflowRequest) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(updateReq)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("PUT", fmt.Sprintf("/api/workflows/%s", id), bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := createWorkflow(CreateWorkflowRequest{Name: "Error Handler"})
+	require.Equal(t, http.StatusCreated, w.Code)
+	var handler Workflow
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&handler))
+
+	// Creating a workflow with an error workflow
+	w = createWorkflow(CreateWorkflowRequest{Name: "Orders", ErrorWorkflowId: &handler.Id})
+	require.Equal(t, http.StatusCreated, w.Code)
+	var workflow Workflow
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&workflow))
+	require.NotNil(t, workflow.ErrorWorkflowId)
+	assert.Equal(t, handler.Id, *workflow.ErrorWorkflowId)
+
+	// Unknown error workflows are rejected
+	unknownID := uuid.New()
+	w = createWorkflow(CreateWorkflowRequest{Name: "Broken", ErrorWorkflowId: &unknownID})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = updateWorkflow(workflow.Id, UpdateWorkflowRequest{ErrorWorkflowId: &unknownID})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// A workflow cannot handle its own errors
+	w = updateWorkflow(workflow.Id, UpdateWorkflowRequest{ErrorWorkflowId: &workflow.Id})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// The nil UUID clears the error workflow
+	nilID := uuid.Nil
+	w = updateWorkflow(workflow.Id, UpdateWorkflowRequest{ErrorWorkflowId: &nilID})
+	require.Equal(t, http.StatusOK, w.Code)
+	var updated Workflow
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&updated))
+	assert.Nil(t, updated.ErrorWorkflowId)
+}
+
+// TestOpenAPIWorkflowConcurrencyLimits tests setting and clearing concurrency limits
+func TestOpenAPIWorkflowConcurrencyLimits(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	mockEngine := execution.NewMockExecutionEngine()
+	defer cleanup()
+
+	router := NewOpenAPIRouter(db, mockEngine)
+
+	sendWorkflow := func(method, path string, body any) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(body)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := sendWorkflow("POST", "/api/workflows", CreateWorkflowRequest{
+		Name:               "Webhook Intake",
+		MaxConcurrentRuns:  testutil.IntPtr(2),
+		MaxConcurrentSteps: testutil.IntPtr(5),
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	var workflow Workflow
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&workflow))
+	assert.Equal(t, 2, *workflow.MaxConcurrentRuns)
+	assert.Equal(t, 5, *workflow.MaxConcurrentSteps)
+
+	// Negative limits are rejected
+	w = sendWorkflow("POST", "/api/workflows", CreateWorkflowRequest{Name: "Broken", MaxConcurrentRuns: testutil.IntPtr(-1)})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = sendWorkflow("PUT", fmt.Sprintf("/api/workflows/%s", workflow.Id), UpdateWorkflowRequest{MaxConcurrentSteps: testutil.IntPtr(-1)})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// 0 removes a limit and leaves the other one
+	w = sendWorkflow("PUT", fmt.Sprintf("/api/workflows/%s", workflow.Id), UpdateWorkflowRequest{MaxConcurrentRuns: testutil.IntPtr(0)})
+	require.Equal(t, http.StatusOK, w.Code)
+	var updated Workflow
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&updated))
+	assert.Nil(t, updated.MaxConcurrentRuns)
+	require.NotNil(t, updated.MaxConcurrentSteps)
+	assert.Equal(t, 5, *updated.MaxConcurrentSteps)
+}
+
 // TestOpenAPIDeleteWorkflow tests deleting a workflow
 func TestOpenAPIDeleteWorkflow(t *testing.T) {
 	db, cleanup := testutil.SetupOpenAPITestDB(t)
@@ -466,13 +570,33 @@ func TestOpenAPIDeleteWorkflowNotFound(t *testing.T) {
 	assert.Equal(t, "not found", *response.Error)
 }
 
+// idempotentEngine is an execution engine that records the runs it starts
+// and answers repeated idempotency keys with the run it started first
+type idempotentEngine struct {
+	execution.ExecutionEngine
+
+	runs []*execution.WorkflowRun
+}
+
+func (e *idempotentEngine) StartRun(ctx context.Context, run *execution.WorkflowRun) error {
+	for _, existing := range e.runs {
+		if run.IdempotencyKey != nil && existing.IdempotencyKey != nil && *run.IdempotencyKey == *existing.IdempotencyKey {
+			*run = *existing
+			return nil
+		}
+	}
+	started := *run
+	e.runs = append(e.runs, &started)
+	return nil
+}
+
 // TestOpenAPIExecuteWorkflow tests executing a workflow
 func TestOpenAPIExecuteWorkflow(t *testing.T) {
 	db, cleanup := testutil.SetupOpenAPITestDB(t)
-	mockEngine := execution.NewMockExecutionEngine()
+	engine := &idempotentEngine{ExecutionEngine: execution.NewMockExecutionEngine()}
 	defer cleanup()
 
-	router := NewOpenAPIRouter(db, mockEngine)
+	router := NewOpenAPIRouter(db, engine)
 
 	// Create a test workflow
 	createReq := CreateWorkflowRequest{
@@ -490,39 +614,182 @@ func TestOpenAPIExecuteWorkflow(t *testing.T) {
 	var createdWorkflow Workflow
 	json.NewDecoder(w.Body).Decode(&createdWorkflow)
 
-	// Execute the workflow
-	executeReq := ExecuteWorkflowJSONBody{
-		Input: &map[string]interface{}{
-			"message": "Hello, World!",
-			"count":   42,
-		},
+	execute := func(idempotencyKey string) *httptest.ResponseRecorder {
+		executeReq := ExecuteWorkflowJSONBody{
+			Input: &map[string]interface{}{
+				"message": "Hello, World!",
+				"count":   42,
+			},
+		}
+		reqBody, _ := json.Marshal(executeReq)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", fmt.Sprintf("/api/workflows/%s/execute", createdWorkflow.Id), bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+		router.ServeHTTP(w, req)
+		return w
 	}
-	reqBody, _ = json.Marshal(executeReq)
 
-	w = httptest.NewRecorder()
-	req = httptest.NewRequest("POST", fmt.Sprintf("/api/workflows/%s/execute", createdWorkflow.Id), bytes.NewBuffer(reqBody))
-	req.Header.Set("Content-Type", "application/json")
-	router.ServeHTTP(w, req)
+	// Workflows without a deployed version are not executed
+	w = execute("")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
 
-	assert.Equal(t, http.StatusOK, w.Code)
+	versionID := uuid.New()
+	_, err := db.Exec(`INSERT INTO workflow_versions (id, workflow_id, version_number, name, definition, is_current) VALUES ($1, $2, 1, 'v1', '{}', true)`,
+		versionID, createdWorkflow.Id)
+	require.NoError(t, err)
+
+	w = execute("order-42")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
 
 	var response WorkflowExecution
-	err := json.NewDecoder(w.Body).Decode(&response)
+	err = json.NewDecoder(w.Body).Decode(&response)
 	require.NoError(t, err)
 
 	assert.NotNil(t, response.Id)
 	assert.Equal(t, createdWorkflow.Id, *response.WorkflowId)
 	assert.Equal(t, WorkflowRunStatusPending, *response.Status)
 	assert.NotNil(t, response.StartedAt)
-	assert.NotNil(t, response.Result)
-	assert.Equal(t, "Hello, World!", (*response.Result)["message"])
-	assert.Equal(t, float64(42), (*response.Result)["count"])
 
-	// Verify the execution was created in the database
-	var count int
-	err = db.QueryRow("SELECT COUNT(*) FROM workflow_executions WHERE id = $1", response.Id.String()).Scan(&count)
+	// The run was started from the deployed version with the input
+	require.Len(t, engine.runs, 1)
+	run := engine.runs[0]
+	assert.Equal(t, *response.Id, run.ID)
+	assert.Equal(t, versionID, run.VersionID)
+	assert.Equal(t, "Hello, World!", run.InputData["message"])
+	assert.Equal(t, "order-42", *run.IdempotencyKey)
+
+	// A repeated request returns the existing run
+	w = execute("order-42")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var repeated WorkflowExecution
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&repeated))
+	assert.Equal(t, *response.Id, *repeated.Id)
+
+	w = execute("")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Len(t, engine.runs, 2)
+}
+
+// finishingEngine is an execution engine whose runs finish as soon as they
+// start, with a fixed status, output and error
+type finishingEngine struct {
+	execution.ExecutionEngine
+	db *sql.DB
+
+	status    execution.WorkflowRunStatus
+	output    map[string]interface{}
+	errorData map[string]interface{}
+}
+
+func (e *finishingEngine) StartRun(ctx context.Context, run *execution.WorkflowRun) error {
+	var outputJSON, errorJSON []byte
+	if e.output != nil {
+		outputJSON, _ = json.Marshal(e.output)
+	}
+	if e.errorData != nil {
+		errorJSON, _ = json.Marshal(e.errorData)
+	}
+	_, err := e.db.ExecContext(ctx, `
+		INSERT INTO workflow_runs (id, workflow_id, version_id, status, output_data, error_data, completed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $4 IN ('completed', 'failed') THEN NOW() END)`,
+		run.ID, run.WorkflowID, run.VersionID, string(e.status), outputJSON, errorJSON)
+	return err
+}
+
+// TestOpenAPIExecuteWorkflowWait tests waiting for the result of a run
+func TestOpenAPIExecuteWorkflowWait(t *testing.T) {
+	db, cleanup := testutil.SetupOpenAPITestDB(t)
+	defer cleanup()
+
+	workflowID := uuid.New()
+	_, err := db.Exec(`INSERT INTO workflows (id, user_id, name, definition) VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Function', '{}')`, workflowID)
 	require.NoError(t, err)
-	assert.Equal(t, 1, count)
+	_, err = db.Exec(`INSERT INTO workflow_versions (workflow_id, version_number, name, definition, is_current) VALUES ($1, 1, 'v1', '{}', true)`,
+		workflowID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		engine     *finishingEngine
+		query      string
+		wantCode   int
+		wantStatus WorkflowRunStatus
+		wantResult map[string]interface{}
+		wantError  string
+	}{
+		{
+			name:       "completed",
+			engine:     &finishingEngine{status: execution.RunStatusCompleted, output: map[string]interface{}{"total": float64(99)}},
+			query:      "wait=true",
+			wantCode:   http.StatusOK,
+			wantStatus: WorkflowRunStatusCompleted,
+			wantResult: map[string]interface{}{"total": float64(99)},
+		},
+		{
+			name:       "failed",
+			engine:     &finishingEngine{status: execution.RunStatusFailed, errorData: map[string]interface{}{"error": "boom"}},
+			query:      "wait=true",
+			wantCode:   http.StatusOK,
+			wantStatus: WorkflowRunStatusFailed,
+			wantError:  "boom",
+		},
+		{
+			name:       "timed out",
+			engine:     &finishingEngine{status: execution.RunStatusRunning},
+			query:      "wait=true&timeout=1",
+			wantCode:   http.StatusOK,
+			wantStatus: WorkflowRunStatusRunning,
+		},
+		{
+			name:       "without wait",
+			engine:     &finishingEngine{status: execution.RunStatusCompleted, output: map[string]interface{}{"total": float64(99)}},
+			wantCode:   http.StatusOK,
+			wantStatus: WorkflowRunStatusPending,
+		},
+		{
+			name:     "invalid timeout",
+			engine:   &finishingEngine{status: execution.RunStatusCompleted},
+			query:    "wait=true&timeout=3600",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.engine.ExecutionEngine = execution.NewMockExecutionEngine()
+			tt.engine.db = db
+			router := NewOpenAPIRouter(db, tt.engine)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/workflows/%s/execute?%s", workflowID, tt.query), bytes.NewBufferString(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code, w.Body.String())
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var response WorkflowExecution
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			assert.Equal(t, tt.wantStatus, *response.Status)
+			if tt.wantResult != nil {
+				require.NotNil(t, response.Result)
+				assert.Equal(t, tt.wantResult, *response.Result)
+			} else {
+				assert.Nil(t, response.Result)
+			}
+			if tt.wantError != "" {
+				require.NotNil(t, response.Error)
+				assert.Equal(t, tt.wantError, *response.Error)
+				assert.NotNil(t, response.CompletedAt)
+			}
+		})
+	}
 }
 
 // TestOpenAPIExecuteWorkflowNotFound tests executing a non-existent workflow
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
 
@@ -22,10 +23,11 @@ func NewOpenAPIRouter(database *sql.DB, engine execution.ExecutionEngine) http.H
 	handlers := NewOpenAPIHandlers(database, engine, "")
 
 	// Create strict server
-	strictHandler := NewStrictHandler(handlers, nil)
+	strictHandler := NewStrictHandler(handlers, []StrictMiddlewareFunc{withHTTPRequest})
 
 	// Mount OpenAPI routes
 	HandlerFromMux(strictHandler, r)
+	mountWebhookAlias(r, strictHandler)
 
 	return r
 }
@@ -41,10 +43,11 @@ func NewCombinedRouter(database *sql.DB, engine execution.ExecutionEngine) http.
 
 	// Create OpenAPI handlers (empty string means use OPENAI_API_KEY env var)
 	openAPIHandlers := NewOpenAPIHandlers(database, engine, "")
-	strictHandler := NewStrictHandler(openAPIHandlers, nil)
+	strictHandler := NewStrictHandler(openAPIHandlers, []StrictMiddlewareFunc{withHTTPRequest})
 
 	// Mount all OpenAPI routes (which are prefixed with /api in the spec)
 	HandlerFromMux(strictHandler, r)
+	mountWebhookAlias(r, strictHandler)
 
 	// Essential legacy endpoints that aren't covered by OpenAPI
 	r.Route("/api", func(r chi.Router) {
@@ -54,3 +57,37 @@ func NewCombinedRouter(database *sql.DB, engine execution.ExecutionEngine) http.
 
 	return r
 }
+
+// mountWebhookAlias serves webhooks at /webhooks/{token} as well, their path
+// before they moved below /api, so that the URLs configured at providers keep
+// working
+func mountWebhookAlias(r chi.Router, si ServerInterface) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	}
+	r.Post("/webhooks/{token}", wrapper.HandleWebhook)
+}
+
+// requestContextKey is the context key of the HTTP request of handlers that
+// need more of it than their request object carries
+type requestContextKey struct{}
+
+// withHTTPRequest passes the HTTP request on to the webhook handler, which
+// starts runs with its method, headers and query
+func withHTTPRequest(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	if operationID != "HandleWebhook" {
+		return f
+	}
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return f(context.WithValue(ctx, requestContextKey{}, r), w, r, request)
+	}
+}
+
+// httpRequestFromContext returns the HTTP request stored by withHTTPRequest
+func httpRequestFromContext(ctx context.Context) (*http.Request, bool) {
+	r, ok := ctx.Value(requestContextKey{}).(*http.Request)
+	return r, ok
+}
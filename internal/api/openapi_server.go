@@ -3,6 +3,7 @@ package api
 import (
 	"database/sql"
 
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
 	"github.com/cedricziel/mel-agent/pkg/execution"
 )
 
@@ -11,13 +12,23 @@ type OpenAPIHandlers struct {
 	db            *sql.DB
 	engine        execution.ExecutionEngine
 	openAIAPIKey  string
+
+	// dataStore keeps the data remote workers share across instances
+	dataStore apiPkg.DataStore
 }
 
 // NewOpenAPIHandlers creates a new OpenAPI handlers instance
 func NewOpenAPIHandlers(database *sql.DB, engine execution.ExecutionEngine, openAIAPIKey string) *OpenAPIHandlers {
+	// Without a database the shared data only lives in this instance
+	var dataStore apiPkg.DataStore = apiPkg.NewMemoryDataStore()
+	if database != nil {
+		dataStore = apiPkg.NewPostgresDataStore(database)
+	}
+
 	return &OpenAPIHandlers{
 		db:           database,
 		engine:       engine,
 		openAIAPIKey: openAIAPIKey,
+		dataStore:    dataStore,
 	}
 }
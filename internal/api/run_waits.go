@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiPkg "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/execution"
+	"github.com/google/uuid"
+)
+
+// runPollInterval is how often requests waiting for a run check on it
+var runPollInterval = 250 * time.Millisecond
+
+// runOutcome is the state of a run a request waits for
+type runOutcome struct {
+	Status      execution.WorkflowRunStatus
+	Output      map[string]interface{}
+	Error       *string
+	CompletedAt *time.Time
+
+	// Response is the first response a node of the run recorded for the
+	// request that started it
+	Response *apiPkg.RunResponse
+}
+
+// awaitRun waits until a run finished or the timeout passed, and returns the
+// state of the run at that point. With untilResponse, it also stops waiting
+// once a node of the run recorded a response.
+func (h *OpenAPIHandlers) awaitRun(ctx context.Context, runID uuid.UUID, timeout time.Duration, untilResponse bool) (*runOutcome, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(runPollInterval)
+	defer ticker.Stop()
+
+	for {
+		outcome, err := h.loadRunOutcome(ctx, runID, untilResponse)
+		if err != nil || outcome.Status.IsTerminal() || outcome.Response != nil {
+			return outcome, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			return outcome, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// loadRunOutcome loads the status, output and error of a run, and with
+// withResponse the response recorded by its nodes
+func (h *OpenAPIHandlers) loadRunOutcome(ctx context.Context, runID uuid.UUID, withResponse bool) (*runOutcome, error) {
+	var outcome runOutcome
+	var outputJSON, errorJSON []byte
+	var completedAt sql.NullTime
+	err := h.db.QueryRowContext(ctx,
+		"SELECT status, output_data, error_data, completed_at FROM workflow_runs WHERE id = $1",
+		runID).Scan(&outcome.Status, &outputJSON, &errorJSON, &completedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run: %w", err)
+	}
+
+	if completedAt.Valid {
+		outcome.CompletedAt = &completedAt.Time
+	}
+	if len(outputJSON) > 0 {
+		if err := json.Unmarshal(outputJSON, &outcome.Output); err != nil {
+			return nil, fmt.Errorf("failed to decode run output: %w", err)
+		}
+	}
+	if len(errorJSON) > 0 {
+		var errorData map[string]interface{}
+		if err := json.Unmarshal(errorJSON, &errorData); err == nil {
+			if message, ok := errorData["error"].(string); ok {
+				outcome.Error = &message
+			}
+		}
+	}
+
+	if withResponse {
+		response, err := h.loadRunResponse(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+		outcome.Response = response
+	}
+
+	return &outcome, nil
+}
+
+// loadRunResponse loads the first response a node of a run recorded for the
+// request that started it, or nil
+func (h *OpenAPIHandlers) loadRunResponse(ctx context.Context, runID uuid.UUID) (*apiPkg.RunResponse, error) {
+	// Successors of the responding node pass its response on, and complete
+	// after it
+	var envelopeJSON []byte
+	err := h.db.QueryRowContext(ctx, `
+		SELECT output_envelope FROM workflow_steps
+		WHERE run_id = $1 AND status = 'completed' AND output_envelope->'meta' ? $2
+		ORDER BY completed_at, step_number
+		LIMIT 1`, runID, apiPkg.MetaHTTPResponse).Scan(&envelopeJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run response: %w", err)
+	}
+
+	var envelope apiPkg.Envelope[any]
+	if err := json.Unmarshal(envelopeJSON, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode run response: %w", err)
+	}
+	responseJSON, _ := envelope.GetMeta(apiPkg.MetaHTTPResponse)
+	var response apiPkg.RunResponse
+	if err := json.Unmarshal([]byte(responseJSON), &response); err != nil {
+		return nil, fmt.Errorf("failed to decode run response: %w", err)
+	}
+	return &response, nil
+}
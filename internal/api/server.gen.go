@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -82,6 +83,7 @@ const (
 const (
 	WorkflowRunStatusCompleted WorkflowRunStatus = "completed"
 	WorkflowRunStatusFailed    WorkflowRunStatus = "failed"
+	WorkflowRunStatusPaused    WorkflowRunStatus = "paused"
 	WorkflowRunStatusPending   WorkflowRunStatus = "pending"
 	WorkflowRunStatusRunning   WorkflowRunStatus = "running"
 )
@@ -93,6 +95,7 @@ const (
 	WorkflowStepStatusPending   WorkflowStepStatus = "pending"
 	WorkflowStepStatusRunning   WorkflowStepStatus = "running"
 	WorkflowStepStatusSkipped   WorkflowStepStatus = "skipped"
+	WorkflowStepStatusWaiting   WorkflowStepStatus = "waiting"
 )
 
 // AssistantChatRequest defines model for AssistantChatRequest.
@@ -168,13 +171,28 @@ type ChatUsage struct {
 type ClaimWorkRequest struct {
 	// MaxItems Maximum number of work items to claim
 	MaxItems *int `json:"maxItems,omitempty"`
+
+	// WaitSeconds Seconds to wait for work when none is available. The request returns as soon as work was claimed, so workers can long-poll instead of polling.
+	WaitSeconds *int `json:"waitSeconds,omitempty"`
 }
 
 // CompleteWorkRequest defines model for CompleteWorkRequest.
 type CompleteWorkRequest struct {
 	// Error Error message if the work item failed
-	Error  *string                 `json:"error,omitempty"`
-	Result *map[string]interface{} `json:"result,omitempty"`
+	Error *string `json:"error,omitempty"`
+
+	// LeaseEpoch Lease epoch of the claim on the work item. Workers whose claim was recovered and handed to another worker no longer hold the current lease.
+	LeaseEpoch int64 `json:"lease_epoch"`
+
+	// NextSteps Steps to queue after this work item
+	NextSteps *[]openapi_types.UUID   `json:"next_steps,omitempty"`
+	Result    *map[string]interface{} `json:"result,omitempty"`
+
+	// RetryDelayMs Delay before the retry is attempted
+	RetryDelayMs *int64 `json:"retry_delay_ms,omitempty"`
+
+	// ShouldRetry Whether a failed work item should be retried
+	ShouldRetry *bool `json:"should_retry,omitempty"`
 }
 
 // Connection defines model for Connection.
@@ -224,7 +242,10 @@ type CreateTriggerRequest struct {
 	// Config Trigger configuration containing trigger-specific parameters and settings
 	Config  *TriggerConfig `json:"config,omitempty"`
 	Enabled *bool          `json:"enabled,omitempty"`
-	Name    string         `json:"name"`
+
+	// MaxConcurrentRuns Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent or 0.
+	MaxConcurrentRuns *int   `json:"max_concurrent_runs,omitempty"`
+	Name              string `json:"name"`
 
 	// Type Type of trigger
 	Type       TriggerType        `json:"type"`
@@ -242,7 +263,7 @@ type CreateWorkflowEdgeRequest struct {
 
 // CreateWorkflowNodeRequest defines model for CreateWorkflowNodeRequest.
 type CreateWorkflowNodeRequest struct {
-	// Config Node configuration containing node-specific parameters and settings
+	// Config Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
 	Config NodeConfig `json:"config"`
 	Id     string     `json:"id"`
 	Name   string     `json:"name"`
@@ -256,7 +277,16 @@ type CreateWorkflowNodeRequest struct {
 type CreateWorkflowRequest struct {
 	Definition  *WorkflowDefinition `json:"definition,omitempty"`
 	Description *string             `json:"description,omitempty"`
-	Name        string              `json:"name"`
+
+	// ErrorWorkflowId Workflow started with the details of the failure whenever a run of this workflow fails
+	ErrorWorkflowId *openapi_types.UUID `json:"error_workflow_id,omitempty"`
+
+	// MaxConcurrentRuns Maximum number of runs of this workflow executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent or 0.
+	MaxConcurrentRuns *int `json:"max_concurrent_runs,omitempty"`
+
+	// MaxConcurrentSteps Maximum number of steps of this workflow executing at the same time across all of its runs. Unlimited when absent or 0.
+	MaxConcurrentSteps *int   `json:"max_concurrent_steps,omitempty"`
+	Name               string `json:"name"`
 }
 
 // CreateWorkflowVersionRequest defines model for CreateWorkflowVersionRequest.
@@ -309,6 +339,44 @@ type CredentialTypeSchema struct {
 	Type        *string                 `json:"type,omitempty"`
 }
 
+// DeadLetter A work item that ran out of attempts
+type DeadLetter struct {
+	AttemptCount int `json:"attempt_count"`
+
+	// CreatedAt When the work item was queued
+	CreatedAt      time.Time `json:"created_at"`
+	DeadLetteredAt time.Time `json:"dead_lettered_at"`
+
+	// Envelope Serialized data envelope flowing between workflow nodes
+	Envelope *Envelope `json:"envelope,omitempty"`
+
+	// Id ID of the work item
+	Id          openapi_types.UUID `json:"id"`
+	LastError   *string            `json:"last_error,omitempty"`
+	MaxAttempts int                `json:"max_attempts"`
+
+	// Payload Generic payload object containing arbitrary data
+	Payload   *GenericPayload     `json:"payload,omitempty"`
+	Priority  *int                `json:"priority,omitempty"`
+	QueueType string              `json:"queue_type"`
+	RunId     openapi_types.UUID  `json:"run_id"`
+	StepId    *openapi_types.UUID `json:"step_id,omitempty"`
+
+	// WorkerId Worker that processed the item last
+	WorkerId *string `json:"worker_id,omitempty"`
+}
+
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	DeadLetters []DeadLetter `json:"dead_letters"`
+	Limit       int          `json:"limit"`
+	Page        int          `json:"page"`
+	Total       int          `json:"total"`
+}
+
+// Envelope Serialized data envelope flowing between workflow nodes
+type Envelope map[string]interface{}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *int    `json:"code,omitempty"`
@@ -361,6 +429,24 @@ type GenericPayload map[string]interface{}
 // GenericResult Generic result object containing arbitrary result data
 type GenericResult map[string]interface{}
 
+// InactiveRunsRequest defines model for InactiveRunsRequest.
+type InactiveRunsRequest struct {
+	// RunIds Runs the worker is executing steps for
+	RunIds []openapi_types.UUID `json:"run_ids"`
+}
+
+// InactiveRunsResponse defines model for InactiveRunsResponse.
+type InactiveRunsResponse struct {
+	// RunIds Runs that were cancelled or have otherwise finished. Their steps should stop executing.
+	RunIds []openapi_types.UUID `json:"run_ids"`
+}
+
+// InitializeRunResponse defines model for InitializeRunResponse.
+type InitializeRunResponse struct {
+	// NextSteps Entry point steps that are ready to execute
+	NextSteps []openapi_types.UUID `json:"next_steps"`
+}
+
 // Integration defines model for Integration.
 type Integration struct {
 	Capabilities *[]string  `json:"capabilities,omitempty"`
@@ -381,7 +467,7 @@ type Integration struct {
 // IntegrationStatus Status of an integration
 type IntegrationStatus string
 
-// NodeConfig Node configuration containing node-specific parameters and settings
+// NodeConfig Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
 type NodeConfig map[string]interface{}
 
 // NodeInput defines model for NodeInput.
@@ -476,9 +562,72 @@ type ParamSpec struct {
 
 // RegisterWorkerRequest defines model for RegisterWorkerRequest.
 type RegisterWorkerRequest struct {
-	Concurrency *int    `json:"concurrency,omitempty"`
-	Id          string  `json:"id"`
-	Name        *string `json:"name,omitempty"`
+	// Capabilities Node types and labels the worker can execute. "*" matches every node type but no labels.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+	Concurrency  *int      `json:"concurrency,omitempty"`
+	Id           string    `json:"id"`
+	Name         *string   `json:"name,omitempty"`
+}
+
+// ReplayWorkflowRunRequest defines model for ReplayWorkflowRunRequest.
+type ReplayWorkflowRunRequest struct {
+	// InputEnvelope Input envelope of the node. Defaults to the input the node received in the original run.
+	InputEnvelope *map[string]interface{} `json:"input_envelope,omitempty"`
+
+	// NodeId Node to replay the run from
+	NodeId string `json:"node_id"`
+}
+
+// SignalResponse defines model for SignalResponse.
+type SignalResponse struct {
+	RunId  openapi_types.UUID `json:"run_id"`
+	Signal string             `json:"signal"`
+
+	// StepId Step that received the signal and continues the workflow
+	StepId openapi_types.UUID `json:"step_id"`
+}
+
+// StepHeartbeatRequest defines model for StepHeartbeatRequest.
+type StepHeartbeatRequest struct {
+	// LeaseEpoch Lease epoch of the execution, as returned when the step was fetched
+	LeaseEpoch int64 `json:"lease_epoch"`
+}
+
+// StepResultRequest defines model for StepResultRequest.
+type StepResultRequest struct {
+	// Error Error message if the node execution failed
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Code classifying the error, such as validation, timeout or upstream. Determines whether the step is retried.
+	ErrorCode *string `json:"error_code,omitempty"`
+
+	// LeaseEpoch Lease epoch of the execution, as returned when the step was fetched
+	LeaseEpoch int64 `json:"lease_epoch"`
+
+	// OutputEnvelope Serialized data envelope flowing between workflow nodes
+	OutputEnvelope *Envelope `json:"output_envelope,omitempty"`
+}
+
+// StepResultResponse defines model for StepResultResponse.
+type StepResultResponse struct {
+	Error *string `json:"error,omitempty"`
+
+	// NextSteps Steps that became ready to execute
+	NextSteps *[]openapi_types.UUID `json:"next_steps,omitempty"`
+
+	// RetryDelayMs Delay before the step is retried
+	RetryDelayMs *int64 `json:"retry_delay_ms,omitempty"`
+	ShouldRetry  *bool  `json:"should_retry,omitempty"`
+	Success      bool   `json:"success"`
+}
+
+// StoreWorkerDataRequest defines model for StoreWorkerDataRequest.
+type StoreWorkerDataRequest struct {
+	// Data The data to store, of any JSON type
+	Data interface{} `json:"data"`
+
+	// TtlSeconds Seconds until the data expires
+	TtlSeconds float64 `json:"ttl_seconds"`
 }
 
 // Trigger defines model for Trigger.
@@ -488,7 +637,10 @@ type Trigger struct {
 	CreatedAt *time.Time          `json:"created_at,omitempty"`
 	Enabled   *bool               `json:"enabled,omitempty"`
 	Id        *openapi_types.UUID `json:"id,omitempty"`
-	Name      *string             `json:"name,omitempty"`
+
+	// MaxConcurrentRuns Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent.
+	MaxConcurrentRuns *int    `json:"max_concurrent_runs,omitempty"`
+	Name              *string `json:"name,omitempty"`
 
 	// Type Type of trigger
 	Type       *TriggerType        `json:"type,omitempty"`
@@ -522,7 +674,10 @@ type UpdateTriggerRequest struct {
 	// Config Trigger configuration containing trigger-specific parameters and settings
 	Config  *TriggerConfig `json:"config,omitempty"`
 	Enabled *bool          `json:"enabled,omitempty"`
-	Name    *string        `json:"name,omitempty"`
+
+	// MaxConcurrentRuns Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. 0 removes the limit.
+	MaxConcurrentRuns *int    `json:"max_concurrent_runs,omitempty"`
+	Name              *string `json:"name,omitempty"`
 }
 
 // UpdateWorkflowDraftRequest defines model for UpdateWorkflowDraftRequest.
@@ -532,7 +687,7 @@ type UpdateWorkflowDraftRequest struct {
 
 // UpdateWorkflowNodeRequest defines model for UpdateWorkflowNodeRequest.
 type UpdateWorkflowNodeRequest struct {
-	// Config Node configuration containing node-specific parameters and settings
+	// Config Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
 	Config *NodeConfig `json:"config,omitempty"`
 	Name   *string     `json:"name,omitempty"`
 
@@ -544,7 +699,16 @@ type UpdateWorkflowNodeRequest struct {
 type UpdateWorkflowRequest struct {
 	Definition  *WorkflowDefinition `json:"definition,omitempty"`
 	Description *string             `json:"description,omitempty"`
-	Name        *string             `json:"name,omitempty"`
+
+	// ErrorWorkflowId Workflow started with the details of the failure whenever a run of this workflow fails. The nil UUID removes the error workflow.
+	ErrorWorkflowId *openapi_types.UUID `json:"error_workflow_id,omitempty"`
+
+	// MaxConcurrentRuns Maximum number of runs of this workflow executing at the same time. Further runs stay pending until a run finishes. 0 removes the limit.
+	MaxConcurrentRuns *int `json:"max_concurrent_runs,omitempty"`
+
+	// MaxConcurrentSteps Maximum number of steps of this workflow executing at the same time across all of its runs. 0 removes the limit.
+	MaxConcurrentSteps *int    `json:"max_concurrent_steps,omitempty"`
+	Name               *string `json:"name,omitempty"`
 }
 
 // ValidatorSpec defines model for ValidatorSpec.
@@ -602,11 +766,20 @@ type WebhookPayload4 = int
 // WebhookPayload5 JSON boolean (true/false)
 type WebhookPayload5 = bool
 
+// WebhookRun defines model for WebhookRun.
+type WebhookRun struct {
+	// RunId ID of the run the webhook started, or of the run a repeated delivery with the same idempotency key started
+	RunId openapi_types.UUID `json:"run_id"`
+}
+
 // WorkItem defines model for WorkItem.
 type WorkItem struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	Id        *string    `json:"id,omitempty"`
 
+	// LeaseEpoch Lease of the claim, to pass back when completing the work item
+	LeaseEpoch *int64 `json:"lease_epoch,omitempty"`
+
 	// Payload Generic payload object containing arbitrary data
 	Payload *GenericPayload `json:"payload,omitempty"`
 	Type    *string         `json:"type,omitempty"`
@@ -614,6 +787,7 @@ type WorkItem struct {
 
 // Worker defines model for Worker.
 type Worker struct {
+	Capabilities  *[]string  `json:"capabilities,omitempty"`
 	Concurrency   *int       `json:"concurrency,omitempty"`
 	Id            *string    `json:"id,omitempty"`
 	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
@@ -624,17 +798,51 @@ type Worker struct {
 	Status *WorkerStatus `json:"status,omitempty"`
 }
 
+// WorkerData defines model for WorkerData.
+type WorkerData struct {
+	// Data The stored data, of any JSON type
+	Data interface{} `json:"data"`
+}
+
 // WorkerStatus Status of a worker
 type WorkerStatus string
 
+// WorkerStep defines model for WorkerStep.
+type WorkerStep struct {
+	AttemptCount *int               `json:"attempt_count,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// InputEnvelope Serialized data envelope flowing between workflow nodes
+	InputEnvelope *Envelope `json:"input_envelope,omitempty"`
+
+	// LeaseEpoch Lease of this execution of the step, to pass back with its result
+	LeaseEpoch  int64 `json:"lease_epoch"`
+	MaxAttempts *int  `json:"max_attempts,omitempty"`
+
+	// NodeConfig Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
+	NodeConfig *NodeConfig        `json:"node_config,omitempty"`
+	NodeId     string             `json:"node_id"`
+	NodeType   string             `json:"node_type"`
+	RunId      openapi_types.UUID `json:"run_id"`
+}
+
 // Workflow defines model for Workflow.
 type Workflow struct {
 	CreatedAt   time.Time           `json:"created_at"`
 	Definition  *WorkflowDefinition `json:"definition,omitempty"`
 	Description *string             `json:"description,omitempty"`
-	Id          openapi_types.UUID  `json:"id"`
-	Name        string              `json:"name"`
-	UpdatedAt   time.Time           `json:"updated_at"`
+
+	// ErrorWorkflowId Workflow started with the details of the failure whenever a run of this workflow fails
+	ErrorWorkflowId *openapi_types.UUID `json:"error_workflow_id,omitempty"`
+	Id              openapi_types.UUID  `json:"id"`
+
+	// MaxConcurrentRuns Maximum number of runs of this workflow executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent.
+	MaxConcurrentRuns *int `json:"max_concurrent_runs,omitempty"`
+
+	// MaxConcurrentSteps Maximum number of steps of this workflow executing at the same time across all of its runs. Unlimited when absent.
+	MaxConcurrentSteps *int      `json:"max_concurrent_steps,omitempty"`
+	Name               string    `json:"name"`
+	UpdatedAt          time.Time `json:"updated_at"`
 }
 
 // WorkflowDefinition defines model for WorkflowDefinition.
@@ -693,7 +901,7 @@ type WorkflowList struct {
 
 // WorkflowNode defines model for WorkflowNode.
 type WorkflowNode struct {
-	// Config Node configuration containing node-specific parameters and settings
+	// Config Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
 	Config NodeConfig `json:"config"`
 	Id     string     `json:"id"`
 	Name   string     `json:"name"`
@@ -775,6 +983,13 @@ type ListCredentialsParams struct {
 	CredentialType *string `form:"credential_type,omitempty" json:"credential_type,omitempty"`
 }
 
+// ListDeadLettersParams defines parameters for ListDeadLetters.
+type ListDeadLettersParams struct {
+	RunId *openapi_types.UUID `form:"run_id,omitempty" json:"run_id,omitempty"`
+	Page  *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListNodeTypesParams defines parameters for ListNodeTypes.
 type ListNodeTypesParams struct {
 	// Kind Filter by node kind (can be comma-separated)
@@ -787,6 +1002,10 @@ type GetNodeParameterOptionsParams struct {
 	Context *string `form:"context,omitempty" json:"context,omitempty"`
 }
 
+// HandleWebhookFormdataBody defines parameters for HandleWebhook.
+type HandleWebhookFormdataBody struct {
+}
+
 // ListWorkflowRunsParams defines parameters for ListWorkflowRuns.
 type ListWorkflowRunsParams struct {
 	WorkflowId *openapi_types.UUID `form:"workflow_id,omitempty" json:"workflow_id,omitempty"`
@@ -795,6 +1014,14 @@ type ListWorkflowRunsParams struct {
 	Limit      *int                `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// SignalWorkflowRunJSONBody defines parameters for SignalWorkflowRun.
+type SignalWorkflowRunJSONBody map[string]interface{}
+
+// SignalWorkflowRunParams defines parameters for SignalWorkflowRun.
+type SignalWorkflowRunParams struct {
+	Token string `form:"token" json:"token"`
+}
+
 // ListWorkflowsParams defines parameters for ListWorkflows.
 type ListWorkflowsParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
@@ -806,6 +1033,18 @@ type ExecuteWorkflowJSONBody struct {
 	Input *map[string]interface{} `json:"input,omitempty"`
 }
 
+// ExecuteWorkflowParams defines parameters for ExecuteWorkflow.
+type ExecuteWorkflowParams struct {
+	// Wait Wait for the run to finish and return its output as the result
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// Timeout Seconds to wait for the run to finish before returning its current status
+	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// IdempotencyKey Repeated requests with the same key return the run the first request started, while the key is retained
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
 // ListWorkflowVersionsParams defines parameters for ListWorkflowVersions.
 type ListWorkflowVersionsParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
@@ -830,6 +1069,12 @@ type CreateTriggerJSONRequestBody = CreateTriggerRequest
 // UpdateTriggerJSONRequestBody defines body for UpdateTrigger for application/json ContentType.
 type UpdateTriggerJSONRequestBody = UpdateTriggerRequest
 
+// HandleWebhookJSONRequestBody defines body for HandleWebhook for application/json ContentType.
+type HandleWebhookJSONRequestBody = WebhookPayload
+
+// HandleWebhookFormdataRequestBody defines body for HandleWebhook for application/x-www-form-urlencoded ContentType.
+type HandleWebhookFormdataRequestBody HandleWebhookFormdataBody
+
 // RegisterWorkerJSONRequestBody defines body for RegisterWorker for application/json ContentType.
 type RegisterWorkerJSONRequestBody = RegisterWorkerRequest
 
@@ -839,6 +1084,24 @@ type ClaimWorkJSONRequestBody = ClaimWorkRequest
 // CompleteWorkJSONRequestBody defines body for CompleteWork for application/json ContentType.
 type CompleteWorkJSONRequestBody = CompleteWorkRequest
 
+// StoreWorkerDataJSONRequestBody defines body for StoreWorkerData for application/json ContentType.
+type StoreWorkerDataJSONRequestBody = StoreWorkerDataRequest
+
+// ListInactiveWorkerRunsJSONRequestBody defines body for ListInactiveWorkerRuns for application/json ContentType.
+type ListInactiveWorkerRunsJSONRequestBody = InactiveRunsRequest
+
+// ReportStepHeartbeatJSONRequestBody defines body for ReportStepHeartbeat for application/json ContentType.
+type ReportStepHeartbeatJSONRequestBody = StepHeartbeatRequest
+
+// ReportStepResultJSONRequestBody defines body for ReportStepResult for application/json ContentType.
+type ReportStepResultJSONRequestBody = StepResultRequest
+
+// ReplayWorkflowRunJSONRequestBody defines body for ReplayWorkflowRun for application/json ContentType.
+type ReplayWorkflowRunJSONRequestBody = ReplayWorkflowRunRequest
+
+// SignalWorkflowRunJSONRequestBody defines body for SignalWorkflowRun for application/json ContentType.
+type SignalWorkflowRunJSONRequestBody SignalWorkflowRunJSONBody
+
 // CreateWorkflowJSONRequestBody defines body for CreateWorkflow for application/json ContentType.
 type CreateWorkflowJSONRequestBody = CreateWorkflowRequest
 
@@ -866,9 +1129,6 @@ type UpdateWorkflowNodeJSONRequestBody = UpdateWorkflowNodeRequest
 // CreateWorkflowVersionJSONRequestBody defines body for CreateWorkflowVersion for application/json ContentType.
 type CreateWorkflowVersionJSONRequestBody = CreateWorkflowVersionRequest
 
-// HandleWebhookJSONRequestBody defines body for HandleWebhook for application/json ContentType.
-type HandleWebhookJSONRequestBody = WebhookPayload
-
 // AsWebhookPayload0 returns the union data inside the WebhookPayload as a WebhookPayload0
 func (t WebhookPayload) AsWebhookPayload0() (WebhookPayload0, error) {
 	var body WebhookPayload0
@@ -1233,6 +1493,18 @@ type ServerInterface interface {
 	// List credentials for selection in nodes
 	// (GET /api/credentials)
 	ListCredentials(w http.ResponseWriter, r *http.Request, params ListCredentialsParams)
+	// List dead letters
+	// (GET /api/dead-letters)
+	ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams)
+	// Discard a dead letter
+	// (DELETE /api/dead-letters/{id})
+	DiscardDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a dead letter
+	// (GET /api/dead-letters/{id})
+	GetDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Requeue a dead letter
+	// (POST /api/dead-letters/{id}/requeue)
+	RequeueDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List available extensions and plugins
 	// (GET /api/extensions)
 	ListExtensions(w http.ResponseWriter, r *http.Request)
@@ -1263,6 +1535,9 @@ type ServerInterface interface {
 	// Update trigger
 	// (PUT /api/triggers/{id})
 	UpdateTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Webhook endpoint
+	// (POST /api/webhooks/{token})
+	HandleWebhook(w http.ResponseWriter, r *http.Request, token string)
 	// List all workers
 	// (GET /api/workers)
 	ListWorkers(w http.ResponseWriter, r *http.Request)
@@ -1278,15 +1553,48 @@ type ServerInterface interface {
 	// Complete a work item
 	// (POST /api/workers/{id}/complete-work/{itemId})
 	CompleteWork(w http.ResponseWriter, r *http.Request, id string, itemId string)
+	// Delete shared data
+	// (DELETE /api/workers/{id}/data/{key})
+	DeleteWorkerData(w http.ResponseWriter, r *http.Request, id string, key string)
+	// Retrieve shared data
+	// (GET /api/workers/{id}/data/{key})
+	GetWorkerData(w http.ResponseWriter, r *http.Request, id string, key string)
+	// Store shared data
+	// (PUT /api/workers/{id}/data/{key})
+	StoreWorkerData(w http.ResponseWriter, r *http.Request, id string, key string)
 	// Update worker heartbeat
 	// (PUT /api/workers/{id}/heartbeat)
 	UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request, id string)
+	// Check for inactive runs
+	// (POST /api/workers/{id}/inactive-runs)
+	ListInactiveWorkerRuns(w http.ResponseWriter, r *http.Request, id string)
+	// Finalize a workflow run
+	// (POST /api/workers/{id}/runs/{runId}/finalize)
+	FinalizeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID)
+	// Initialize a workflow run
+	// (POST /api/workers/{id}/runs/{runId}/initialize)
+	InitializeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID)
+	// Report a step heartbeat
+	// (PUT /api/workers/{id}/steps/{stepId}/heartbeat)
+	ReportStepHeartbeat(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID)
+	// Report a step result
+	// (POST /api/workers/{id}/steps/{stepId}/result)
+	ReportStepResult(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID)
+	// Start executing a step
+	// (POST /api/workers/{id}/steps/{stepId}/start)
+	StartWorkerStep(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID)
 	// List workflow runs
 	// (GET /api/workflow-runs)
 	ListWorkflowRuns(w http.ResponseWriter, r *http.Request, params ListWorkflowRunsParams)
 	// Get workflow run details
 	// (GET /api/workflow-runs/{id})
 	GetWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replay a workflow run from a node
+	// (POST /api/workflow-runs/{id}/replay)
+	ReplayWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Send a signal to a workflow run
+	// (POST /api/workflow-runs/{id}/signals/{name})
+	SignalWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, name string, params SignalWorkflowRunParams)
 	// Get workflow run steps
 	// (GET /api/workflow-runs/{id}/steps)
 	GetWorkflowRunSteps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -1307,7 +1615,7 @@ type ServerInterface interface {
 	UpdateWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Execute a workflow
 	// (POST /api/workflows/{id}/execute)
-	ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExecuteWorkflowParams)
 	// Get current draft for a workflow
 	// (GET /api/workflows/{workflowId}/draft)
 	GetWorkflowDraft(w http.ResponseWriter, r *http.Request, workflowId openapi_types.UUID)
@@ -1356,9 +1664,6 @@ type ServerInterface interface {
 	// Deploy a specific workflow version
 	// (POST /api/workflows/{workflowId}/versions/{versionNumber}/deploy)
 	DeployWorkflowVersion(w http.ResponseWriter, r *http.Request, workflowId openapi_types.UUID, versionNumber int)
-	// Webhook endpoint
-	// (POST /webhooks/{token})
-	HandleWebhook(w http.ResponseWriter, r *http.Request, token string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -1425,6 +1730,30 @@ func (_ Unimplemented) ListCredentials(w http.ResponseWriter, r *http.Request, p
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List dead letters
+// (GET /api/dead-letters)
+func (_ Unimplemented) ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Discard a dead letter
+// (DELETE /api/dead-letters/{id})
+func (_ Unimplemented) DiscardDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a dead letter
+// (GET /api/dead-letters/{id})
+func (_ Unimplemented) GetDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Requeue a dead letter
+// (POST /api/dead-letters/{id}/requeue)
+func (_ Unimplemented) RequeueDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List available extensions and plugins
 // (GET /api/extensions)
 func (_ Unimplemented) ListExtensions(w http.ResponseWriter, r *http.Request) {
@@ -1485,6 +1814,12 @@ func (_ Unimplemented) UpdateTrigger(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Webhook endpoint
+// (POST /api/webhooks/{token})
+func (_ Unimplemented) HandleWebhook(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all workers
 // (GET /api/workers)
 func (_ Unimplemented) ListWorkers(w http.ResponseWriter, r *http.Request) {
@@ -1515,12 +1850,66 @@ func (_ Unimplemented) CompleteWork(w http.ResponseWriter, r *http.Request, id s
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete shared data
+// (DELETE /api/workers/{id}/data/{key})
+func (_ Unimplemented) DeleteWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retrieve shared data
+// (GET /api/workers/{id}/data/{key})
+func (_ Unimplemented) GetWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store shared data
+// (PUT /api/workers/{id}/data/{key})
+func (_ Unimplemented) StoreWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update worker heartbeat
 // (PUT /api/workers/{id}/heartbeat)
 func (_ Unimplemented) UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check for inactive runs
+// (POST /api/workers/{id}/inactive-runs)
+func (_ Unimplemented) ListInactiveWorkerRuns(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finalize a workflow run
+// (POST /api/workers/{id}/runs/{runId}/finalize)
+func (_ Unimplemented) FinalizeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Initialize a workflow run
+// (POST /api/workers/{id}/runs/{runId}/initialize)
+func (_ Unimplemented) InitializeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report a step heartbeat
+// (PUT /api/workers/{id}/steps/{stepId}/heartbeat)
+func (_ Unimplemented) ReportStepHeartbeat(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report a step result
+// (POST /api/workers/{id}/steps/{stepId}/result)
+func (_ Unimplemented) ReportStepResult(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start executing a step
+// (POST /api/workers/{id}/steps/{stepId}/start)
+func (_ Unimplemented) StartWorkerStep(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List workflow runs
 // (GET /api/workflow-runs)
 func (_ Unimplemented) ListWorkflowRuns(w http.ResponseWriter, r *http.Request, params ListWorkflowRunsParams) {
@@ -1533,6 +1922,18 @@ func (_ Unimplemented) GetWorkflowRun(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Replay a workflow run from a node
+// (POST /api/workflow-runs/{id}/replay)
+func (_ Unimplemented) ReplayWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a signal to a workflow run
+// (POST /api/workflow-runs/{id}/signals/{name})
+func (_ Unimplemented) SignalWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, name string, params SignalWorkflowRunParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get workflow run steps
 // (GET /api/workflow-runs/{id}/steps)
 func (_ Unimplemented) GetWorkflowRunSteps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -1571,7 +1972,7 @@ func (_ Unimplemented) UpdateWorkflow(w http.ResponseWriter, r *http.Request, id
 
 // Execute a workflow
 // (POST /api/workflows/{id}/execute)
-func (_ Unimplemented) ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (_ Unimplemented) ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExecuteWorkflowParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1671,12 +2072,6 @@ func (_ Unimplemented) DeployWorkflowVersion(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Webhook endpoint
-// (POST /webhooks/{token})
-func (_ Unimplemented) HandleWebhook(w http.ResponseWriter, r *http.Request, token string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
-
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -1974,8 +2369,10 @@ func (siw *ServerInterfaceWrapper) ListCredentials(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListExtensions operation middleware
-func (siw *ServerInterfaceWrapper) ListExtensions(w http.ResponseWriter, r *http.Request) {
+// ListDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1985,30 +2382,35 @@ func (siw *ServerInterfaceWrapper) ListExtensions(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListExtensions(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeadLettersParams
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "run_id" -------------
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "run_id", r.URL.Query(), &params.RunId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "page" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.ListDeadLetters(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2018,8 +2420,19 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListIntegrations operation middleware
-func (siw *ServerInterfaceWrapper) ListIntegrations(w http.ResponseWriter, r *http.Request) {
+// DiscardDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) DiscardDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2030,7 +2443,7 @@ func (siw *ServerInterfaceWrapper) ListIntegrations(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListIntegrations(w, r)
+		siw.Handler.DiscardDeadLetter(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2040,11 +2453,20 @@ func (siw *ServerInterfaceWrapper) ListIntegrations(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListNodeTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListNodeTypes(w http.ResponseWriter, r *http.Request) {
+// GetDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) GetDeadLetter(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2053,19 +2475,8 @@ func (siw *ServerInterfaceWrapper) ListNodeTypes(w http.ResponseWriter, r *http.
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNodeTypesParams
-
-	// ------------- Optional query parameter "kind" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListNodeTypes(w, r, params)
+		siw.Handler.GetDeadLetter(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2075,26 +2486,17 @@ func (siw *ServerInterfaceWrapper) ListNodeTypes(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetNodeParameterOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetNodeParameterOptions(w http.ResponseWriter, r *http.Request) {
+// RequeueDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) RequeueDeadLetter(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "type" -------------
-	var pType string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "type", chi.URLParam(r, "type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "parameter" -------------
-	var parameter string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "parameter", chi.URLParam(r, "parameter"), &parameter, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parameter", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -2106,19 +2508,8 @@ func (siw *ServerInterfaceWrapper) GetNodeParameterOptions(w http.ResponseWriter
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeParameterOptionsParams
-
-	// ------------- Optional query parameter "context" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "context", r.URL.Query(), &params.Context)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "context", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNodeParameterOptions(w, r, pType, parameter, params)
+		siw.Handler.RequeueDeadLetter(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2128,7 +2519,161 @@ func (siw *ServerInterfaceWrapper) GetNodeParameterOptions(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// ListTriggers operation middleware
+// ListExtensions operation middleware
+func (siw *ServerInterfaceWrapper) ListExtensions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExtensions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListIntegrations operation middleware
+func (siw *ServerInterfaceWrapper) ListIntegrations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIntegrations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNodeTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListNodeTypes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNodeTypesParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNodeTypes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNodeParameterOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNodeParameterOptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", chi.URLParam(r, "type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "parameter" -------------
+	var parameter string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "parameter", chi.URLParam(r, "parameter"), &parameter, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parameter", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeParameterOptionsParams
+
+	// ------------- Optional query parameter "context" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "context", r.URL.Query(), &params.Context)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "context", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNodeParameterOptions(w, r, pType, parameter, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTriggers operation middleware
 func (siw *ServerInterfaceWrapper) ListTriggers(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
@@ -2271,6 +2816,39 @@ func (siw *ServerInterfaceWrapper) UpdateTrigger(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// HandleWebhook operation middleware
+func (siw *ServerInterfaceWrapper) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleWebhook(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListWorkers operation middleware
 func (siw *ServerInterfaceWrapper) ListWorkers(w http.ResponseWriter, r *http.Request) {
 
@@ -2423,8 +3001,8 @@ func (siw *ServerInterfaceWrapper) CompleteWork(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWorkerHeartbeat operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request) {
+// DeleteWorkerData operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkerData(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2437,6 +3015,15 @@ func (siw *ServerInterfaceWrapper) UpdateWorkerHeartbeat(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2446,7 +3033,7 @@ func (siw *ServerInterfaceWrapper) UpdateWorkerHeartbeat(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWorkerHeartbeat(w, r, id)
+		siw.Handler.DeleteWorkerData(w, r, id, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2456,56 +3043,39 @@ func (siw *ServerInterfaceWrapper) UpdateWorkerHeartbeat(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflowRuns operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *http.Request) {
+// GetWorkerData operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkerData(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWorkflowRunsParams
-
-	// ------------- Optional query parameter "workflow_id" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "workflow_id", r.URL.Query(), &params.WorkflowId)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Path parameter "key" -------------
+	var key string
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflowRuns(w, r, params)
+		siw.Handler.GetWorkerData(w, r, id, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2515,13 +3085,13 @@ func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowRun operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http.Request) {
+// StoreWorkerData operation middleware
+func (siw *ServerInterfaceWrapper) StoreWorkerData(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2529,6 +3099,15 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2538,7 +3117,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflowRun(w, r, id)
+		siw.Handler.StoreWorkerData(w, r, id, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2548,13 +3127,13 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowRunSteps operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowRunSteps(w http.ResponseWriter, r *http.Request) {
+// UpdateWorkerHeartbeat operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2571,7 +3150,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRunSteps(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflowRunSteps(w, r, id)
+		siw.Handler.UpdateWorkerHeartbeat(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2581,52 +3160,20 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRunSteps(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflows operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflows(w http.ResponseWriter, r *http.Request) {
+// ListInactiveWorkerRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListInactiveWorkerRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWorkflowsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflows(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// CreateWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2636,7 +3183,7 @@ func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflow(w, r)
+		siw.Handler.ListInactiveWorkerRuns(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2646,13 +3193,13 @@ func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWorkflow(w http.ResponseWriter, r *http.Request) {
+// FinalizeWorkerRun operation middleware
+func (siw *ServerInterfaceWrapper) FinalizeWorkerRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2660,6 +3207,15 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflow(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", chi.URLParam(r, "runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2669,7 +3225,7 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflow(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWorkflow(w, r, id)
+		siw.Handler.FinalizeWorkerRun(w, r, id, runId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2679,13 +3235,13 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+// InitializeWorkerRun operation middleware
+func (siw *ServerInterfaceWrapper) InitializeWorkerRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2693,6 +3249,15 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", chi.URLParam(r, "runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2702,7 +3267,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflow(w, r, id)
+		siw.Handler.InitializeWorkerRun(w, r, id, runId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2712,13 +3277,13 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http.Request) {
+// ReportStepHeartbeat operation middleware
+func (siw *ServerInterfaceWrapper) ReportStepHeartbeat(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2726,6 +3291,15 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "stepId" -------------
+	var stepId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stepId", chi.URLParam(r, "stepId"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stepId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2735,7 +3309,7 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWorkflow(w, r, id)
+		siw.Handler.ReportStepHeartbeat(w, r, id, stepId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2745,13 +3319,13 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ExecuteWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) ExecuteWorkflow(w http.ResponseWriter, r *http.Request) {
+// ReportStepResult operation middleware
+func (siw *ServerInterfaceWrapper) ReportStepResult(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2759,6 +3333,15 @@ func (siw *ServerInterfaceWrapper) ExecuteWorkflow(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "stepId" -------------
+	var stepId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stepId", chi.URLParam(r, "stepId"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stepId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2768,7 +3351,7 @@ func (siw *ServerInterfaceWrapper) ExecuteWorkflow(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExecuteWorkflow(w, r, id)
+		siw.Handler.ReportStepResult(w, r, id, stepId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2778,17 +3361,26 @@ func (siw *ServerInterfaceWrapper) ExecuteWorkflow(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowDraft operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowDraft(w http.ResponseWriter, r *http.Request) {
+// StartWorkerStep operation middleware
+func (siw *ServerInterfaceWrapper) StartWorkerStep(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stepId" -------------
+	var stepId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stepId", chi.URLParam(r, "stepId"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stepId", Err: err})
 		return
 	}
 
@@ -2801,7 +3393,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflowDraft(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflowDraft(w, r, workflowId)
+		siw.Handler.StartWorkerStep(w, r, id, stepId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2811,20 +3403,11 @@ func (siw *ServerInterfaceWrapper) GetWorkflowDraft(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWorkflowDraft operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWorkflowDraft(w http.ResponseWriter, r *http.Request) {
+// ListWorkflowRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2833,50 +3416,43 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflowDraft(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWorkflowDraft(w, r, workflowId)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// TestWorkflowDraftNode operation middleware
-func (siw *ServerInterfaceWrapper) TestWorkflowDraftNode(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkflowRunsParams
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Optional query parameter "workflow_id" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "workflow_id", r.URL.Query(), &params.WorkflowId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "page" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TestWorkflowDraftNode(w, r, workflowId, nodeId)
+		siw.Handler.ListWorkflowRuns(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2886,17 +3462,17 @@ func (siw *ServerInterfaceWrapper) TestWorkflowDraftNode(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflowEdges operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflowEdges(w http.ResponseWriter, r *http.Request) {
+// GetWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -2909,7 +3485,7 @@ func (siw *ServerInterfaceWrapper) ListWorkflowEdges(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflowEdges(w, r, workflowId)
+		siw.Handler.GetWorkflowRun(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2919,17 +3495,17 @@ func (siw *ServerInterfaceWrapper) ListWorkflowEdges(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflowEdge operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflowEdge(w http.ResponseWriter, r *http.Request) {
+// ReplayWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) ReplayWorkflowRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -2942,7 +3518,7 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowEdge(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflowEdge(w, r, workflowId)
+		siw.Handler.ReplayWorkflowRun(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2952,26 +3528,26 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowEdge(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWorkflowEdge operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWorkflowEdge(w http.ResponseWriter, r *http.Request) {
+// SignalWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) SignalWorkflowRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "edgeId" -------------
-	var edgeId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "edgeId", chi.URLParam(r, "edgeId"), &edgeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "edgeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
@@ -2983,41 +3559,26 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflowEdge(w http.ResponseWriter, r *
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWorkflowEdge(w, r, workflowId, edgeId)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SignalWorkflowRunParams
 
-// AutoLayoutWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) AutoLayoutWorkflow(w http.ResponseWriter, r *http.Request) {
+	// ------------- Required query parameter "token" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoLayoutWorkflow(w, r, workflowId)
+		siw.Handler.SignalWorkflowRun(w, r, id, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3027,17 +3588,17 @@ func (siw *ServerInterfaceWrapper) AutoLayoutWorkflow(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflowNodes operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflowNodes(w http.ResponseWriter, r *http.Request) {
+// GetWorkflowRunSteps operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowRunSteps(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3050,7 +3611,7 @@ func (siw *ServerInterfaceWrapper) ListWorkflowNodes(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflowNodes(w, r, workflowId)
+		siw.Handler.GetWorkflowRunSteps(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3060,20 +3621,11 @@ func (siw *ServerInterfaceWrapper) ListWorkflowNodes(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflowNode operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflowNode(w http.ResponseWriter, r *http.Request) {
+// ListWorkflows operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflows(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -3082,8 +3634,27 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowNode(w http.ResponseWriter, r *
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflowNode(w, r, workflowId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkflowsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkflows(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3093,26 +3664,39 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowNode(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWorkflowNode operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWorkflowNode(w http.ResponseWriter, r *http.Request) {
+// CreateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflow(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// DeleteWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3125,7 +3709,7 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflowNode(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWorkflowNode(w, r, workflowId, nodeId)
+		siw.Handler.DeleteWorkflow(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3135,26 +3719,17 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflowNode(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowNode operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowNode(w http.ResponseWriter, r *http.Request) {
+// GetWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3167,7 +3742,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflowNode(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflowNode(w, r, workflowId, nodeId)
+		siw.Handler.GetWorkflow(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3177,26 +3752,17 @@ func (siw *ServerInterfaceWrapper) GetWorkflowNode(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWorkflowNode operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWorkflowNode(w http.ResponseWriter, r *http.Request) {
+// UpdateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3209,7 +3775,7 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflowNode(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWorkflowNode(w, r, workflowId, nodeId)
+		siw.Handler.UpdateWorkflow(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3219,17 +3785,17 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflowNode(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflowVersions operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflowVersions(w http.ResponseWriter, r *http.Request) {
+// ExecuteWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteWorkflow(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "workflowId" -------------
-	var workflowId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3242,26 +3808,47 @@ func (siw *ServerInterfaceWrapper) ListWorkflowVersions(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWorkflowVersionsParams
+	var params ExecuteWorkflowParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "wait" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "timeout" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "timeout", r.URL.Query(), &params.Timeout)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeout", Err: err})
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflowVersions(w, r, workflowId, params)
+		siw.Handler.ExecuteWorkflow(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3271,8 +3858,8 @@ func (siw *ServerInterfaceWrapper) ListWorkflowVersions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflowVersion operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflowVersion(w http.ResponseWriter, r *http.Request) {
+// GetWorkflowDraft operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowDraft(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3294,7 +3881,7 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowVersion(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflowVersion(w, r, workflowId)
+		siw.Handler.GetWorkflowDraft(w, r, workflowId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3304,8 +3891,8 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetLatestWorkflowVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestWorkflowVersion(w http.ResponseWriter, r *http.Request) {
+// UpdateWorkflowDraft operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkflowDraft(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3327,7 +3914,7 @@ func (siw *ServerInterfaceWrapper) GetLatestWorkflowVersion(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLatestWorkflowVersion(w, r, workflowId)
+		siw.Handler.UpdateWorkflowDraft(w, r, workflowId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3337,8 +3924,8 @@ func (siw *ServerInterfaceWrapper) GetLatestWorkflowVersion(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// DeployWorkflowVersion operation middleware
-func (siw *ServerInterfaceWrapper) DeployWorkflowVersion(w http.ResponseWriter, r *http.Request) {
+// TestWorkflowDraftNode operation middleware
+func (siw *ServerInterfaceWrapper) TestWorkflowDraftNode(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3351,12 +3938,12 @@ func (siw *ServerInterfaceWrapper) DeployWorkflowVersion(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "versionNumber" -------------
-	var versionNumber int
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "versionNumber", chi.URLParam(r, "versionNumber"), &versionNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "versionNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
 		return
 	}
 
@@ -3369,7 +3956,7 @@ func (siw *ServerInterfaceWrapper) DeployWorkflowVersion(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeployWorkflowVersion(w, r, workflowId, versionNumber)
+		siw.Handler.TestWorkflowDraftNode(w, r, workflowId, nodeId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3379,17 +3966,17 @@ func (siw *ServerInterfaceWrapper) DeployWorkflowVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// HandleWebhook operation middleware
-func (siw *ServerInterfaceWrapper) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+// ListWorkflowEdges operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowEdges(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "token" -------------
-	var token string
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
 		return
 	}
 
@@ -3402,7 +3989,7 @@ func (siw *ServerInterfaceWrapper) HandleWebhook(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleWebhook(w, r, token)
+		siw.Handler.ListWorkflowEdges(w, r, workflowId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3412,2065 +3999,3198 @@ func (siw *ServerInterfaceWrapper) HandleWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// CreateWorkflowEdge operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflowEdge(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	r = r.WithContext(ctx)
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflowEdge(w, r, workflowId)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// DeleteWorkflowEdge operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkflowEdge(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	var err error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
-
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	// ------------- Path parameter "edgeId" -------------
+	var edgeId string
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "edgeId", chi.URLParam(r, "edgeId"), &edgeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "edgeId", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	r = r.WithContext(ctx)
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/assistant/chat", wrapper.AssistantChat)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/connections", wrapper.ListConnections)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/connections", wrapper.CreateConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/connections/{id}", wrapper.DeleteConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/connections/{id}", wrapper.GetConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/connections/{id}", wrapper.UpdateConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/credential-types", wrapper.ListCredentialTypes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/credential-types/{type}/schema", wrapper.GetCredentialTypeSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/credential-types/{type}/test", wrapper.TestCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/credentials", wrapper.ListCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/extensions", wrapper.ListExtensions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/integrations", wrapper.ListIntegrations)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/node-types", wrapper.ListNodeTypes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/node-types/{type}/parameters/{parameter}/options", wrapper.GetNodeParameterOptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/triggers", wrapper.ListTriggers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/triggers", wrapper.CreateTrigger)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/triggers/{id}", wrapper.DeleteTrigger)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/triggers/{id}", wrapper.GetTrigger)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/triggers/{id}", wrapper.UpdateTrigger)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workers", wrapper.ListWorkers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workers", wrapper.RegisterWorker)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/workers/{id}", wrapper.UnregisterWorker)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workers/{id}/claim-work", wrapper.ClaimWork)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workers/{id}/complete-work/{itemId}", wrapper.CompleteWork)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/workers/{id}/heartbeat", wrapper.UpdateWorkerHeartbeat)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflow-runs", wrapper.ListWorkflowRuns)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflow-runs/{id}", wrapper.GetWorkflowRun)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflow-runs/{id}/steps", wrapper.GetWorkflowRunSteps)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows", wrapper.ListWorkflows)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows", wrapper.CreateWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/workflows/{id}", wrapper.DeleteWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{id}", wrapper.GetWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/workflows/{id}", wrapper.UpdateWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{id}/execute", wrapper.ExecuteWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/draft", wrapper.GetWorkflowDraft)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/workflows/{workflowId}/draft", wrapper.UpdateWorkflowDraft)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/draft/nodes/{nodeId}/test", wrapper.TestWorkflowDraftNode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/edges", wrapper.ListWorkflowEdges)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/edges", wrapper.CreateWorkflowEdge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/workflows/{workflowId}/edges/{edgeId}", wrapper.DeleteWorkflowEdge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/layout", wrapper.AutoLayoutWorkflow)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/nodes", wrapper.ListWorkflowNodes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/nodes", wrapper.CreateWorkflowNode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.DeleteWorkflowNode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.GetWorkflowNode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.UpdateWorkflowNode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/versions", wrapper.ListWorkflowVersions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/versions", wrapper.CreateWorkflowVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/workflows/{workflowId}/versions/latest", wrapper.GetLatestWorkflowVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/workflows/{workflowId}/versions/{versionNumber}/deploy", wrapper.DeployWorkflowVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/webhooks/{token}", wrapper.HandleWebhook)
-	})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkflowEdge(w, r, workflowId, edgeId)
+	}))
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AssistantChatRequestObject struct {
-	Body *AssistantChatJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type AssistantChatResponseObject interface {
-	VisitAssistantChatResponse(w http.ResponseWriter) error
-}
+// AutoLayoutWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) AutoLayoutWorkflow(w http.ResponseWriter, r *http.Request) {
 
-type AssistantChat200JSONResponse AssistantChatResponse
+	var err error
 
-func (response AssistantChat200JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-type AssistantChat400JSONResponse Error
+	ctx := r.Context()
 
-func (response AssistantChat400JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type AssistantChat500JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response AssistantChat500JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoLayoutWorkflow(w, r, workflowId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListConnectionsRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListConnectionsResponseObject interface {
-	VisitListConnectionsResponse(w http.ResponseWriter) error
-}
+// ListWorkflowNodes operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowNodes(w http.ResponseWriter, r *http.Request) {
 
-type ListConnections200JSONResponse []Connection
+	var err error
 
-func (response ListConnections200JSONResponse) VisitListConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-type ListConnections500JSONResponse Error
+	ctx := r.Context()
 
-func (response ListConnections500JSONResponse) VisitListConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type CreateConnectionRequestObject struct {
-	Body *CreateConnectionJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type CreateConnectionResponseObject interface {
-	VisitCreateConnectionResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkflowNodes(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateConnection201JSONResponse Connection
+// CreateWorkflowNode operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflowNode(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type CreateConnection400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateConnection500JSONResponse Error
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflowNode(w, r, workflowId)
+	}))
 
-type DeleteConnectionRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConnectionResponseObject interface {
-	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConnection204Response struct {
-}
+// DeleteWorkflowNode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkflowNode(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteConnection204Response) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var err error
 
-type DeleteConnection404JSONResponse Error
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-func (response DeleteConnection404JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
 
-type DeleteConnection500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
 
-func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetConnectionRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetConnectionResponseObject interface {
-	VisitGetConnectionResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetConnection200JSONResponse Connection
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkflowNode(w, r, workflowId, nodeId)
+	}))
 
-func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetConnection404JSONResponse Error
-
-func (response GetConnection404JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetWorkflowNode operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowNode(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetConnection500JSONResponse Error
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
 
-type UpdateConnectionRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateConnectionJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
 
-type UpdateConnectionResponseObject interface {
-	VisitUpdateConnectionResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type UpdateConnection200JSONResponse Connection
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateConnection200JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type UpdateConnection400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowNode(w, r, workflowId, nodeId)
+	}))
 
-func (response UpdateConnection400JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateConnection404JSONResponse Error
+// UpdateWorkflowNode operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkflowNode(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateConnection404JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type UpdateConnection500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (response UpdateConnection500JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", chi.URLParam(r, "nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
 
-type ListCredentialTypesRequestObject struct {
-}
+	ctx := r.Context()
 
-type ListCredentialTypesResponseObject interface {
-	VisitListCredentialTypesResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListCredentialTypes200JSONResponse []CredentialType
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response ListCredentialTypes200JSONResponse) VisitListCredentialTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWorkflowNode(w, r, workflowId, nodeId)
+	}))
 
-type ListCredentialTypes500JSONResponse Error
-
-func (response ListCredentialTypes500JSONResponse) VisitListCredentialTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetCredentialTypeSchemaRequestObject struct {
-	Type string `json:"type"`
-}
+// ListWorkflowVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowVersions(w http.ResponseWriter, r *http.Request) {
 
-type GetCredentialTypeSchemaResponseObject interface {
-	VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetCredentialTypeSchema200JSONResponse CredentialTypeSchema
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-func (response GetCredentialTypeSchema200JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetCredentialTypeSchema404JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetCredentialTypeSchema404JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetCredentialTypeSchema500JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkflowVersionsParams
 
-func (response GetCredentialTypeSchema500JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type TestCredentialsRequestObject struct {
-	Type string `json:"type"`
-	Body *TestCredentialsJSONRequestBody
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type TestCredentialsResponseObject interface {
-	VisitTestCredentialsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type TestCredentials200JSONResponse CredentialTestResult
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkflowVersions(w, r, workflowId, params)
+	}))
 
-func (response TestCredentials200JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type TestCredentials400JSONResponse Error
+// CreateWorkflowVersion operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflowVersion(w http.ResponseWriter, r *http.Request) {
 
-func (response TestCredentials400JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type TestCredentials404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (response TestCredentials404JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type TestCredentials500JSONResponse Error
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response TestCredentials500JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflowVersion(w, r, workflowId)
+	}))
 
-type ListCredentialsRequestObject struct {
-	Params ListCredentialsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCredentialsResponseObject interface {
-	VisitListCredentialsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListCredentials200JSONResponse []Credential
+// GetLatestWorkflowVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestWorkflowVersion(w http.ResponseWriter, r *http.Request) {
 
-func (response ListCredentials200JSONResponse) VisitListCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type ListCredentials500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-func (response ListCredentials500JSONResponse) VisitListCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListExtensionsRequestObject struct {
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type ListExtensionsResponseObject interface {
-	VisitListExtensionsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ListExtensions200JSONResponse []Extension
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLatestWorkflowVersion(w, r, workflowId)
+	}))
 
-func (response ListExtensions200JSONResponse) VisitListExtensionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListExtensions500JSONResponse Error
+// DeployWorkflowVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeployWorkflowVersion(w http.ResponseWriter, r *http.Request) {
 
-func (response ListExtensions500JSONResponse) VisitListExtensionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "workflowId" -------------
+	var workflowId openapi_types.UUID
 
-type GetHealthRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workflowId", chi.URLParam(r, "workflowId"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflowId", Err: err})
+		return
+	}
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "versionNumber" -------------
+	var versionNumber int
 
-type GetHealth200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "versionNumber", chi.URLParam(r, "versionNumber"), &versionNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "versionNumber", Err: err})
+		return
+	}
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetHealth503JSONResponse Error
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response GetHealth503JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeployWorkflowVersion(w, r, workflowId, versionNumber)
+	}))
 
-type ListIntegrationsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListIntegrationsResponseObject interface {
-	VisitListIntegrationsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListIntegrations200JSONResponse []Integration
-
-func (response ListIntegrations200JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type ListIntegrations500JSONResponse Error
-
-func (response ListIntegrations500JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type ListNodeTypesRequestObject struct {
-	Params ListNodeTypesParams
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type ListNodeTypesResponseObject interface {
-	VisitListNodeTypesResponse(w http.ResponseWriter) error
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type ListNodeTypes200JSONResponse []NodeType
-
-func (response ListNodeTypes200JSONResponse) VisitListNodeTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetNodeParameterOptionsRequestObject struct {
-	Type      string `json:"type"`
-	Parameter string `json:"parameter"`
-	Params    GetNodeParameterOptionsParams
-}
-
-type GetNodeParameterOptionsResponseObject interface {
-	VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error
-}
-
-type GetNodeParameterOptions200JSONResponse NodeParameterOptions
-
-func (response GetNodeParameterOptions200JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetNodeParameterOptions404JSONResponse Error
-
-func (response GetNodeParameterOptions404JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetNodeParameterOptions500JSONResponse Error
-
-func (response GetNodeParameterOptions500JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListTriggersRequestObject struct {
-}
-
-type ListTriggersResponseObject interface {
-	VisitListTriggersResponse(w http.ResponseWriter) error
-}
-
-type ListTriggers200JSONResponse []Trigger
-
-func (response ListTriggers200JSONResponse) VisitListTriggersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListTriggers500JSONResponse Error
-
-func (response ListTriggers500JSONResponse) VisitListTriggersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateTriggerRequestObject struct {
-	Body *CreateTriggerJSONRequestBody
-}
-
-type CreateTriggerResponseObject interface {
-	VisitCreateTriggerResponse(w http.ResponseWriter) error
-}
-
-type CreateTrigger201JSONResponse Trigger
-
-func (response CreateTrigger201JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateTrigger400JSONResponse Error
-
-func (response CreateTrigger400JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateTrigger500JSONResponse Error
-
-func (response CreateTrigger500JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeleteTriggerRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
-
-type DeleteTriggerResponseObject interface {
-	VisitDeleteTriggerResponse(w http.ResponseWriter) error
-}
-
-type DeleteTrigger204Response struct {
-}
-
-func (response DeleteTrigger204Response) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteTrigger404JSONResponse Error
-
-func (response DeleteTrigger404JSONResponse) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type RequiredParamError struct {
+	ParamName string
 }
 
-type DeleteTrigger500JSONResponse Error
-
-func (response DeleteTrigger500JSONResponse) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type GetTriggerRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type GetTriggerResponseObject interface {
-	VisitGetTriggerResponse(w http.ResponseWriter) error
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type GetTrigger200JSONResponse Trigger
-
-func (response GetTrigger200JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type GetTrigger404JSONResponse Error
-
-func (response GetTrigger404JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type GetTrigger500JSONResponse Error
-
-func (response GetTrigger500JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type UpdateTriggerRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateTriggerJSONRequestBody
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type UpdateTriggerResponseObject interface {
-	VisitUpdateTriggerResponse(w http.ResponseWriter) error
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type UpdateTrigger200JSONResponse Trigger
-
-func (response UpdateTrigger200JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type UpdateTrigger400JSONResponse Error
-
-func (response UpdateTrigger400JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
 }
 
-type UpdateTrigger404JSONResponse Error
-
-func (response UpdateTrigger404JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type UpdateTrigger500JSONResponse Error
-
-func (response UpdateTrigger500JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
 }
 
-type ListWorkersRequestObject struct {
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-type ListWorkersResponseObject interface {
-	VisitListWorkersResponse(w http.ResponseWriter) error
-}
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
 
-type ListWorkers200JSONResponse []Worker
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-func (response ListWorkers200JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListWorkers500JSONResponse Error
-
-func (response ListWorkers500JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/assistant/chat", wrapper.AssistantChat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/connections", wrapper.ListConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/connections", wrapper.CreateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/connections/{id}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/connections/{id}", wrapper.GetConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/connections/{id}", wrapper.UpdateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/credential-types", wrapper.ListCredentialTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/credential-types/{type}/schema", wrapper.GetCredentialTypeSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/credential-types/{type}/test", wrapper.TestCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/credentials", wrapper.ListCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/dead-letters", wrapper.ListDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/dead-letters/{id}", wrapper.DiscardDeadLetter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/dead-letters/{id}", wrapper.GetDeadLetter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/dead-letters/{id}/requeue", wrapper.RequeueDeadLetter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/extensions", wrapper.ListExtensions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/integrations", wrapper.ListIntegrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/node-types", wrapper.ListNodeTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/node-types/{type}/parameters/{parameter}/options", wrapper.GetNodeParameterOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/triggers", wrapper.ListTriggers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/triggers", wrapper.CreateTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/triggers/{id}", wrapper.DeleteTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/triggers/{id}", wrapper.GetTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/triggers/{id}", wrapper.UpdateTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/webhooks/{token}", wrapper.HandleWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workers", wrapper.ListWorkers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers", wrapper.RegisterWorker)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workers/{id}", wrapper.UnregisterWorker)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/claim-work", wrapper.ClaimWork)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/complete-work/{itemId}", wrapper.CompleteWork)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workers/{id}/data/{key}", wrapper.DeleteWorkerData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workers/{id}/data/{key}", wrapper.GetWorkerData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workers/{id}/data/{key}", wrapper.StoreWorkerData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workers/{id}/heartbeat", wrapper.UpdateWorkerHeartbeat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/inactive-runs", wrapper.ListInactiveWorkerRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/runs/{runId}/finalize", wrapper.FinalizeWorkerRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/runs/{runId}/initialize", wrapper.InitializeWorkerRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workers/{id}/steps/{stepId}/heartbeat", wrapper.ReportStepHeartbeat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/steps/{stepId}/result", wrapper.ReportStepResult)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workers/{id}/steps/{stepId}/start", wrapper.StartWorkerStep)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflow-runs", wrapper.ListWorkflowRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflow-runs/{id}", wrapper.GetWorkflowRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflow-runs/{id}/replay", wrapper.ReplayWorkflowRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflow-runs/{id}/signals/{name}", wrapper.SignalWorkflowRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflow-runs/{id}/steps", wrapper.GetWorkflowRunSteps)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows", wrapper.ListWorkflows)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows", wrapper.CreateWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workflows/{id}", wrapper.DeleteWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{id}", wrapper.GetWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workflows/{id}", wrapper.UpdateWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{id}/execute", wrapper.ExecuteWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/draft", wrapper.GetWorkflowDraft)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workflows/{workflowId}/draft", wrapper.UpdateWorkflowDraft)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/draft/nodes/{nodeId}/test", wrapper.TestWorkflowDraftNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/edges", wrapper.ListWorkflowEdges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/edges", wrapper.CreateWorkflowEdge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workflows/{workflowId}/edges/{edgeId}", wrapper.DeleteWorkflowEdge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/layout", wrapper.AutoLayoutWorkflow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/nodes", wrapper.ListWorkflowNodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/nodes", wrapper.CreateWorkflowNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.DeleteWorkflowNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.GetWorkflowNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/workflows/{workflowId}/nodes/{nodeId}", wrapper.UpdateWorkflowNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/versions", wrapper.ListWorkflowVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/versions", wrapper.CreateWorkflowVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workflows/{workflowId}/versions/latest", wrapper.GetLatestWorkflowVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workflows/{workflowId}/versions/{versionNumber}/deploy", wrapper.DeployWorkflowVersion)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	return r
 }
 
-type RegisterWorkerRequestObject struct {
-	Body *RegisterWorkerJSONRequestBody
+type AssistantChatRequestObject struct {
+	Body *AssistantChatJSONRequestBody
 }
 
-type RegisterWorkerResponseObject interface {
-	VisitRegisterWorkerResponse(w http.ResponseWriter) error
+type AssistantChatResponseObject interface {
+	VisitAssistantChatResponse(w http.ResponseWriter) error
 }
 
-type RegisterWorker201JSONResponse Worker
+type AssistantChat200JSONResponse AssistantChatResponse
 
-func (response RegisterWorker201JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
+func (response AssistantChat200JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RegisterWorker400JSONResponse Error
+type AssistantChat400JSONResponse Error
 
-func (response RegisterWorker400JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
+func (response AssistantChat400JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RegisterWorker500JSONResponse Error
+type AssistantChat500JSONResponse Error
 
-func (response RegisterWorker500JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
+func (response AssistantChat500JSONResponse) VisitAssistantChatResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnregisterWorkerRequestObject struct {
-	Id string `json:"id"`
-}
-
-type UnregisterWorkerResponseObject interface {
-	VisitUnregisterWorkerResponse(w http.ResponseWriter) error
-}
-
-type UnregisterWorker204Response struct {
+type ListConnectionsRequestObject struct {
 }
 
-func (response UnregisterWorker204Response) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type ListConnectionsResponseObject interface {
+	VisitListConnectionsResponse(w http.ResponseWriter) error
 }
 
-type UnregisterWorker404JSONResponse Error
+type ListConnections200JSONResponse []Connection
 
-func (response UnregisterWorker404JSONResponse) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
+func (response ListConnections200JSONResponse) VisitListConnectionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnregisterWorker500JSONResponse Error
+type ListConnections500JSONResponse Error
 
-func (response UnregisterWorker500JSONResponse) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
+func (response ListConnections500JSONResponse) VisitListConnectionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ClaimWorkRequestObject struct {
-	Id   string `json:"id"`
-	Body *ClaimWorkJSONRequestBody
+type CreateConnectionRequestObject struct {
+	Body *CreateConnectionJSONRequestBody
 }
 
-type ClaimWorkResponseObject interface {
-	VisitClaimWorkResponse(w http.ResponseWriter) error
+type CreateConnectionResponseObject interface {
+	VisitCreateConnectionResponse(w http.ResponseWriter) error
 }
 
-type ClaimWork200JSONResponse []WorkItem
+type CreateConnection201JSONResponse Connection
 
-func (response ClaimWork200JSONResponse) VisitClaimWorkResponse(w http.ResponseWriter) error {
+func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CompleteWorkRequestObject struct {
-	Id     string `json:"id"`
-	ItemId string `json:"itemId"`
-	Body   *CompleteWorkJSONRequestBody
-}
-
-type CompleteWorkResponseObject interface {
-	VisitCompleteWorkResponse(w http.ResponseWriter) error
-}
-
-type CompleteWork200Response struct {
-}
-
-func (response CompleteWork200Response) VisitCompleteWorkResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type UpdateWorkerHeartbeatRequestObject struct {
-	Id string `json:"id"`
-}
-
-type UpdateWorkerHeartbeatResponseObject interface {
-	VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error
-}
-
-type UpdateWorkerHeartbeat200Response struct {
-}
-
-func (response UpdateWorkerHeartbeat200Response) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type UpdateWorkerHeartbeat404JSONResponse Error
+type CreateConnection400JSONResponse Error
 
-func (response UpdateWorkerHeartbeat404JSONResponse) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
+func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkerHeartbeat500JSONResponse Error
+type CreateConnection500JSONResponse Error
 
-func (response UpdateWorkerHeartbeat500JSONResponse) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
+func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowRunsRequestObject struct {
-	Params ListWorkflowRunsParams
+type DeleteConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type ListWorkflowRunsResponseObject interface {
-	VisitListWorkflowRunsResponse(w http.ResponseWriter) error
+type DeleteConnectionResponseObject interface {
+	VisitDeleteConnectionResponse(w http.ResponseWriter) error
 }
 
-type ListWorkflowRuns200JSONResponse WorkflowRunList
+type DeleteConnection204Response struct {
+}
 
-func (response ListWorkflowRuns200JSONResponse) VisitListWorkflowRunsResponse(w http.ResponseWriter) error {
+func (response DeleteConnection204Response) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteConnection404JSONResponse Error
+
+func (response DeleteConnection404JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowRuns500JSONResponse Error
+type DeleteConnection500JSONResponse Error
 
-func (response ListWorkflowRuns500JSONResponse) VisitListWorkflowRunsResponse(w http.ResponseWriter) error {
+func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRunRequestObject struct {
+type GetConnectionRequestObject struct {
 	Id openapi_types.UUID `json:"id"`
 }
 
-type GetWorkflowRunResponseObject interface {
-	VisitGetWorkflowRunResponse(w http.ResponseWriter) error
+type GetConnectionResponseObject interface {
+	VisitGetConnectionResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflowRun200JSONResponse WorkflowRun
+type GetConnection200JSONResponse Connection
 
-func (response GetWorkflowRun200JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRun404JSONResponse Error
+type GetConnection404JSONResponse Error
 
-func (response GetWorkflowRun404JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+func (response GetConnection404JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRun500JSONResponse Error
+type GetConnection500JSONResponse Error
 
-func (response GetWorkflowRun500JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRunStepsRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type UpdateConnectionRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateConnectionJSONRequestBody
 }
 
-type GetWorkflowRunStepsResponseObject interface {
-	VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error
+type UpdateConnectionResponseObject interface {
+	VisitUpdateConnectionResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflowRunSteps200JSONResponse []WorkflowStep
+type UpdateConnection200JSONResponse Connection
 
-func (response GetWorkflowRunSteps200JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+func (response UpdateConnection200JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRunSteps404JSONResponse Error
+type UpdateConnection400JSONResponse Error
 
-func (response GetWorkflowRunSteps404JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+func (response UpdateConnection400JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnection404JSONResponse Error
+
+func (response UpdateConnection404JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRunSteps500JSONResponse Error
+type UpdateConnection500JSONResponse Error
 
-func (response GetWorkflowRunSteps500JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+func (response UpdateConnection500JSONResponse) VisitUpdateConnectionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowsRequestObject struct {
-	Params ListWorkflowsParams
+type ListCredentialTypesRequestObject struct {
 }
 
-type ListWorkflowsResponseObject interface {
-	VisitListWorkflowsResponse(w http.ResponseWriter) error
+type ListCredentialTypesResponseObject interface {
+	VisitListCredentialTypesResponse(w http.ResponseWriter) error
 }
 
-type ListWorkflows200JSONResponse WorkflowList
+type ListCredentialTypes200JSONResponse []CredentialType
 
-func (response ListWorkflows200JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
+func (response ListCredentialTypes200JSONResponse) VisitListCredentialTypesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflows500JSONResponse Error
+type ListCredentialTypes500JSONResponse Error
 
-func (response ListWorkflows500JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
+func (response ListCredentialTypes500JSONResponse) VisitListCredentialTypesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowRequestObject struct {
-	Body *CreateWorkflowJSONRequestBody
+type GetCredentialTypeSchemaRequestObject struct {
+	Type string `json:"type"`
 }
 
-type CreateWorkflowResponseObject interface {
-	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+type GetCredentialTypeSchemaResponseObject interface {
+	VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error
 }
 
-type CreateWorkflow201JSONResponse Workflow
+type GetCredentialTypeSchema200JSONResponse CredentialTypeSchema
 
-func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetCredentialTypeSchema200JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow400JSONResponse Error
+type GetCredentialTypeSchema404JSONResponse Error
 
-func (response CreateWorkflow400JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetCredentialTypeSchema404JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflow500JSONResponse Error
+type GetCredentialTypeSchema500JSONResponse Error
 
-func (response CreateWorkflow500JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+func (response GetCredentialTypeSchema500JSONResponse) VisitGetCredentialTypeSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflowRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type TestCredentialsRequestObject struct {
+	Type string `json:"type"`
+	Body *TestCredentialsJSONRequestBody
 }
 
-type DeleteWorkflowResponseObject interface {
-	VisitDeleteWorkflowResponse(w http.ResponseWriter) error
+type TestCredentialsResponseObject interface {
+	VisitTestCredentialsResponse(w http.ResponseWriter) error
 }
 
-type DeleteWorkflow204Response struct {
+type TestCredentials200JSONResponse CredentialTestResult
+
+func (response TestCredentials200JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteWorkflow204Response) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type TestCredentials400JSONResponse Error
+
+func (response TestCredentials400JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflow404JSONResponse Error
+type TestCredentials404JSONResponse Error
 
-func (response DeleteWorkflow404JSONResponse) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
+func (response TestCredentials404JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflow500JSONResponse Error
+type TestCredentials500JSONResponse Error
 
-func (response DeleteWorkflow500JSONResponse) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
+func (response TestCredentials500JSONResponse) VisitTestCredentialsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type ListCredentialsRequestObject struct {
+	Params ListCredentialsParams
 }
 
-type GetWorkflowResponseObject interface {
-	VisitGetWorkflowResponse(w http.ResponseWriter) error
+type ListCredentialsResponseObject interface {
+	VisitListCredentialsResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflow200JSONResponse Workflow
+type ListCredentials200JSONResponse []Credential
 
-func (response GetWorkflow200JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+func (response ListCredentials200JSONResponse) VisitListCredentialsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflow404JSONResponse Error
-
-func (response GetWorkflow404JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetWorkflow500JSONResponse Error
+type ListCredentials500JSONResponse Error
 
-func (response GetWorkflow500JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+func (response ListCredentials500JSONResponse) VisitListCredentialsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateWorkflowJSONRequestBody
+type ListDeadLettersRequestObject struct {
+	Params ListDeadLettersParams
 }
 
-type UpdateWorkflowResponseObject interface {
-	VisitUpdateWorkflowResponse(w http.ResponseWriter) error
+type ListDeadLettersResponseObject interface {
+	VisitListDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type UpdateWorkflow200JSONResponse Workflow
+type ListDeadLetters200JSONResponse DeadLetterList
 
-func (response UpdateWorkflow200JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+func (response ListDeadLetters200JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflow400JSONResponse Error
+type ListDeadLetters500JSONResponse Error
 
-func (response UpdateWorkflow400JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+func (response ListDeadLetters500JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflow404JSONResponse Error
+type DiscardDeadLetterRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
 
-func (response UpdateWorkflow404JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+type DiscardDeadLetterResponseObject interface {
+	VisitDiscardDeadLetterResponse(w http.ResponseWriter) error
+}
+
+type DiscardDeadLetter204Response struct {
+}
+
+func (response DiscardDeadLetter204Response) VisitDiscardDeadLetterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DiscardDeadLetter404JSONResponse Error
+
+func (response DiscardDeadLetter404JSONResponse) VisitDiscardDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflow500JSONResponse Error
+type DiscardDeadLetter500JSONResponse Error
 
-func (response UpdateWorkflow500JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+func (response DiscardDeadLetter500JSONResponse) VisitDiscardDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteWorkflowRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *ExecuteWorkflowJSONRequestBody
+type GetDeadLetterRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type ExecuteWorkflowResponseObject interface {
-	VisitExecuteWorkflowResponse(w http.ResponseWriter) error
+type GetDeadLetterResponseObject interface {
+	VisitGetDeadLetterResponse(w http.ResponseWriter) error
 }
 
-type ExecuteWorkflow200JSONResponse WorkflowExecution
+type GetDeadLetter200JSONResponse DeadLetter
 
-func (response ExecuteWorkflow200JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+func (response GetDeadLetter200JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteWorkflow400JSONResponse Error
+type GetDeadLetter404JSONResponse Error
 
-func (response ExecuteWorkflow400JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ExecuteWorkflow404JSONResponse Error
-
-func (response ExecuteWorkflow404JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+func (response GetDeadLetter404JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteWorkflow500JSONResponse Error
+type GetDeadLetter500JSONResponse Error
 
-func (response ExecuteWorkflow500JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+func (response GetDeadLetter500JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowDraftRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+type RequeueDeadLetterRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type GetWorkflowDraftResponseObject interface {
-	VisitGetWorkflowDraftResponse(w http.ResponseWriter) error
+type RequeueDeadLetterResponseObject interface {
+	VisitRequeueDeadLetterResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflowDraft200JSONResponse WorkflowDraft
-
-func (response GetWorkflowDraft200JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RequeueDeadLetter204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response RequeueDeadLetter204Response) VisitRequeueDeadLetterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetWorkflowDraft404JSONResponse Error
+type RequeueDeadLetter404JSONResponse Error
 
-func (response GetWorkflowDraft404JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
+func (response RequeueDeadLetter404JSONResponse) VisitRequeueDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowDraft500JSONResponse Error
+type RequeueDeadLetter500JSONResponse Error
 
-func (response GetWorkflowDraft500JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
+func (response RequeueDeadLetter500JSONResponse) VisitRequeueDeadLetterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowDraftRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	Body       *UpdateWorkflowDraftJSONRequestBody
+type ListExtensionsRequestObject struct {
 }
 
-type UpdateWorkflowDraftResponseObject interface {
-	VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error
+type ListExtensionsResponseObject interface {
+	VisitListExtensionsResponse(w http.ResponseWriter) error
 }
 
-type UpdateWorkflowDraft200JSONResponse WorkflowDraft
+type ListExtensions200JSONResponse []Extension
 
-func (response UpdateWorkflowDraft200JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+func (response ListExtensions200JSONResponse) VisitListExtensionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowDraft400JSONResponse Error
+type ListExtensions500JSONResponse Error
 
-func (response UpdateWorkflowDraft400JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+func (response ListExtensions500JSONResponse) VisitListExtensionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowDraft404JSONResponse Error
+type GetHealthRequestObject struct {
+}
 
-func (response UpdateWorkflowDraft404JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowDraft500JSONResponse Error
+type GetHealth503JSONResponse Error
 
-func (response UpdateWorkflowDraft500JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+func (response GetHealth503JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(503)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TestWorkflowDraftNodeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	NodeId     string             `json:"nodeId"`
-	Body       *TestWorkflowDraftNodeJSONRequestBody
+type ListIntegrationsRequestObject struct {
 }
 
-type TestWorkflowDraftNodeResponseObject interface {
-	VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error
+type ListIntegrationsResponseObject interface {
+	VisitListIntegrationsResponse(w http.ResponseWriter) error
 }
 
-type TestWorkflowDraftNode200JSONResponse NodeTestResult
+type ListIntegrations200JSONResponse []Integration
 
-func (response TestWorkflowDraftNode200JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+func (response ListIntegrations200JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TestWorkflowDraftNode400JSONResponse Error
+type ListIntegrations500JSONResponse Error
 
-func (response TestWorkflowDraftNode400JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+func (response ListIntegrations500JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TestWorkflowDraftNode404JSONResponse Error
-
-func (response TestWorkflowDraftNode404JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type ListNodeTypesRequestObject struct {
+	Params ListNodeTypesParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type ListNodeTypesResponseObject interface {
+	VisitListNodeTypesResponse(w http.ResponseWriter) error
 }
 
-type TestWorkflowDraftNode500JSONResponse Error
+type ListNodeTypes200JSONResponse []NodeType
 
-func (response TestWorkflowDraftNode500JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+func (response ListNodeTypes200JSONResponse) VisitListNodeTypesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowEdgesRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+type GetNodeParameterOptionsRequestObject struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter"`
+	Params    GetNodeParameterOptionsParams
 }
 
-type ListWorkflowEdgesResponseObject interface {
-	VisitListWorkflowEdgesResponse(w http.ResponseWriter) error
+type GetNodeParameterOptionsResponseObject interface {
+	VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error
 }
 
-type ListWorkflowEdges200JSONResponse []WorkflowEdge
+type GetNodeParameterOptions200JSONResponse NodeParameterOptions
 
-func (response ListWorkflowEdges200JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+func (response GetNodeParameterOptions200JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowEdges404JSONResponse Error
+type GetNodeParameterOptions404JSONResponse Error
 
-func (response ListWorkflowEdges404JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+func (response GetNodeParameterOptions404JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowEdges500JSONResponse Error
+type GetNodeParameterOptions500JSONResponse Error
 
-func (response ListWorkflowEdges500JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+func (response GetNodeParameterOptions500JSONResponse) VisitGetNodeParameterOptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowEdgeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	Body       *CreateWorkflowEdgeJSONRequestBody
+type ListTriggersRequestObject struct {
 }
 
-type CreateWorkflowEdgeResponseObject interface {
-	VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error
+type ListTriggersResponseObject interface {
+	VisitListTriggersResponse(w http.ResponseWriter) error
 }
 
-type CreateWorkflowEdge201JSONResponse WorkflowEdge
+type ListTriggers200JSONResponse []Trigger
 
-func (response CreateWorkflowEdge201JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response ListTriggers200JSONResponse) VisitListTriggersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowEdge400JSONResponse Error
+type ListTriggers500JSONResponse Error
 
-func (response CreateWorkflowEdge400JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response ListTriggers500JSONResponse) VisitListTriggersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowEdge404JSONResponse Error
+type CreateTriggerRequestObject struct {
+	Body *CreateTriggerJSONRequestBody
+}
 
-func (response CreateWorkflowEdge404JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+type CreateTriggerResponseObject interface {
+	VisitCreateTriggerResponse(w http.ResponseWriter) error
+}
+
+type CreateTrigger201JSONResponse Trigger
+
+func (response CreateTrigger201JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowEdge500JSONResponse Error
+type CreateTrigger400JSONResponse Error
 
-func (response CreateWorkflowEdge500JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response CreateTrigger400JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTrigger500JSONResponse Error
+
+func (response CreateTrigger500JSONResponse) VisitCreateTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflowEdgeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	EdgeId     string             `json:"edgeId"`
+type DeleteTriggerRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type DeleteWorkflowEdgeResponseObject interface {
-	VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error
+type DeleteTriggerResponseObject interface {
+	VisitDeleteTriggerResponse(w http.ResponseWriter) error
 }
 
-type DeleteWorkflowEdge204Response struct {
+type DeleteTrigger204Response struct {
 }
 
-func (response DeleteWorkflowEdge204Response) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response DeleteTrigger204Response) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteWorkflowEdge404JSONResponse Error
+type DeleteTrigger404JSONResponse Error
 
-func (response DeleteWorkflowEdge404JSONResponse) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response DeleteTrigger404JSONResponse) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflowEdge500JSONResponse Error
+type DeleteTrigger500JSONResponse Error
 
-func (response DeleteWorkflowEdge500JSONResponse) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+func (response DeleteTrigger500JSONResponse) VisitDeleteTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AutoLayoutWorkflowRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+type GetTriggerRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type AutoLayoutWorkflowResponseObject interface {
-	VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error
+type GetTriggerResponseObject interface {
+	VisitGetTriggerResponse(w http.ResponseWriter) error
 }
 
-type AutoLayoutWorkflow200JSONResponse WorkflowLayoutResult
+type GetTrigger200JSONResponse Trigger
 
-func (response AutoLayoutWorkflow200JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+func (response GetTrigger200JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AutoLayoutWorkflow404JSONResponse Error
+type GetTrigger404JSONResponse Error
 
-func (response AutoLayoutWorkflow404JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+func (response GetTrigger404JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AutoLayoutWorkflow500JSONResponse Error
+type GetTrigger500JSONResponse Error
 
-func (response AutoLayoutWorkflow500JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+func (response GetTrigger500JSONResponse) VisitGetTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowNodesRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+type UpdateTriggerRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateTriggerJSONRequestBody
 }
 
-type ListWorkflowNodesResponseObject interface {
-	VisitListWorkflowNodesResponse(w http.ResponseWriter) error
+type UpdateTriggerResponseObject interface {
+	VisitUpdateTriggerResponse(w http.ResponseWriter) error
 }
 
-type ListWorkflowNodes200JSONResponse []WorkflowNode
+type UpdateTrigger200JSONResponse Trigger
 
-func (response ListWorkflowNodes200JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+func (response UpdateTrigger200JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowNodes404JSONResponse Error
+type UpdateTrigger400JSONResponse Error
 
-func (response ListWorkflowNodes404JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+func (response UpdateTrigger400JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrigger404JSONResponse Error
+
+func (response UpdateTrigger404JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowNodes500JSONResponse Error
+type UpdateTrigger500JSONResponse Error
 
-func (response ListWorkflowNodes500JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+func (response UpdateTrigger500JSONResponse) VisitUpdateTriggerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowNodeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	Body       *CreateWorkflowNodeJSONRequestBody
+type HandleWebhookRequestObject struct {
+	Token        string `json:"token"`
+	JSONBody     *HandleWebhookJSONRequestBody
+	FormdataBody *HandleWebhookFormdataRequestBody
 }
 
-type CreateWorkflowNodeResponseObject interface {
-	VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error
+type HandleWebhookResponseObject interface {
+	VisitHandleWebhookResponse(w http.ResponseWriter) error
 }
 
-type CreateWorkflowNode201JSONResponse WorkflowNode
+type HandleWebhook200JSONResponse WebhookRun
 
-func (response CreateWorkflowNode201JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response HandleWebhook200JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowNode400JSONResponse Error
+type HandleWebhook404JSONResponse Error
 
-func (response CreateWorkflowNode400JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response HandleWebhook404JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowNode404JSONResponse Error
+type HandleWebhook500JSONResponse Error
 
-func (response CreateWorkflowNode404JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response HandleWebhook500JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowNode500JSONResponse Error
+type HandleWebhook504JSONResponse Error
 
-func (response CreateWorkflowNode500JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response HandleWebhook504JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(504)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflowNodeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	NodeId     string             `json:"nodeId"`
-}
-
-type DeleteWorkflowNodeResponseObject interface {
-	VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error
-}
-
-type DeleteWorkflowNode204Response struct {
+type ListWorkersRequestObject struct {
 }
 
-func (response DeleteWorkflowNode204Response) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type ListWorkersResponseObject interface {
+	VisitListWorkersResponse(w http.ResponseWriter) error
 }
 
-type DeleteWorkflowNode404JSONResponse Error
+type ListWorkers200JSONResponse []Worker
 
-func (response DeleteWorkflowNode404JSONResponse) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response ListWorkers200JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteWorkflowNode500JSONResponse Error
+type ListWorkers500JSONResponse Error
 
-func (response DeleteWorkflowNode500JSONResponse) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response ListWorkers500JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowNodeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	NodeId     string             `json:"nodeId"`
+type RegisterWorkerRequestObject struct {
+	Body *RegisterWorkerJSONRequestBody
 }
 
-type GetWorkflowNodeResponseObject interface {
-	VisitGetWorkflowNodeResponse(w http.ResponseWriter) error
+type RegisterWorkerResponseObject interface {
+	VisitRegisterWorkerResponse(w http.ResponseWriter) error
 }
 
-type GetWorkflowNode200JSONResponse WorkflowNode
+type RegisterWorker201JSONResponse Worker
 
-func (response GetWorkflowNode200JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response RegisterWorker201JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowNode404JSONResponse Error
+type RegisterWorker400JSONResponse Error
 
-func (response GetWorkflowNode404JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response RegisterWorker400JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowNode500JSONResponse Error
+type RegisterWorker500JSONResponse Error
 
-func (response GetWorkflowNode500JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response RegisterWorker500JSONResponse) VisitRegisterWorkerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowNodeRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	NodeId     string             `json:"nodeId"`
-	Body       *UpdateWorkflowNodeJSONRequestBody
+type UnregisterWorkerRequestObject struct {
+	Id string `json:"id"`
 }
 
-type UpdateWorkflowNodeResponseObject interface {
-	VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error
+type UnregisterWorkerResponseObject interface {
+	VisitUnregisterWorkerResponse(w http.ResponseWriter) error
 }
 
-type UpdateWorkflowNode200JSONResponse WorkflowNode
+type UnregisterWorker204Response struct {
+}
 
-func (response UpdateWorkflowNode200JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response UnregisterWorker204Response) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnregisterWorker404JSONResponse Error
+
+func (response UnregisterWorker404JSONResponse) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowNode400JSONResponse Error
+type UnregisterWorker500JSONResponse Error
 
-func (response UpdateWorkflowNode400JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response UnregisterWorker500JSONResponse) VisitUnregisterWorkerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowNode404JSONResponse Error
+type ClaimWorkRequestObject struct {
+	Id   string `json:"id"`
+	Body *ClaimWorkJSONRequestBody
+}
 
-func (response UpdateWorkflowNode404JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+type ClaimWorkResponseObject interface {
+	VisitClaimWorkResponse(w http.ResponseWriter) error
+}
+
+type ClaimWork200JSONResponse []WorkItem
+
+func (response ClaimWork200JSONResponse) VisitClaimWorkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowNode500JSONResponse Error
+type ClaimWork500JSONResponse Error
 
-func (response UpdateWorkflowNode500JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+func (response ClaimWork500JSONResponse) VisitClaimWorkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowVersionsRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	Params     ListWorkflowVersionsParams
+type CompleteWorkRequestObject struct {
+	Id     string `json:"id"`
+	ItemId string `json:"itemId"`
+	Body   *CompleteWorkJSONRequestBody
 }
 
-type ListWorkflowVersionsResponseObject interface {
-	VisitListWorkflowVersionsResponse(w http.ResponseWriter) error
+type CompleteWorkResponseObject interface {
+	VisitCompleteWorkResponse(w http.ResponseWriter) error
 }
 
-type ListWorkflowVersions200JSONResponse WorkflowVersionList
+type CompleteWork200Response struct {
+}
 
-func (response ListWorkflowVersions200JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response CompleteWork200Response) VisitCompleteWorkResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
+
+type CompleteWork400JSONResponse Error
+
+func (response CompleteWork400JSONResponse) VisitCompleteWorkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowVersions404JSONResponse Error
+type CompleteWork404JSONResponse Error
 
-func (response ListWorkflowVersions404JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
+func (response CompleteWork404JSONResponse) VisitCompleteWorkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListWorkflowVersions500JSONResponse Error
+type CompleteWork409JSONResponse Error
 
-func (response ListWorkflowVersions500JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
+func (response CompleteWork409JSONResponse) VisitCompleteWorkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteWork500JSONResponse Error
+
+func (response CompleteWork500JSONResponse) VisitCompleteWorkResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowVersionRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
-	Body       *CreateWorkflowVersionJSONRequestBody
+type DeleteWorkerDataRequestObject struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
 }
 
-type CreateWorkflowVersionResponseObject interface {
-	VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error
+type DeleteWorkerDataResponseObject interface {
+	VisitDeleteWorkerDataResponse(w http.ResponseWriter) error
 }
 
-type CreateWorkflowVersion201JSONResponse WorkflowVersion
+type DeleteWorkerData204Response struct {
+}
 
-func (response CreateWorkflowVersion201JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response DeleteWorkerData204Response) VisitDeleteWorkerDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkerData500JSONResponse Error
+
+func (response DeleteWorkerData500JSONResponse) VisitDeleteWorkerDataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowVersion400JSONResponse Error
+type GetWorkerDataRequestObject struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
+}
 
-func (response CreateWorkflowVersion400JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+type GetWorkerDataResponseObject interface {
+	VisitGetWorkerDataResponse(w http.ResponseWriter) error
+}
+
+type GetWorkerData200JSONResponse WorkerData
+
+func (response GetWorkerData200JSONResponse) VisitGetWorkerDataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowVersion404JSONResponse Error
+type GetWorkerData404JSONResponse Error
 
-func (response CreateWorkflowVersion404JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response GetWorkerData404JSONResponse) VisitGetWorkerDataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateWorkflowVersion500JSONResponse Error
+type GetWorkerData410JSONResponse Error
 
-func (response CreateWorkflowVersion500JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response GetWorkerData410JSONResponse) VisitGetWorkerDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkerData500JSONResponse Error
+
+func (response GetWorkerData500JSONResponse) VisitGetWorkerDataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLatestWorkflowVersionRequestObject struct {
-	WorkflowId openapi_types.UUID `json:"workflowId"`
+type StoreWorkerDataRequestObject struct {
+	Id   string `json:"id"`
+	Key  string `json:"key"`
+	Body *StoreWorkerDataJSONRequestBody
 }
 
-type GetLatestWorkflowVersionResponseObject interface {
-	VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error
+type StoreWorkerDataResponseObject interface {
+	VisitStoreWorkerDataResponse(w http.ResponseWriter) error
 }
 
-type GetLatestWorkflowVersion200JSONResponse WorkflowVersion
+type StoreWorkerData204Response struct {
+}
 
-func (response GetLatestWorkflowVersion200JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response StoreWorkerData204Response) VisitStoreWorkerDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StoreWorkerData500JSONResponse Error
+
+func (response StoreWorkerData500JSONResponse) VisitStoreWorkerDataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLatestWorkflowVersion404JSONResponse Error
+type UpdateWorkerHeartbeatRequestObject struct {
+	Id string `json:"id"`
+}
 
-func (response GetLatestWorkflowVersion404JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+type UpdateWorkerHeartbeatResponseObject interface {
+	VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkerHeartbeat200Response struct {
+}
+
+func (response UpdateWorkerHeartbeat200Response) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateWorkerHeartbeat404JSONResponse Error
+
+func (response UpdateWorkerHeartbeat404JSONResponse) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLatestWorkflowVersion500JSONResponse Error
+type UpdateWorkerHeartbeat500JSONResponse Error
 
-func (response GetLatestWorkflowVersion500JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response UpdateWorkerHeartbeat500JSONResponse) VisitUpdateWorkerHeartbeatResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeployWorkflowVersionRequestObject struct {
-	WorkflowId    openapi_types.UUID `json:"workflowId"`
-	VersionNumber int                `json:"versionNumber"`
+type ListInactiveWorkerRunsRequestObject struct {
+	Id   string `json:"id"`
+	Body *ListInactiveWorkerRunsJSONRequestBody
 }
 
-type DeployWorkflowVersionResponseObject interface {
-	VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error
+type ListInactiveWorkerRunsResponseObject interface {
+	VisitListInactiveWorkerRunsResponse(w http.ResponseWriter) error
 }
 
-type DeployWorkflowVersion200JSONResponse WorkflowVersion
+type ListInactiveWorkerRuns200JSONResponse InactiveRunsResponse
 
-func (response DeployWorkflowVersion200JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response ListInactiveWorkerRuns200JSONResponse) VisitListInactiveWorkerRunsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeployWorkflowVersion404JSONResponse Error
+type ListInactiveWorkerRuns500JSONResponse Error
 
-func (response DeployWorkflowVersion404JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+func (response ListInactiveWorkerRuns500JSONResponse) VisitListInactiveWorkerRunsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeployWorkflowVersion500JSONResponse Error
+type FinalizeWorkerRunRequestObject struct {
+	Id    string             `json:"id"`
+	RunId openapi_types.UUID `json:"runId"`
+}
 
-func (response DeployWorkflowVersion500JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+type FinalizeWorkerRunResponseObject interface {
+	VisitFinalizeWorkerRunResponse(w http.ResponseWriter) error
+}
+
+type FinalizeWorkerRun204Response struct {
+}
+
+func (response FinalizeWorkerRun204Response) VisitFinalizeWorkerRunResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type FinalizeWorkerRun500JSONResponse Error
+
+func (response FinalizeWorkerRun500JSONResponse) VisitFinalizeWorkerRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleWebhookRequestObject struct {
-	Token string `json:"token"`
-	Body  *HandleWebhookJSONRequestBody
+type InitializeWorkerRunRequestObject struct {
+	Id    string             `json:"id"`
+	RunId openapi_types.UUID `json:"runId"`
 }
 
-type HandleWebhookResponseObject interface {
-	VisitHandleWebhookResponse(w http.ResponseWriter) error
+type InitializeWorkerRunResponseObject interface {
+	VisitInitializeWorkerRunResponse(w http.ResponseWriter) error
 }
 
-type HandleWebhook200Response struct {
-}
+type InitializeWorkerRun200JSONResponse InitializeRunResponse
 
-func (response HandleWebhook200Response) VisitHandleWebhookResponse(w http.ResponseWriter) error {
+func (response InitializeWorkerRun200JSONResponse) VisitInitializeWorkerRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleWebhook404JSONResponse Error
+type InitializeWorkerRun404JSONResponse Error
 
-func (response HandleWebhook404JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
+func (response InitializeWorkerRun404JSONResponse) VisitInitializeWorkerRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleWebhook500JSONResponse Error
+type InitializeWorkerRun410JSONResponse Error
 
-func (response HandleWebhook500JSONResponse) VisitHandleWebhookResponse(w http.ResponseWriter) error {
+func (response InitializeWorkerRun410JSONResponse) VisitInitializeWorkerRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(410)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
+type InitializeWorkerRun500JSONResponse Error
+
+func (response InitializeWorkerRun500JSONResponse) VisitInitializeWorkerRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepHeartbeatRequestObject struct {
+	Id     string             `json:"id"`
+	StepId openapi_types.UUID `json:"stepId"`
+	Body   *ReportStepHeartbeatJSONRequestBody
+}
+
+type ReportStepHeartbeatResponseObject interface {
+	VisitReportStepHeartbeatResponse(w http.ResponseWriter) error
+}
+
+type ReportStepHeartbeat204Response struct {
+}
+
+func (response ReportStepHeartbeat204Response) VisitReportStepHeartbeatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReportStepHeartbeat409JSONResponse Error
+
+func (response ReportStepHeartbeat409JSONResponse) VisitReportStepHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepHeartbeat500JSONResponse Error
+
+func (response ReportStepHeartbeat500JSONResponse) VisitReportStepHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepResultRequestObject struct {
+	Id     string             `json:"id"`
+	StepId openapi_types.UUID `json:"stepId"`
+	Body   *ReportStepResultJSONRequestBody
+}
+
+type ReportStepResultResponseObject interface {
+	VisitReportStepResultResponse(w http.ResponseWriter) error
+}
+
+type ReportStepResult200JSONResponse StepResultResponse
+
+func (response ReportStepResult200JSONResponse) VisitReportStepResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepResult404JSONResponse Error
+
+func (response ReportStepResult404JSONResponse) VisitReportStepResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepResult409JSONResponse Error
+
+func (response ReportStepResult409JSONResponse) VisitReportStepResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepResult410JSONResponse Error
+
+func (response ReportStepResult410JSONResponse) VisitReportStepResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportStepResult500JSONResponse Error
+
+func (response ReportStepResult500JSONResponse) VisitReportStepResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartWorkerStepRequestObject struct {
+	Id     string             `json:"id"`
+	StepId openapi_types.UUID `json:"stepId"`
+}
+
+type StartWorkerStepResponseObject interface {
+	VisitStartWorkerStepResponse(w http.ResponseWriter) error
+}
+
+type StartWorkerStep200JSONResponse WorkerStep
+
+func (response StartWorkerStep200JSONResponse) VisitStartWorkerStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartWorkerStep404JSONResponse Error
+
+func (response StartWorkerStep404JSONResponse) VisitStartWorkerStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartWorkerStep409JSONResponse Error
+
+func (response StartWorkerStep409JSONResponse) VisitStartWorkerStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartWorkerStep410JSONResponse Error
+
+func (response StartWorkerStep410JSONResponse) VisitStartWorkerStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartWorkerStep500JSONResponse Error
+
+func (response StartWorkerStep500JSONResponse) VisitStartWorkerStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowRunsRequestObject struct {
+	Params ListWorkflowRunsParams
+}
+
+type ListWorkflowRunsResponseObject interface {
+	VisitListWorkflowRunsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflowRuns200JSONResponse WorkflowRunList
+
+func (response ListWorkflowRuns200JSONResponse) VisitListWorkflowRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowRuns500JSONResponse Error
+
+func (response ListWorkflowRuns500JSONResponse) VisitListWorkflowRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRunRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetWorkflowRunResponseObject interface {
+	VisitGetWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowRun200JSONResponse WorkflowRun
+
+func (response GetWorkflowRun200JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRun404JSONResponse Error
+
+func (response GetWorkflowRun404JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRun500JSONResponse Error
+
+func (response GetWorkflowRun500JSONResponse) VisitGetWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWorkflowRunRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ReplayWorkflowRunJSONRequestBody
+}
+
+type ReplayWorkflowRunResponseObject interface {
+	VisitReplayWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type ReplayWorkflowRun201JSONResponse WorkflowRun
+
+func (response ReplayWorkflowRun201JSONResponse) VisitReplayWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWorkflowRun400JSONResponse Error
+
+func (response ReplayWorkflowRun400JSONResponse) VisitReplayWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWorkflowRun404JSONResponse Error
+
+func (response ReplayWorkflowRun404JSONResponse) VisitReplayWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWorkflowRun500JSONResponse Error
+
+func (response ReplayWorkflowRun500JSONResponse) VisitReplayWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignalWorkflowRunRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Name   string             `json:"name"`
+	Params SignalWorkflowRunParams
+	Body   *SignalWorkflowRunJSONRequestBody
+}
+
+type SignalWorkflowRunResponseObject interface {
+	VisitSignalWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type SignalWorkflowRun200JSONResponse SignalResponse
+
+func (response SignalWorkflowRun200JSONResponse) VisitSignalWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignalWorkflowRun403JSONResponse Error
+
+func (response SignalWorkflowRun403JSONResponse) VisitSignalWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignalWorkflowRun404JSONResponse Error
+
+func (response SignalWorkflowRun404JSONResponse) VisitSignalWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignalWorkflowRun410JSONResponse Error
+
+func (response SignalWorkflowRun410JSONResponse) VisitSignalWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignalWorkflowRun500JSONResponse Error
+
+func (response SignalWorkflowRun500JSONResponse) VisitSignalWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRunStepsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetWorkflowRunStepsResponseObject interface {
+	VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowRunSteps200JSONResponse []WorkflowStep
+
+func (response GetWorkflowRunSteps200JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRunSteps404JSONResponse Error
+
+func (response GetWorkflowRunSteps404JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRunSteps500JSONResponse Error
+
+func (response GetWorkflowRunSteps500JSONResponse) VisitGetWorkflowRunStepsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowsRequestObject struct {
+	Params ListWorkflowsParams
+}
+
+type ListWorkflowsResponseObject interface {
+	VisitListWorkflowsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflows200JSONResponse WorkflowList
+
+func (response ListWorkflows200JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflows500JSONResponse Error
+
+func (response ListWorkflows500JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowRequestObject struct {
+	Body *CreateWorkflowJSONRequestBody
+}
+
+type CreateWorkflowResponseObject interface {
+	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflow201JSONResponse Workflow
+
+func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow400JSONResponse Error
+
+func (response CreateWorkflow400JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow500JSONResponse Error
+
+func (response CreateWorkflow500JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflowRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteWorkflowResponseObject interface {
+	VisitDeleteWorkflowResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkflow204Response struct {
+}
+
+func (response DeleteWorkflow204Response) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkflow404JSONResponse Error
+
+func (response DeleteWorkflow404JSONResponse) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflow500JSONResponse Error
+
+func (response DeleteWorkflow500JSONResponse) VisitDeleteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetWorkflowResponseObject interface {
+	VisitGetWorkflowResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflow200JSONResponse Workflow
+
+func (response GetWorkflow200JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflow404JSONResponse Error
+
+func (response GetWorkflow404JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflow500JSONResponse Error
+
+func (response GetWorkflow500JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateWorkflowJSONRequestBody
+}
+
+type UpdateWorkflowResponseObject interface {
+	VisitUpdateWorkflowResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkflow200JSONResponse Workflow
+
+func (response UpdateWorkflow200JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflow400JSONResponse Error
+
+func (response UpdateWorkflow400JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflow404JSONResponse Error
+
+func (response UpdateWorkflow404JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflow500JSONResponse Error
+
+func (response UpdateWorkflow500JSONResponse) VisitUpdateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteWorkflowRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ExecuteWorkflowParams
+	Body   *ExecuteWorkflowJSONRequestBody
+}
+
+type ExecuteWorkflowResponseObject interface {
+	VisitExecuteWorkflowResponse(w http.ResponseWriter) error
+}
+
+type ExecuteWorkflow200JSONResponse WorkflowExecution
+
+func (response ExecuteWorkflow200JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteWorkflow400JSONResponse Error
+
+func (response ExecuteWorkflow400JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteWorkflow404JSONResponse Error
+
+func (response ExecuteWorkflow404JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecuteWorkflow500JSONResponse Error
+
+func (response ExecuteWorkflow500JSONResponse) VisitExecuteWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowDraftRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+type GetWorkflowDraftResponseObject interface {
+	VisitGetWorkflowDraftResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowDraft200JSONResponse WorkflowDraft
+
+func (response GetWorkflowDraft200JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowDraft404JSONResponse Error
+
+func (response GetWorkflowDraft404JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowDraft500JSONResponse Error
+
+func (response GetWorkflowDraft500JSONResponse) VisitGetWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowDraftRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	Body       *UpdateWorkflowDraftJSONRequestBody
+}
+
+type UpdateWorkflowDraftResponseObject interface {
+	VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkflowDraft200JSONResponse WorkflowDraft
+
+func (response UpdateWorkflowDraft200JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowDraft400JSONResponse Error
+
+func (response UpdateWorkflowDraft400JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowDraft404JSONResponse Error
+
+func (response UpdateWorkflowDraft404JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowDraft500JSONResponse Error
+
+func (response UpdateWorkflowDraft500JSONResponse) VisitUpdateWorkflowDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWorkflowDraftNodeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	NodeId     string             `json:"nodeId"`
+	Body       *TestWorkflowDraftNodeJSONRequestBody
+}
+
+type TestWorkflowDraftNodeResponseObject interface {
+	VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error
+}
+
+type TestWorkflowDraftNode200JSONResponse NodeTestResult
+
+func (response TestWorkflowDraftNode200JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWorkflowDraftNode400JSONResponse Error
+
+func (response TestWorkflowDraftNode400JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWorkflowDraftNode404JSONResponse Error
+
+func (response TestWorkflowDraftNode404JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWorkflowDraftNode500JSONResponse Error
+
+func (response TestWorkflowDraftNode500JSONResponse) VisitTestWorkflowDraftNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowEdgesRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+type ListWorkflowEdgesResponseObject interface {
+	VisitListWorkflowEdgesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflowEdges200JSONResponse []WorkflowEdge
+
+func (response ListWorkflowEdges200JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowEdges404JSONResponse Error
+
+func (response ListWorkflowEdges404JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowEdges500JSONResponse Error
+
+func (response ListWorkflowEdges500JSONResponse) VisitListWorkflowEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowEdgeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	Body       *CreateWorkflowEdgeJSONRequestBody
+}
+
+type CreateWorkflowEdgeResponseObject interface {
+	VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflowEdge201JSONResponse WorkflowEdge
+
+func (response CreateWorkflowEdge201JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowEdge400JSONResponse Error
+
+func (response CreateWorkflowEdge400JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowEdge404JSONResponse Error
+
+func (response CreateWorkflowEdge404JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowEdge500JSONResponse Error
+
+func (response CreateWorkflowEdge500JSONResponse) VisitCreateWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflowEdgeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	EdgeId     string             `json:"edgeId"`
+}
+
+type DeleteWorkflowEdgeResponseObject interface {
+	VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkflowEdge204Response struct {
+}
+
+func (response DeleteWorkflowEdge204Response) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkflowEdge404JSONResponse Error
+
+func (response DeleteWorkflowEdge404JSONResponse) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflowEdge500JSONResponse Error
+
+func (response DeleteWorkflowEdge500JSONResponse) VisitDeleteWorkflowEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AutoLayoutWorkflowRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+type AutoLayoutWorkflowResponseObject interface {
+	VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error
+}
+
+type AutoLayoutWorkflow200JSONResponse WorkflowLayoutResult
+
+func (response AutoLayoutWorkflow200JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AutoLayoutWorkflow404JSONResponse Error
+
+func (response AutoLayoutWorkflow404JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AutoLayoutWorkflow500JSONResponse Error
+
+func (response AutoLayoutWorkflow500JSONResponse) VisitAutoLayoutWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowNodesRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+type ListWorkflowNodesResponseObject interface {
+	VisitListWorkflowNodesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflowNodes200JSONResponse []WorkflowNode
+
+func (response ListWorkflowNodes200JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowNodes404JSONResponse Error
+
+func (response ListWorkflowNodes404JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowNodes500JSONResponse Error
+
+func (response ListWorkflowNodes500JSONResponse) VisitListWorkflowNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowNodeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	Body       *CreateWorkflowNodeJSONRequestBody
+}
+
+type CreateWorkflowNodeResponseObject interface {
+	VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflowNode201JSONResponse WorkflowNode
+
+func (response CreateWorkflowNode201JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowNode400JSONResponse Error
+
+func (response CreateWorkflowNode400JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowNode404JSONResponse Error
+
+func (response CreateWorkflowNode404JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowNode500JSONResponse Error
+
+func (response CreateWorkflowNode500JSONResponse) VisitCreateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflowNodeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	NodeId     string             `json:"nodeId"`
+}
+
+type DeleteWorkflowNodeResponseObject interface {
+	VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkflowNode204Response struct {
+}
+
+func (response DeleteWorkflowNode204Response) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkflowNode404JSONResponse Error
+
+func (response DeleteWorkflowNode404JSONResponse) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkflowNode500JSONResponse Error
+
+func (response DeleteWorkflowNode500JSONResponse) VisitDeleteWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowNodeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	NodeId     string             `json:"nodeId"`
+}
+
+type GetWorkflowNodeResponseObject interface {
+	VisitGetWorkflowNodeResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowNode200JSONResponse WorkflowNode
+
+func (response GetWorkflowNode200JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowNode404JSONResponse Error
+
+func (response GetWorkflowNode404JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowNode500JSONResponse Error
+
+func (response GetWorkflowNode500JSONResponse) VisitGetWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowNodeRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	NodeId     string             `json:"nodeId"`
+	Body       *UpdateWorkflowNodeJSONRequestBody
+}
+
+type UpdateWorkflowNodeResponseObject interface {
+	VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkflowNode200JSONResponse WorkflowNode
+
+func (response UpdateWorkflowNode200JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowNode400JSONResponse Error
+
+func (response UpdateWorkflowNode400JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowNode404JSONResponse Error
+
+func (response UpdateWorkflowNode404JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowNode500JSONResponse Error
+
+func (response UpdateWorkflowNode500JSONResponse) VisitUpdateWorkflowNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowVersionsRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	Params     ListWorkflowVersionsParams
+}
+
+type ListWorkflowVersionsResponseObject interface {
+	VisitListWorkflowVersionsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflowVersions200JSONResponse WorkflowVersionList
+
+func (response ListWorkflowVersions200JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowVersions404JSONResponse Error
+
+func (response ListWorkflowVersions404JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowVersions500JSONResponse Error
+
+func (response ListWorkflowVersions500JSONResponse) VisitListWorkflowVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowVersionRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+	Body       *CreateWorkflowVersionJSONRequestBody
+}
+
+type CreateWorkflowVersionResponseObject interface {
+	VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflowVersion201JSONResponse WorkflowVersion
+
+func (response CreateWorkflowVersion201JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowVersion400JSONResponse Error
+
+func (response CreateWorkflowVersion400JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowVersion404JSONResponse Error
+
+func (response CreateWorkflowVersion404JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowVersion500JSONResponse Error
+
+func (response CreateWorkflowVersion500JSONResponse) VisitCreateWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLatestWorkflowVersionRequestObject struct {
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
+type GetLatestWorkflowVersionResponseObject interface {
+	VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error
+}
+
+type GetLatestWorkflowVersion200JSONResponse WorkflowVersion
+
+func (response GetLatestWorkflowVersion200JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLatestWorkflowVersion404JSONResponse Error
+
+func (response GetLatestWorkflowVersion404JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLatestWorkflowVersion500JSONResponse Error
+
+func (response GetLatestWorkflowVersion500JSONResponse) VisitGetLatestWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployWorkflowVersionRequestObject struct {
+	WorkflowId    openapi_types.UUID `json:"workflowId"`
+	VersionNumber int                `json:"versionNumber"`
+}
+
+type DeployWorkflowVersionResponseObject interface {
+	VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error
+}
+
+type DeployWorkflowVersion200JSONResponse WorkflowVersion
+
+func (response DeployWorkflowVersion200JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployWorkflowVersion404JSONResponse Error
+
+func (response DeployWorkflowVersion404JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployWorkflowVersion500JSONResponse Error
+
+func (response DeployWorkflowVersion500JSONResponse) VisitDeployWorkflowVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Chat with AI assistant
 	// (POST /api/assistant/chat)
@@ -5502,6 +7222,18 @@ type StrictServerInterface interface {
 	// List credentials for selection in nodes
 	// (GET /api/credentials)
 	ListCredentials(ctx context.Context, request ListCredentialsRequestObject) (ListCredentialsResponseObject, error)
+	// List dead letters
+	// (GET /api/dead-letters)
+	ListDeadLetters(ctx context.Context, request ListDeadLettersRequestObject) (ListDeadLettersResponseObject, error)
+	// Discard a dead letter
+	// (DELETE /api/dead-letters/{id})
+	DiscardDeadLetter(ctx context.Context, request DiscardDeadLetterRequestObject) (DiscardDeadLetterResponseObject, error)
+	// Get a dead letter
+	// (GET /api/dead-letters/{id})
+	GetDeadLetter(ctx context.Context, request GetDeadLetterRequestObject) (GetDeadLetterResponseObject, error)
+	// Requeue a dead letter
+	// (POST /api/dead-letters/{id}/requeue)
+	RequeueDeadLetter(ctx context.Context, request RequeueDeadLetterRequestObject) (RequeueDeadLetterResponseObject, error)
 	// List available extensions and plugins
 	// (GET /api/extensions)
 	ListExtensions(ctx context.Context, request ListExtensionsRequestObject) (ListExtensionsResponseObject, error)
@@ -5532,6 +7264,9 @@ type StrictServerInterface interface {
 	// Update trigger
 	// (PUT /api/triggers/{id})
 	UpdateTrigger(ctx context.Context, request UpdateTriggerRequestObject) (UpdateTriggerResponseObject, error)
+	// Webhook endpoint
+	// (POST /api/webhooks/{token})
+	HandleWebhook(ctx context.Context, request HandleWebhookRequestObject) (HandleWebhookResponseObject, error)
 	// List all workers
 	// (GET /api/workers)
 	ListWorkers(ctx context.Context, request ListWorkersRequestObject) (ListWorkersResponseObject, error)
@@ -5547,15 +7282,48 @@ type StrictServerInterface interface {
 	// Complete a work item
 	// (POST /api/workers/{id}/complete-work/{itemId})
 	CompleteWork(ctx context.Context, request CompleteWorkRequestObject) (CompleteWorkResponseObject, error)
+	// Delete shared data
+	// (DELETE /api/workers/{id}/data/{key})
+	DeleteWorkerData(ctx context.Context, request DeleteWorkerDataRequestObject) (DeleteWorkerDataResponseObject, error)
+	// Retrieve shared data
+	// (GET /api/workers/{id}/data/{key})
+	GetWorkerData(ctx context.Context, request GetWorkerDataRequestObject) (GetWorkerDataResponseObject, error)
+	// Store shared data
+	// (PUT /api/workers/{id}/data/{key})
+	StoreWorkerData(ctx context.Context, request StoreWorkerDataRequestObject) (StoreWorkerDataResponseObject, error)
 	// Update worker heartbeat
 	// (PUT /api/workers/{id}/heartbeat)
 	UpdateWorkerHeartbeat(ctx context.Context, request UpdateWorkerHeartbeatRequestObject) (UpdateWorkerHeartbeatResponseObject, error)
+	// Check for inactive runs
+	// (POST /api/workers/{id}/inactive-runs)
+	ListInactiveWorkerRuns(ctx context.Context, request ListInactiveWorkerRunsRequestObject) (ListInactiveWorkerRunsResponseObject, error)
+	// Finalize a workflow run
+	// (POST /api/workers/{id}/runs/{runId}/finalize)
+	FinalizeWorkerRun(ctx context.Context, request FinalizeWorkerRunRequestObject) (FinalizeWorkerRunResponseObject, error)
+	// Initialize a workflow run
+	// (POST /api/workers/{id}/runs/{runId}/initialize)
+	InitializeWorkerRun(ctx context.Context, request InitializeWorkerRunRequestObject) (InitializeWorkerRunResponseObject, error)
+	// Report a step heartbeat
+	// (PUT /api/workers/{id}/steps/{stepId}/heartbeat)
+	ReportStepHeartbeat(ctx context.Context, request ReportStepHeartbeatRequestObject) (ReportStepHeartbeatResponseObject, error)
+	// Report a step result
+	// (POST /api/workers/{id}/steps/{stepId}/result)
+	ReportStepResult(ctx context.Context, request ReportStepResultRequestObject) (ReportStepResultResponseObject, error)
+	// Start executing a step
+	// (POST /api/workers/{id}/steps/{stepId}/start)
+	StartWorkerStep(ctx context.Context, request StartWorkerStepRequestObject) (StartWorkerStepResponseObject, error)
 	// List workflow runs
 	// (GET /api/workflow-runs)
 	ListWorkflowRuns(ctx context.Context, request ListWorkflowRunsRequestObject) (ListWorkflowRunsResponseObject, error)
 	// Get workflow run details
 	// (GET /api/workflow-runs/{id})
 	GetWorkflowRun(ctx context.Context, request GetWorkflowRunRequestObject) (GetWorkflowRunResponseObject, error)
+	// Replay a workflow run from a node
+	// (POST /api/workflow-runs/{id}/replay)
+	ReplayWorkflowRun(ctx context.Context, request ReplayWorkflowRunRequestObject) (ReplayWorkflowRunResponseObject, error)
+	// Send a signal to a workflow run
+	// (POST /api/workflow-runs/{id}/signals/{name})
+	SignalWorkflowRun(ctx context.Context, request SignalWorkflowRunRequestObject) (SignalWorkflowRunResponseObject, error)
 	// Get workflow run steps
 	// (GET /api/workflow-runs/{id}/steps)
 	GetWorkflowRunSteps(ctx context.Context, request GetWorkflowRunStepsRequestObject) (GetWorkflowRunStepsResponseObject, error)
@@ -5625,64 +7393,388 @@ type StrictServerInterface interface {
 	// Deploy a specific workflow version
 	// (POST /api/workflows/{workflowId}/versions/{versionNumber}/deploy)
 	DeployWorkflowVersion(ctx context.Context, request DeployWorkflowVersionRequestObject) (DeployWorkflowVersionResponseObject, error)
-	// Webhook endpoint
-	// (POST /webhooks/{token})
-	HandleWebhook(ctx context.Context, request HandleWebhookRequestObject) (HandleWebhookResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AssistantChat operation middleware
+func (sh *strictHandler) AssistantChat(w http.ResponseWriter, r *http.Request) {
+	var request AssistantChatRequestObject
+
+	var body AssistantChatJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AssistantChat(ctx, request.(AssistantChatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssistantChat")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AssistantChatResponseObject); ok {
+		if err := validResponse.VisitAssistantChatResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListConnections operation middleware
+func (sh *strictHandler) ListConnections(w http.ResponseWriter, r *http.Request) {
+	var request ListConnectionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListConnections(ctx, request.(ListConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListConnections")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListConnectionsResponseObject); ok {
+		if err := validResponse.VisitListConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateConnection operation middleware
+func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request) {
+	var request CreateConnectionRequestObject
+
+	var body CreateConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
+		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteConnection operation middleware
+func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteConnectionRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// GetConnection operation middleware
+func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
+		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// UpdateConnection operation middleware
+func (sh *strictHandler) UpdateConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateConnectionRequestObject
+
+	request.Id = id
+
+	var body UpdateConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConnection(ctx, request.(UpdateConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateConnectionResponseObject); ok {
+		if err := validResponse.VisitUpdateConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// ListCredentialTypes operation middleware
+func (sh *strictHandler) ListCredentialTypes(w http.ResponseWriter, r *http.Request) {
+	var request ListCredentialTypesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCredentialTypes(ctx, request.(ListCredentialTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCredentialTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCredentialTypesResponseObject); ok {
+		if err := validResponse.VisitListCredentialTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// AssistantChat operation middleware
-func (sh *strictHandler) AssistantChat(w http.ResponseWriter, r *http.Request) {
-	var request AssistantChatRequestObject
+// GetCredentialTypeSchema operation middleware
+func (sh *strictHandler) GetCredentialTypeSchema(w http.ResponseWriter, r *http.Request, pType string) {
+	var request GetCredentialTypeSchemaRequestObject
 
-	var body AssistantChatJSONRequestBody
+	request.Type = pType
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialTypeSchema(ctx, request.(GetCredentialTypeSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialTypeSchema")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialTypeSchemaResponseObject); ok {
+		if err := validResponse.VisitGetCredentialTypeSchemaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TestCredentials operation middleware
+func (sh *strictHandler) TestCredentials(w http.ResponseWriter, r *http.Request, pType string) {
+	var request TestCredentialsRequestObject
+
+	request.Type = pType
+
+	var body TestCredentialsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	request.Body = &body
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestCredentials(ctx, request.(TestCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestCredentialsResponseObject); ok {
+		if err := validResponse.VisitTestCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCredentials operation middleware
+func (sh *strictHandler) ListCredentials(w http.ResponseWriter, r *http.Request, params ListCredentialsParams) {
+	var request ListCredentialsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCredentials(ctx, request.(ListCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCredentialsResponseObject); ok {
+		if err := validResponse.VisitListCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDeadLetters operation middleware
+func (sh *strictHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams) {
+	var request ListDeadLettersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDeadLetters(ctx, request.(ListDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDeadLettersResponseObject); ok {
+		if err := validResponse.VisitListDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiscardDeadLetter operation middleware
+func (sh *strictHandler) DiscardDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DiscardDeadLetterRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiscardDeadLetter(ctx, request.(DiscardDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiscardDeadLetter")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiscardDeadLetterResponseObject); ok {
+		if err := validResponse.VisitDiscardDeadLetterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDeadLetter operation middleware
+func (sh *strictHandler) GetDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetDeadLetterRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AssistantChat(ctx, request.(AssistantChatRequestObject))
+		return sh.ssi.GetDeadLetter(ctx, request.(GetDeadLetterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AssistantChat")
+		handler = middleware(handler, "GetDeadLetter")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AssistantChatResponseObject); ok {
-		if err := validResponse.VisitAssistantChatResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDeadLetterResponseObject); ok {
+		if err := validResponse.VisitGetDeadLetterResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5690,23 +7782,25 @@ func (sh *strictHandler) AssistantChat(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListConnections operation middleware
-func (sh *strictHandler) ListConnections(w http.ResponseWriter, r *http.Request) {
-	var request ListConnectionsRequestObject
+// RequeueDeadLetter operation middleware
+func (sh *strictHandler) RequeueDeadLetter(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RequeueDeadLetterRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListConnections(ctx, request.(ListConnectionsRequestObject))
+		return sh.ssi.RequeueDeadLetter(ctx, request.(RequeueDeadLetterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListConnections")
+		handler = middleware(handler, "RequeueDeadLetter")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListConnectionsResponseObject); ok {
-		if err := validResponse.VisitListConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RequeueDeadLetterResponseObject); ok {
+		if err := validResponse.VisitRequeueDeadLetterResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5714,30 +7808,23 @@ func (sh *strictHandler) ListConnections(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateConnection operation middleware
-func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request) {
-	var request CreateConnectionRequestObject
-
-	var body CreateConnectionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// ListExtensions operation middleware
+func (sh *strictHandler) ListExtensions(w http.ResponseWriter, r *http.Request) {
+	var request ListExtensionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+		return sh.ssi.ListExtensions(ctx, request.(ListExtensionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateConnection")
+		handler = middleware(handler, "ListExtensions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
-		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListExtensionsResponseObject); ok {
+		if err := validResponse.VisitListExtensionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5745,25 +7832,23 @@ func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteConnection operation middleware
-func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteConnectionRequestObject
-
-	request.Id = id
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnection")
+		handler = middleware(handler, "GetHealth")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5771,25 +7856,23 @@ func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConnection operation middleware
-func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetConnectionRequestObject
-
-	request.Id = id
+// ListIntegrations operation middleware
+func (sh *strictHandler) ListIntegrations(w http.ResponseWriter, r *http.Request) {
+	var request ListIntegrationsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+		return sh.ssi.ListIntegrations(ctx, request.(ListIntegrationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnection")
+		handler = middleware(handler, "ListIntegrations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
-		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListIntegrationsResponseObject); ok {
+		if err := validResponse.VisitListIntegrationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5797,32 +7880,25 @@ func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// UpdateConnection operation middleware
-func (sh *strictHandler) UpdateConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateConnectionRequestObject
-
-	request.Id = id
+// ListNodeTypes operation middleware
+func (sh *strictHandler) ListNodeTypes(w http.ResponseWriter, r *http.Request, params ListNodeTypesParams) {
+	var request ListNodeTypesRequestObject
 
-	var body UpdateConnectionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateConnection(ctx, request.(UpdateConnectionRequestObject))
+		return sh.ssi.ListNodeTypes(ctx, request.(ListNodeTypesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateConnection")
+		handler = middleware(handler, "ListNodeTypes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateConnectionResponseObject); ok {
-		if err := validResponse.VisitUpdateConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListNodeTypesResponseObject); ok {
+		if err := validResponse.VisitListNodeTypesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5830,23 +7906,27 @@ func (sh *strictHandler) UpdateConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListCredentialTypes operation middleware
-func (sh *strictHandler) ListCredentialTypes(w http.ResponseWriter, r *http.Request) {
-	var request ListCredentialTypesRequestObject
+// GetNodeParameterOptions operation middleware
+func (sh *strictHandler) GetNodeParameterOptions(w http.ResponseWriter, r *http.Request, pType string, parameter string, params GetNodeParameterOptionsParams) {
+	var request GetNodeParameterOptionsRequestObject
+
+	request.Type = pType
+	request.Parameter = parameter
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCredentialTypes(ctx, request.(ListCredentialTypesRequestObject))
+		return sh.ssi.GetNodeParameterOptions(ctx, request.(GetNodeParameterOptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCredentialTypes")
+		handler = middleware(handler, "GetNodeParameterOptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCredentialTypesResponseObject); ok {
-		if err := validResponse.VisitListCredentialTypesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetNodeParameterOptionsResponseObject); ok {
+		if err := validResponse.VisitGetNodeParameterOptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5854,25 +7934,23 @@ func (sh *strictHandler) ListCredentialTypes(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetCredentialTypeSchema operation middleware
-func (sh *strictHandler) GetCredentialTypeSchema(w http.ResponseWriter, r *http.Request, pType string) {
-	var request GetCredentialTypeSchemaRequestObject
-
-	request.Type = pType
+// ListTriggers operation middleware
+func (sh *strictHandler) ListTriggers(w http.ResponseWriter, r *http.Request) {
+	var request ListTriggersRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentialTypeSchema(ctx, request.(GetCredentialTypeSchemaRequestObject))
+		return sh.ssi.ListTriggers(ctx, request.(ListTriggersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentialTypeSchema")
+		handler = middleware(handler, "ListTriggers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialTypeSchemaResponseObject); ok {
-		if err := validResponse.VisitGetCredentialTypeSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTriggersResponseObject); ok {
+		if err := validResponse.VisitListTriggersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5880,13 +7958,11 @@ func (sh *strictHandler) GetCredentialTypeSchema(w http.ResponseWriter, r *http.
 	}
 }
 
-// TestCredentials operation middleware
-func (sh *strictHandler) TestCredentials(w http.ResponseWriter, r *http.Request, pType string) {
-	var request TestCredentialsRequestObject
-
-	request.Type = pType
+// CreateTrigger operation middleware
+func (sh *strictHandler) CreateTrigger(w http.ResponseWriter, r *http.Request) {
+	var request CreateTriggerRequestObject
 
-	var body TestCredentialsJSONRequestBody
+	var body CreateTriggerJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5894,18 +7970,18 @@ func (sh *strictHandler) TestCredentials(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TestCredentials(ctx, request.(TestCredentialsRequestObject))
+		return sh.ssi.CreateTrigger(ctx, request.(CreateTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TestCredentials")
+		handler = middleware(handler, "CreateTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TestCredentialsResponseObject); ok {
-		if err := validResponse.VisitTestCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTriggerResponseObject); ok {
+		if err := validResponse.VisitCreateTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5913,25 +7989,25 @@ func (sh *strictHandler) TestCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListCredentials operation middleware
-func (sh *strictHandler) ListCredentials(w http.ResponseWriter, r *http.Request, params ListCredentialsParams) {
-	var request ListCredentialsRequestObject
+// DeleteTrigger operation middleware
+func (sh *strictHandler) DeleteTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteTriggerRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCredentials(ctx, request.(ListCredentialsRequestObject))
+		return sh.ssi.DeleteTrigger(ctx, request.(DeleteTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCredentials")
+		handler = middleware(handler, "DeleteTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCredentialsResponseObject); ok {
-		if err := validResponse.VisitListCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTriggerResponseObject); ok {
+		if err := validResponse.VisitDeleteTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5939,23 +8015,25 @@ func (sh *strictHandler) ListCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListExtensions operation middleware
-func (sh *strictHandler) ListExtensions(w http.ResponseWriter, r *http.Request) {
-	var request ListExtensionsRequestObject
+// GetTrigger operation middleware
+func (sh *strictHandler) GetTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTriggerRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListExtensions(ctx, request.(ListExtensionsRequestObject))
+		return sh.ssi.GetTrigger(ctx, request.(GetTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListExtensions")
+		handler = middleware(handler, "GetTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListExtensionsResponseObject); ok {
-		if err := validResponse.VisitListExtensionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTriggerResponseObject); ok {
+		if err := validResponse.VisitGetTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5963,23 +8041,32 @@ func (sh *strictHandler) ListExtensions(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// UpdateTrigger operation middleware
+func (sh *strictHandler) UpdateTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateTriggerRequestObject
+
+	request.Id = id
+
+	var body UpdateTriggerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.UpdateTrigger(ctx, request.(UpdateTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "UpdateTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTriggerResponseObject); ok {
+		if err := validResponse.VisitUpdateTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5987,23 +8074,46 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListIntegrations operation middleware
-func (sh *strictHandler) ListIntegrations(w http.ResponseWriter, r *http.Request) {
-	var request ListIntegrationsRequestObject
+// HandleWebhook operation middleware
+func (sh *strictHandler) HandleWebhook(w http.ResponseWriter, r *http.Request, token string) {
+	var request HandleWebhookRequestObject
+
+	request.Token = token
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body HandleWebhookJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if err := r.ParseForm(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+			return
+		}
+		var body HandleWebhookFormdataRequestBody
+		if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+			return
+		}
+		request.FormdataBody = &body
+	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListIntegrations(ctx, request.(ListIntegrationsRequestObject))
+		return sh.ssi.HandleWebhook(ctx, request.(HandleWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListIntegrations")
+		handler = middleware(handler, "HandleWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListIntegrationsResponseObject); ok {
-		if err := validResponse.VisitListIntegrationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(HandleWebhookResponseObject); ok {
+		if err := validResponse.VisitHandleWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6011,25 +8121,54 @@ func (sh *strictHandler) ListIntegrations(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListNodeTypes operation middleware
-func (sh *strictHandler) ListNodeTypes(w http.ResponseWriter, r *http.Request, params ListNodeTypesParams) {
-	var request ListNodeTypesRequestObject
+// ListWorkers operation middleware
+func (sh *strictHandler) ListWorkers(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkersRequestObject
 
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkers(ctx, request.(ListWorkersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkersResponseObject); ok {
+		if err := validResponse.VisitListWorkersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterWorker operation middleware
+func (sh *strictHandler) RegisterWorker(w http.ResponseWriter, r *http.Request) {
+	var request RegisterWorkerRequestObject
+
+	var body RegisterWorkerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListNodeTypes(ctx, request.(ListNodeTypesRequestObject))
+		return sh.ssi.RegisterWorker(ctx, request.(RegisterWorkerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListNodeTypes")
+		handler = middleware(handler, "RegisterWorker")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListNodeTypesResponseObject); ok {
-		if err := validResponse.VisitListNodeTypesResponse(w); err != nil {
+	} else if validResponse, ok := response.(RegisterWorkerResponseObject); ok {
+		if err := validResponse.VisitRegisterWorkerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6037,27 +8176,25 @@ func (sh *strictHandler) ListNodeTypes(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// GetNodeParameterOptions operation middleware
-func (sh *strictHandler) GetNodeParameterOptions(w http.ResponseWriter, r *http.Request, pType string, parameter string, params GetNodeParameterOptionsParams) {
-	var request GetNodeParameterOptionsRequestObject
+// UnregisterWorker operation middleware
+func (sh *strictHandler) UnregisterWorker(w http.ResponseWriter, r *http.Request, id string) {
+	var request UnregisterWorkerRequestObject
 
-	request.Type = pType
-	request.Parameter = parameter
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetNodeParameterOptions(ctx, request.(GetNodeParameterOptionsRequestObject))
+		return sh.ssi.UnregisterWorker(ctx, request.(UnregisterWorkerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetNodeParameterOptions")
+		handler = middleware(handler, "UnregisterWorker")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetNodeParameterOptionsResponseObject); ok {
-		if err := validResponse.VisitGetNodeParameterOptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UnregisterWorkerResponseObject); ok {
+		if err := validResponse.VisitUnregisterWorkerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6065,23 +8202,32 @@ func (sh *strictHandler) GetNodeParameterOptions(w http.ResponseWriter, r *http.
 	}
 }
 
-// ListTriggers operation middleware
-func (sh *strictHandler) ListTriggers(w http.ResponseWriter, r *http.Request) {
-	var request ListTriggersRequestObject
+// ClaimWork operation middleware
+func (sh *strictHandler) ClaimWork(w http.ResponseWriter, r *http.Request, id string) {
+	var request ClaimWorkRequestObject
+
+	request.Id = id
+
+	var body ClaimWorkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTriggers(ctx, request.(ListTriggersRequestObject))
+		return sh.ssi.ClaimWork(ctx, request.(ClaimWorkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTriggers")
+		handler = middleware(handler, "ClaimWork")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTriggersResponseObject); ok {
-		if err := validResponse.VisitListTriggersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ClaimWorkResponseObject); ok {
+		if err := validResponse.VisitClaimWorkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6089,11 +8235,14 @@ func (sh *strictHandler) ListTriggers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateTrigger operation middleware
-func (sh *strictHandler) CreateTrigger(w http.ResponseWriter, r *http.Request) {
-	var request CreateTriggerRequestObject
+// CompleteWork operation middleware
+func (sh *strictHandler) CompleteWork(w http.ResponseWriter, r *http.Request, id string, itemId string) {
+	var request CompleteWorkRequestObject
 
-	var body CreateTriggerJSONRequestBody
+	request.Id = id
+	request.ItemId = itemId
+
+	var body CompleteWorkJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6101,18 +8250,18 @@ func (sh *strictHandler) CreateTrigger(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTrigger(ctx, request.(CreateTriggerRequestObject))
+		return sh.ssi.CompleteWork(ctx, request.(CompleteWorkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTrigger")
+		handler = middleware(handler, "CompleteWork")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTriggerResponseObject); ok {
-		if err := validResponse.VisitCreateTriggerResponse(w); err != nil {
+	} else if validResponse, ok := response.(CompleteWorkResponseObject); ok {
+		if err := validResponse.VisitCompleteWorkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6120,25 +8269,26 @@ func (sh *strictHandler) CreateTrigger(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteTrigger operation middleware
-func (sh *strictHandler) DeleteTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteTriggerRequestObject
+// DeleteWorkerData operation middleware
+func (sh *strictHandler) DeleteWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	var request DeleteWorkerDataRequestObject
 
 	request.Id = id
+	request.Key = key
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTrigger(ctx, request.(DeleteTriggerRequestObject))
+		return sh.ssi.DeleteWorkerData(ctx, request.(DeleteWorkerDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTrigger")
+		handler = middleware(handler, "DeleteWorkerData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTriggerResponseObject); ok {
-		if err := validResponse.VisitDeleteTriggerResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWorkerDataResponseObject); ok {
+		if err := validResponse.VisitDeleteWorkerDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6146,25 +8296,26 @@ func (sh *strictHandler) DeleteTrigger(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetTrigger operation middleware
-func (sh *strictHandler) GetTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetTriggerRequestObject
+// GetWorkerData operation middleware
+func (sh *strictHandler) GetWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	var request GetWorkerDataRequestObject
 
 	request.Id = id
+	request.Key = key
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTrigger(ctx, request.(GetTriggerRequestObject))
+		return sh.ssi.GetWorkerData(ctx, request.(GetWorkerDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTrigger")
+		handler = middleware(handler, "GetWorkerData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTriggerResponseObject); ok {
-		if err := validResponse.VisitGetTriggerResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkerDataResponseObject); ok {
+		if err := validResponse.VisitGetWorkerDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6172,13 +8323,14 @@ func (sh *strictHandler) GetTrigger(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// UpdateTrigger operation middleware
-func (sh *strictHandler) UpdateTrigger(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateTriggerRequestObject
+// StoreWorkerData operation middleware
+func (sh *strictHandler) StoreWorkerData(w http.ResponseWriter, r *http.Request, id string, key string) {
+	var request StoreWorkerDataRequestObject
 
 	request.Id = id
+	request.Key = key
 
-	var body UpdateTriggerJSONRequestBody
+	var body StoreWorkerDataJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6186,18 +8338,18 @@ func (sh *strictHandler) UpdateTrigger(w http.ResponseWriter, r *http.Request, i
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTrigger(ctx, request.(UpdateTriggerRequestObject))
+		return sh.ssi.StoreWorkerData(ctx, request.(StoreWorkerDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTrigger")
+		handler = middleware(handler, "StoreWorkerData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTriggerResponseObject); ok {
-		if err := validResponse.VisitUpdateTriggerResponse(w); err != nil {
+	} else if validResponse, ok := response.(StoreWorkerDataResponseObject); ok {
+		if err := validResponse.VisitStoreWorkerDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6205,23 +8357,25 @@ func (sh *strictHandler) UpdateTrigger(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// ListWorkers operation middleware
-func (sh *strictHandler) ListWorkers(w http.ResponseWriter, r *http.Request) {
-	var request ListWorkersRequestObject
+// UpdateWorkerHeartbeat operation middleware
+func (sh *strictHandler) UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateWorkerHeartbeatRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListWorkers(ctx, request.(ListWorkersRequestObject))
+		return sh.ssi.UpdateWorkerHeartbeat(ctx, request.(UpdateWorkerHeartbeatRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListWorkers")
+		handler = middleware(handler, "UpdateWorkerHeartbeat")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListWorkersResponseObject); ok {
-		if err := validResponse.VisitListWorkersResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWorkerHeartbeatResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkerHeartbeatResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6229,11 +8383,13 @@ func (sh *strictHandler) ListWorkers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RegisterWorker operation middleware
-func (sh *strictHandler) RegisterWorker(w http.ResponseWriter, r *http.Request) {
-	var request RegisterWorkerRequestObject
+// ListInactiveWorkerRuns operation middleware
+func (sh *strictHandler) ListInactiveWorkerRuns(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListInactiveWorkerRunsRequestObject
 
-	var body RegisterWorkerJSONRequestBody
+	request.Id = id
+
+	var body ListInactiveWorkerRunsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6241,18 +8397,18 @@ func (sh *strictHandler) RegisterWorker(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RegisterWorker(ctx, request.(RegisterWorkerRequestObject))
+		return sh.ssi.ListInactiveWorkerRuns(ctx, request.(ListInactiveWorkerRunsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RegisterWorker")
+		handler = middleware(handler, "ListInactiveWorkerRuns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterWorkerResponseObject); ok {
-		if err := validResponse.VisitRegisterWorkerResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListInactiveWorkerRunsResponseObject); ok {
+		if err := validResponse.VisitListInactiveWorkerRunsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6260,25 +8416,26 @@ func (sh *strictHandler) RegisterWorker(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UnregisterWorker operation middleware
-func (sh *strictHandler) UnregisterWorker(w http.ResponseWriter, r *http.Request, id string) {
-	var request UnregisterWorkerRequestObject
+// FinalizeWorkerRun operation middleware
+func (sh *strictHandler) FinalizeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID) {
+	var request FinalizeWorkerRunRequestObject
 
 	request.Id = id
+	request.RunId = runId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UnregisterWorker(ctx, request.(UnregisterWorkerRequestObject))
+		return sh.ssi.FinalizeWorkerRun(ctx, request.(FinalizeWorkerRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnregisterWorker")
+		handler = middleware(handler, "FinalizeWorkerRun")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UnregisterWorkerResponseObject); ok {
-		if err := validResponse.VisitUnregisterWorkerResponse(w); err != nil {
+	} else if validResponse, ok := response.(FinalizeWorkerRunResponseObject); ok {
+		if err := validResponse.VisitFinalizeWorkerRunResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6286,13 +8443,41 @@ func (sh *strictHandler) UnregisterWorker(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ClaimWork operation middleware
-func (sh *strictHandler) ClaimWork(w http.ResponseWriter, r *http.Request, id string) {
-	var request ClaimWorkRequestObject
+// InitializeWorkerRun operation middleware
+func (sh *strictHandler) InitializeWorkerRun(w http.ResponseWriter, r *http.Request, id string, runId openapi_types.UUID) {
+	var request InitializeWorkerRunRequestObject
 
 	request.Id = id
+	request.RunId = runId
 
-	var body ClaimWorkJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InitializeWorkerRun(ctx, request.(InitializeWorkerRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InitializeWorkerRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InitializeWorkerRunResponseObject); ok {
+		if err := validResponse.VisitInitializeWorkerRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReportStepHeartbeat operation middleware
+func (sh *strictHandler) ReportStepHeartbeat(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	var request ReportStepHeartbeatRequestObject
+
+	request.Id = id
+	request.StepId = stepId
+
+	var body ReportStepHeartbeatJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6300,18 +8485,18 @@ func (sh *strictHandler) ClaimWork(w http.ResponseWriter, r *http.Request, id st
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ClaimWork(ctx, request.(ClaimWorkRequestObject))
+		return sh.ssi.ReportStepHeartbeat(ctx, request.(ReportStepHeartbeatRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ClaimWork")
+		handler = middleware(handler, "ReportStepHeartbeat")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ClaimWorkResponseObject); ok {
-		if err := validResponse.VisitClaimWorkResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReportStepHeartbeatResponseObject); ok {
+		if err := validResponse.VisitReportStepHeartbeatResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6319,14 +8504,14 @@ func (sh *strictHandler) ClaimWork(w http.ResponseWriter, r *http.Request, id st
 	}
 }
 
-// CompleteWork operation middleware
-func (sh *strictHandler) CompleteWork(w http.ResponseWriter, r *http.Request, id string, itemId string) {
-	var request CompleteWorkRequestObject
+// ReportStepResult operation middleware
+func (sh *strictHandler) ReportStepResult(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	var request ReportStepResultRequestObject
 
 	request.Id = id
-	request.ItemId = itemId
+	request.StepId = stepId
 
-	var body CompleteWorkJSONRequestBody
+	var body ReportStepResultJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6334,18 +8519,18 @@ func (sh *strictHandler) CompleteWork(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CompleteWork(ctx, request.(CompleteWorkRequestObject))
+		return sh.ssi.ReportStepResult(ctx, request.(ReportStepResultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CompleteWork")
+		handler = middleware(handler, "ReportStepResult")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CompleteWorkResponseObject); ok {
-		if err := validResponse.VisitCompleteWorkResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReportStepResultResponseObject); ok {
+		if err := validResponse.VisitReportStepResultResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6353,25 +8538,26 @@ func (sh *strictHandler) CompleteWork(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// UpdateWorkerHeartbeat operation middleware
-func (sh *strictHandler) UpdateWorkerHeartbeat(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateWorkerHeartbeatRequestObject
+// StartWorkerStep operation middleware
+func (sh *strictHandler) StartWorkerStep(w http.ResponseWriter, r *http.Request, id string, stepId openapi_types.UUID) {
+	var request StartWorkerStepRequestObject
 
 	request.Id = id
+	request.StepId = stepId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateWorkerHeartbeat(ctx, request.(UpdateWorkerHeartbeatRequestObject))
+		return sh.ssi.StartWorkerStep(ctx, request.(StartWorkerStepRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateWorkerHeartbeat")
+		handler = middleware(handler, "StartWorkerStep")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateWorkerHeartbeatResponseObject); ok {
-		if err := validResponse.VisitUpdateWorkerHeartbeatResponse(w); err != nil {
+	} else if validResponse, ok := response.(StartWorkerStepResponseObject); ok {
+		if err := validResponse.VisitStartWorkerStepResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6431,6 +8617,74 @@ func (sh *strictHandler) GetWorkflowRun(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// ReplayWorkflowRun operation middleware
+func (sh *strictHandler) ReplayWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ReplayWorkflowRunRequestObject
+
+	request.Id = id
+
+	var body ReplayWorkflowRunJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplayWorkflowRun(ctx, request.(ReplayWorkflowRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplayWorkflowRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplayWorkflowRunResponseObject); ok {
+		if err := validResponse.VisitReplayWorkflowRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SignalWorkflowRun operation middleware
+func (sh *strictHandler) SignalWorkflowRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, name string, params SignalWorkflowRunParams) {
+	var request SignalWorkflowRunRequestObject
+
+	request.Id = id
+	request.Name = name
+	request.Params = params
+
+	var body SignalWorkflowRunJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SignalWorkflowRun(ctx, request.(SignalWorkflowRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SignalWorkflowRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SignalWorkflowRunResponseObject); ok {
+		if err := validResponse.VisitSignalWorkflowRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetWorkflowRunSteps operation middleware
 func (sh *strictHandler) GetWorkflowRunSteps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	var request GetWorkflowRunStepsRequestObject
@@ -6600,10 +8854,11 @@ func (sh *strictHandler) UpdateWorkflow(w http.ResponseWriter, r *http.Request,
 }
 
 // ExecuteWorkflow operation middleware
-func (sh *strictHandler) ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (sh *strictHandler) ExecuteWorkflow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExecuteWorkflowParams) {
 	var request ExecuteWorkflowRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body ExecuteWorkflowJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -7096,36 +9351,3 @@ func (sh *strictHandler) DeployWorkflowVersion(w http.ResponseWriter, r *http.Re
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
-
-// HandleWebhook operation middleware
-func (sh *strictHandler) HandleWebhook(w http.ResponseWriter, r *http.Request, token string) {
-	var request HandleWebhookRequestObject
-
-	request.Token = token
-
-	var body HandleWebhookJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.HandleWebhook(ctx, request.(HandleWebhookRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HandleWebhook")
-	}
-
-	response, err := handler(r.Context(), w, r, request)
-
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HandleWebhookResponseObject); ok {
-		if err := validResponse.VisitHandleWebhookResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
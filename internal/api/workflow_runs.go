@@ -234,7 +234,7 @@ func (h *WorkflowRunsHandler) createWorkflowRun(w http.ResponseWriter, r *http.R
 
 	// Set defaults
 	if run.TimeoutSeconds == 0 {
-		run.TimeoutSeconds = 3600 // 1 hour default
+		run.TimeoutSeconds = execution.DefaultRunTimeoutSeconds
 	}
 	if run.Variables == nil {
 		run.Variables = make(map[string]any)
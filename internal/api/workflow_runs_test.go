@@ -177,16 +177,41 @@ func (m *MockAPIEngine) RetryStep(ctx context.Context, stepID uuid.UUID) error {
 	return nil
 }
 
+func (m *MockAPIEngine) RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error {
+	return nil
+}
+
 // Implement remaining ExecutionEngine interface methods for testing
+func (m *MockAPIEngine) InitializeRun(ctx context.Context, runID uuid.UUID, workerID string) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (m *MockAPIEngine) FinalizeRun(ctx context.Context, runID uuid.UUID) error {
+	return nil
+}
+
+func (m *MockAPIEngine) PrepareStep(ctx context.Context, stepID uuid.UUID, workerID string) (*execution.WorkflowStep, error) {
+	return nil, nil
+}
+
 func (m *MockAPIEngine) ExecuteStep(ctx context.Context, step *execution.WorkflowStep) (*api.Envelope[any], error) {
 	return nil, nil
 }
 
+func (m *MockAPIEngine) RecordStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *api.Envelope[any], stepErr error) (*execution.WorkResult, error) {
+	return nil, nil
+}
+
 func (m *MockAPIEngine) ClaimWork(ctx context.Context, workerID string, maxItems int) ([]*execution.QueueItem, error) {
 	return nil, nil
 }
 
-func (m *MockAPIEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, result *execution.WorkResult) error {
+func (m *MockAPIEngine) WaitForWork(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (m *MockAPIEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, lease int64, result *execution.WorkResult) error {
 	return nil
 }
 
@@ -210,6 +235,42 @@ func (m *MockAPIEngine) RecoverFailedRuns(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockAPIEngine) TimeoutRuns(ctx context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (m *MockAPIEngine) InactiveRuns(ctx context.Context, runIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (m *MockAPIEngine) SignalRun(ctx context.Context, runID uuid.UUID, signal, token string, payload map[string]any) (uuid.UUID, error) {
+	return uuid.Nil, execution.ErrSignalNotAwaited
+}
+
+func (m *MockAPIEngine) ReplayRun(ctx context.Context, runID uuid.UUID, nodeID string, input *api.Envelope[any]) (*execution.WorkflowRun, error) {
+	return nil, execution.ErrNodeNotFound
+}
+
+func (m *MockAPIEngine) TimeoutSignals(ctx context.Context) error {
+	return nil
+}
+
+func (m *MockAPIEngine) ListDeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*execution.DeadLetter, int, error) {
+	return []*execution.DeadLetter{}, 0, nil
+}
+
+func (m *MockAPIEngine) GetDeadLetter(ctx context.Context, id uuid.UUID) (*execution.DeadLetter, error) {
+	return nil, execution.ErrDeadLetterNotFound
+}
+
+func (m *MockAPIEngine) RequeueDeadLetter(ctx context.Context, id uuid.UUID) error {
+	return execution.ErrDeadLetterNotFound
+}
+
+func (m *MockAPIEngine) DiscardDeadLetter(ctx context.Context, id uuid.UUID) error {
+	return execution.ErrDeadLetterNotFound
+}
+
 // Helper to create test router with mock engine
 func createTestRouter(db *sql.DB) http.Handler {
 	r := chi.NewRouter()
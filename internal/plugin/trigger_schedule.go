@@ -90,7 +90,7 @@ func (scheduleTriggerPlugin) OnTrigger(ctx context.Context, payload interface{})
 			BackoffMultiplier: 2.0,
 			MaxDelayMS:        3600000, // 1 hour
 		},
-		TimeoutSeconds: 3600,
+		TimeoutSeconds: execution.DefaultRunTimeoutSeconds,
 	}
 
 	// Insert workflow run
@@ -3,6 +3,7 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cedricziel/mel-agent/internal/db"
 	"github.com/cedricziel/mel-agent/internal/testutil"
+	"github.com/cedricziel/mel-agent/pkg/execution"
 )
 
 func TestWebhookTriggerPlugin_Meta(t *testing.T) {
@@ -41,7 +43,7 @@ func TestWebhookTriggerPlugin_OnTrigger_Integration(t *testing.T) {
 	db.DB = testDB
 	defer func() { db.DB = oldDB }()
 
-	plugin := webhookTriggerPlugin{}
+	plugin := webhookTriggerPlugin{engine: execution.NewDurableExecutionEngine(testDB, nil, "test")}
 
 	t.Run("creates workflow run and queue item", func(t *testing.T) {
 		// Setup test data
@@ -115,6 +117,81 @@ func TestWebhookTriggerPlugin_OnTrigger_Integration(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "start_run", queueType)
 	})
+
+	t.Run("returns the run of a retried delivery", func(t *testing.T) {
+		userID := uuid.New()
+		agentID := uuid.New()
+		versionID := uuid.New()
+		triggerID := uuid.New()
+
+		_, err := testDB.Exec(`INSERT INTO users (id, email, created_at) VALUES ($1, $2, NOW())`,
+			userID, "retry@example.com")
+		require.NoError(t, err)
+		_, err = testDB.Exec(`INSERT INTO agents (id, user_id, name, latest_version_id) VALUES ($1, $2, $3, $4)`,
+			agentID, userID, "Retry Agent", versionID)
+		require.NoError(t, err)
+
+		configJSON, _ := json.Marshal(map[string]interface{}{
+			"method":            "POST",
+			"idempotencyHeader": "X-GitHub-Delivery",
+		})
+		_, err = testDB.Exec(`
+			INSERT INTO triggers (id, user_id, provider, name, type, agent_id, node_id, config, enabled) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, triggerID, userID, "webhook", "Retry Webhook Trigger", "webhook", agentID, "webhook-node", configJSON, true)
+		require.NoError(t, err)
+
+		deliver := func(delivery string) string {
+			result, err := plugin.OnTrigger(context.Background(), map[string]interface{}{
+				"trigger_id":  triggerID.String(),
+				"agent_id":    agentID.String(),
+				"node_id":     "webhook-node",
+				"http_method": "POST",
+				"headers":     map[string][]string{"X-Github-Delivery": {delivery}},
+				"body_raw":    []byte(`{}`),
+			})
+			require.NoError(t, err)
+			return result.(string)
+		}
+
+		first := deliver("delivery-1")
+		assert.Equal(t, first, deliver("delivery-1"))
+		assert.NotEqual(t, first, deliver("delivery-2"))
+
+		var count int
+		err = testDB.QueryRow(`SELECT COUNT(*) FROM workflow_runs WHERE trigger_id = $1`, triggerID).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestWebhookIdempotencyKey(t *testing.T) {
+	headers := http.Header{"Idempotency-Key": {"header-key"}}
+	body := []byte(`{"id":"evt_1","data":{"object":{"id":42}}}`)
+
+	tests := []struct {
+		name    string
+		cfg     map[string]interface{}
+		headers http.Header
+		body    []byte
+		want    string
+	}{
+		{name: "no settings", cfg: map[string]interface{}{}, headers: headers, body: body, want: ""},
+		{name: "header", cfg: map[string]interface{}{"idempotencyHeader": "idempotency-key"}, headers: headers, body: body, want: "header-key"},
+		{name: "body path", cfg: map[string]interface{}{"idempotencyPath": "id"}, headers: headers, body: body, want: "evt_1"},
+		{name: "nested number", cfg: map[string]interface{}{"idempotencyPath": "data.object.id"}, body: body, want: "42"},
+		{name: "header before body", cfg: map[string]interface{}{"idempotencyHeader": "Idempotency-Key", "idempotencyPath": "id"}, headers: headers, body: body, want: "header-key"},
+		{name: "missing header falls back to body", cfg: map[string]interface{}{"idempotencyHeader": "X-Delivery", "idempotencyPath": "id"}, headers: headers, body: body, want: "evt_1"},
+		{name: "missing path", cfg: map[string]interface{}{"idempotencyPath": "data.missing"}, body: body, want: ""},
+		{name: "object at path", cfg: map[string]interface{}{"idempotencyPath": "data"}, body: body, want: ""},
+		{name: "non-JSON body", cfg: map[string]interface{}{"idempotencyPath": "id"}, body: []byte("id=evt_1"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WebhookIdempotencyKey(tt.cfg, tt.headers, tt.body))
+		})
+	}
 }
 
 func TestScheduleTriggerPlugin_Meta(t *testing.T) {
@@ -228,7 +305,7 @@ func TestTriggerPlugins_ErrorHandling(t *testing.T) {
 	defer func() { db.DB = oldDB }()
 
 	t.Run("webhook plugin handles invalid payload", func(t *testing.T) {
-		plugin := webhookTriggerPlugin{}
+		plugin := webhookTriggerPlugin{engine: execution.NewDurableExecutionEngine(testDB, nil, "test")}
 		ctx := context.Background()
 
 		_, err := plugin.OnTrigger(ctx, "invalid-payload")
@@ -246,7 +323,7 @@ func TestTriggerPlugins_ErrorHandling(t *testing.T) {
 	})
 
 	t.Run("webhook plugin handles agent without version", func(t *testing.T) {
-		plugin := webhookTriggerPlugin{}
+		plugin := webhookTriggerPlugin{engine: execution.NewDurableExecutionEngine(testDB, nil, "test")}
 		ctx := context.Background()
 
 		userID := uuid.New()
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,7 +17,16 @@ import (
 )
 
 // webhookTriggerPlugin handles HTTP webhook triggers.
-type webhookTriggerPlugin struct{}
+type webhookTriggerPlugin struct {
+	engine execution.ExecutionEngine
+}
+
+// NewWebhookTriggerPlugin creates the webhook trigger plugin starting runs
+// with the given execution engine, so that they are queued and deduplicated
+// like the runs of the API.
+func NewWebhookTriggerPlugin(engine execution.ExecutionEngine) TriggerPlugin {
+	return webhookTriggerPlugin{engine: engine}
+}
 
 // Meta describes the webhook trigger configuration schema.
 func (webhookTriggerPlugin) Meta() PluginMeta {
@@ -29,13 +40,20 @@ func (webhookTriggerPlugin) Meta() PluginMeta {
 			{Name: "mode", Label: "Mode", Type: "enum", Required: true, Default: "async", Options: []string{"async", "sync"}, Group: "Execution", Description: "Async enqueue or Sync inline"},
 			{Name: "statusCode", Label: "Response Status", Type: "number", Required: false, Default: 200, Group: "Response", Description: "HTTP status code (sync)"},
 			{Name: "responseBody", Label: "Response Body", Type: "string", Required: false, Default: "", Group: "Response", Description: "HTTP body (sync)"},
+			{Name: "responseTimeout", Label: "Response Timeout", Type: "number", Required: false, Default: 30, Group: "Response", Description: "Seconds to wait for the response of the run, at most 300 (sync)"},
+			{Name: "idempotencyHeader", Label: "Idempotency Header", Type: "string", Required: false, Default: "", Group: "Idempotency", Description: "Request header holding the delivery ID, e.g. Idempotency-Key or X-GitHub-Delivery"},
+			{Name: "idempotencyPath", Label: "Idempotency Body Path", Type: "string", Required: false, Default: "", Group: "Idempotency", Description: "Dot-separated path to the delivery ID in a JSON body, e.g. id"},
 		},
 	}
 }
 
 // OnTrigger fires when a webhook event arrives.
 // payload must include: trigger_id, agent_id, node_id, http_method, headers, body
-func (webhookTriggerPlugin) OnTrigger(ctx context.Context, payload interface{}) (interface{}, error) {
+func (p webhookTriggerPlugin) OnTrigger(ctx context.Context, payload interface{}) (interface{}, error) {
+	if p.engine == nil {
+		return nil, fmt.Errorf("webhook trigger: no execution engine")
+	}
+
 	data, ok := payload.(map[string]interface{})
 	if !ok {
 		return nil, fmt.Errorf("webhook trigger: invalid payload")
@@ -49,8 +67,9 @@ func (webhookTriggerPlugin) OnTrigger(ctx context.Context, payload interface{})
 
 	// Load trigger config for validation
 	var cfgRaw []byte
-	row := db.DB.QueryRow(`SELECT config FROM triggers WHERE id=$1`, triggerID)
-	if err := row.Scan(&cfgRaw); err != nil {
+	var workflowID uuid.NullUUID
+	row := db.DB.QueryRow(`SELECT config, workflow_id FROM triggers WHERE id=$1`, triggerID)
+	if err := row.Scan(&cfgRaw, &workflowID); err != nil {
 		return nil, err
 	}
 	var cfg map[string]interface{}
@@ -109,9 +128,8 @@ func (webhookTriggerPlugin) OnTrigger(ctx context.Context, payload interface{})
 	}
 
 	// Create workflow run using durable execution system
-	runID := uuid.New()
 	workflowRun := &execution.WorkflowRun{
-		ID:        runID,
+		ID:        uuid.New(),
 		AgentID:   agentUUID,
 		VersionID: versionUUID,
 		TriggerID: &triggerUUID,
@@ -124,48 +142,64 @@ func (webhookTriggerPlugin) OnTrigger(ctx context.Context, payload interface{})
 			BackoffMultiplier: 2.0,
 			MaxDelayMS:        3600000, // 1 hour
 		},
-		TimeoutSeconds: 3600,
+		TimeoutSeconds: execution.DefaultRunTimeoutSeconds,
+	}
+
+	// Runs of triggers of a workflow belong to it, so that their idempotency
+	// keys are scoped like the ones of webhooks of the API
+	if workflowID.Valid {
+		workflowRun.WorkflowID = &workflowID.UUID
 	}
 
-	// Use execution engine to start the run
-	// Note: This requires access to the execution engine instance
-	// For now, we'll insert directly to workflow_runs and queue tables
+	// Retried deliveries carry the same idempotency key and return the run
+	// of the first delivery
+	if key := WebhookIdempotencyKey(cfg, headers, bodyRaw); key != "" {
+		workflowRun.IdempotencyKey = &key
+	}
 
-	// Insert workflow run
-	inputDataJSON, _ := json.Marshal(inputData)
-	variablesJSON, _ := json.Marshal(workflowRun.Variables)
-	retryPolicyJSON, _ := json.Marshal(workflowRun.RetryPolicy)
+	if err := p.engine.StartRun(ctx, workflowRun); err != nil {
+		return nil, err
+	}
 
-	query := `
-		INSERT INTO workflow_runs (
-			id, agent_id, version_id, trigger_id, status, input_data, 
-			variables, timeout_seconds, retry_policy
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
-		)`
+	return workflowRun.ID.String(), nil
+}
 
-	if _, err := db.DB.ExecContext(ctx, query,
-		runID, agentUUID, versionUUID, triggerUUID, workflowRun.Status,
-		inputDataJSON, variablesJSON, workflowRun.TimeoutSeconds, retryPolicyJSON); err != nil {
-		return nil, fmt.Errorf("failed to create workflow run: %w", err)
+// WebhookIdempotencyKey returns the idempotency key of a webhook delivery,
+// read from the header named by the idempotencyHeader setting of the trigger
+// or from the idempotencyPath of its JSON body. The header takes precedence.
+// Deliveries without a key return "".
+func WebhookIdempotencyKey(cfg map[string]interface{}, headers http.Header, body []byte) string {
+	if name, _ := cfg["idempotencyHeader"].(string); name != "" {
+		if key := headers.Get(name); key != "" {
+			return key
+		}
 	}
 
-	// Queue the run for execution
-	queueItemID := uuid.New()
-	queueQuery := `
-		INSERT INTO workflow_queue (
-			id, run_id, queue_type, priority, available_at, max_attempts, payload
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
-		)`
+	path, _ := cfg["idempotencyPath"].(string)
+	if path == "" || len(body) == 0 {
+		return ""
+	}
 
-	payloadJSON, _ := json.Marshal(map[string]interface{}{})
-	if _, err := db.DB.ExecContext(ctx, queueQuery,
-		queueItemID, runID, "start_run", 5, time.Now(), 3, payloadJSON); err != nil {
-		return nil, fmt.Errorf("failed to queue workflow run: %w", err)
+	var current interface{}
+	if err := json.Unmarshal(body, &current); err != nil {
+		return ""
+	}
+	for _, part := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		current = object[part]
 	}
 
-	return runID.String(), nil
+	switch key := current.(type) {
+	case string:
+		return key
+	case float64:
+		return strconv.FormatFloat(key, 'f', -1, 64)
+	default:
+		return ""
+	}
 }
 
 func init() {
@@ -225,7 +225,7 @@ func (e *Engine) fireTriggerWithTransaction(triggerID, agentID, nodeID string) e
 			BackoffMultiplier: 2.0,
 			MaxDelayMS:        3600000, // 1 hour
 		},
-		TimeoutSeconds: 3600,
+		TimeoutSeconds: execution.DefaultRunTimeoutSeconds,
 	}
 
 	// Marshal JSON data
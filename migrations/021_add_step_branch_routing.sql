@@ -0,0 +1,9 @@
+-- Migration 021: Branch-aware routing for workflow steps
+-- Steps record the branch labels of their incoming edges and the branch a
+-- branching node selected, so untaken paths can be skipped
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS branch_conditions JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS selected_branch TEXT;
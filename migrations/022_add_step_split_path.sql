@@ -0,0 +1,13 @@
+-- Migration 022: Fan-out of split items into per-item steps
+-- Steps downstream of a splitter are instantiated once per item. The split
+-- path identifies the item a step instance belongs to ("2", or "2.0" for
+-- nested splits) and is empty for steps outside of a split.
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS split_path TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE workflow_steps
+DROP CONSTRAINT IF EXISTS workflow_steps_run_id_node_id_key;
+
+ALTER TABLE workflow_steps
+ADD CONSTRAINT workflow_steps_run_id_node_id_split_path_key UNIQUE (run_id, node_id, split_path);
@@ -0,0 +1,12 @@
+-- Migration 023: Error handler workflows
+-- A workflow can name another workflow that is started whenever one of its
+-- runs fails. Runs started that way reference the failed run, and their own
+-- failures do not start further error workflows.
+
+ALTER TABLE workflows
+ADD COLUMN IF NOT EXISTS error_workflow_id UUID REFERENCES workflows(id) ON DELETE SET NULL;
+
+ALTER TABLE workflow_runs
+ADD COLUMN IF NOT EXISTS error_source_run_id UUID REFERENCES workflow_runs(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_error_source ON workflow_runs(error_source_run_id);
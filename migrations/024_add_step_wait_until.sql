@@ -0,0 +1,6 @@
+-- Migration 024: Durable waits
+-- Steps that pause the workflow record until when. Queue items of the steps
+-- following them do not become available before that time.
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS wait_until TIMESTAMP WITH TIME ZONE;
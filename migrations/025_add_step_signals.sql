@@ -0,0 +1,9 @@
+-- Migration 025: Waiting for signals
+-- Steps waiting for a signal are parked with the name of the signal and the
+-- token of their resume URL. wait_until holds the time the wait times out.
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS wait_signal TEXT,
+ADD COLUMN IF NOT EXISTS signal_token TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_steps_waiting ON workflow_steps(run_id, wait_signal) WHERE status = 'waiting';
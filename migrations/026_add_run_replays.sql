@@ -0,0 +1,8 @@
+-- Migration 026: Run replays
+-- A run can be replayed from one of its nodes. The replay run references the
+-- run whose checkpointed outputs it reuses for the nodes upstream.
+
+ALTER TABLE workflow_runs
+ADD COLUMN IF NOT EXISTS replay_source_run_id UUID REFERENCES workflow_runs(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_replay_source ON workflow_runs(replay_source_run_id);
@@ -0,0 +1,7 @@
+-- Migration 027: Capability-based work routing
+-- Steps record the capabilities their node requires. Workers only claim
+-- steps of node types they advertise (or "*") when they also advertise every
+-- required capability.
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS required_capabilities TEXT[] NOT NULL DEFAULT '{}';
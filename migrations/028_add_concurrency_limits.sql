@@ -0,0 +1,11 @@
+-- Migration 028: Concurrency limits
+-- Workflows can limit how many of their runs and steps execute at the same
+-- time, triggers how many of the runs they started. NULL means unlimited.
+-- Work over a limit stays queued until running work finishes.
+
+ALTER TABLE workflows
+ADD COLUMN IF NOT EXISTS max_concurrent_runs INTEGER CHECK (max_concurrent_runs > 0),
+ADD COLUMN IF NOT EXISTS max_concurrent_steps INTEGER CHECK (max_concurrent_steps > 0);
+
+ALTER TABLE triggers
+ADD COLUMN IF NOT EXISTS max_concurrent_runs INTEGER CHECK (max_concurrent_runs > 0);
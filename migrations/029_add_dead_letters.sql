@@ -0,0 +1,24 @@
+-- Migration 029: Dead letters
+-- Work items that ran out of attempts are moved out of the queue into
+-- workflow_dead_letters, together with the last error, the worker that
+-- processed them and the input envelope of their step. They stay there until
+-- they are requeued or discarded.
+
+CREATE TABLE IF NOT EXISTS workflow_dead_letters (
+    id UUID PRIMARY KEY,
+    run_id UUID NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+    step_id UUID REFERENCES workflow_steps(id) ON DELETE CASCADE,
+    queue_type TEXT NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 5,
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 3,
+    payload JSONB,
+    last_error TEXT,
+    worker_id TEXT,
+    envelope JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    dead_lettered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_dead_letters_dead_lettered_at ON workflow_dead_letters(dead_lettered_at DESC);
+CREATE INDEX IF NOT EXISTS idx_workflow_dead_letters_run ON workflow_dead_letters(run_id);
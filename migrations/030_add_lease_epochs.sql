@@ -0,0 +1,11 @@
+-- Migration 030: Lease fencing
+-- Every claim of a queue item and every execution of a step takes a new
+-- lease epoch. Workers pass their epoch back with every write, and writes
+-- carrying a stale epoch are rejected, so a worker that lost its lease after
+-- orphan recovery cannot overwrite the result of the execution that replaced it.
+
+ALTER TABLE workflow_queue
+ADD COLUMN IF NOT EXISTS lease_epoch BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE workflow_steps
+ADD COLUMN IF NOT EXISTS lease_epoch BIGINT NOT NULL DEFAULT 0;
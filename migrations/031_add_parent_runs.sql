@@ -0,0 +1,11 @@
+-- Migration 031: Parent and child runs
+-- A workflow_call node starts the called workflow as a child run of its
+-- step. The child references the parent run and step, so that its
+-- workflow_return node can resume the waiting step on any worker, even after
+-- a restart.
+
+ALTER TABLE workflow_runs
+ADD COLUMN IF NOT EXISTS parent_run_id UUID REFERENCES workflow_runs(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS parent_step_id UUID REFERENCES workflow_steps(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_parent ON workflow_runs(parent_run_id);
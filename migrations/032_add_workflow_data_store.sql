@@ -0,0 +1,13 @@
+-- Migration 032: Shared workflow data store
+-- Data nodes store for cross-workflow communication, including the returns
+-- of workflow calls, is kept in the database so that every instance of a
+-- scaled deployment sees it. Expired entries are removed by a sweeper.
+
+CREATE TABLE IF NOT EXISTS workflow_data_store (
+    key TEXT PRIMARY KEY,
+    data JSONB,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_data_store_expires_at ON workflow_data_store(expires_at);
@@ -0,0 +1,12 @@
+-- Migration 033: Run idempotency keys
+-- Triggers and API callers may start a run with an idempotency key. A
+-- repeated request with the same key for the same workflow returns the run
+-- it started while the key is retained, so that retried webhook deliveries
+-- and double-submitted executions do not start duplicate runs.
+
+ALTER TABLE workflow_runs
+ADD COLUMN IF NOT EXISTS idempotency_key TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_idempotency_key
+ON workflow_runs(idempotency_key, created_at)
+WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,32 @@
+-- Migration 034: Workflow keys of queue items
+-- Claims consider only the first items of each workflow instead of ranking
+-- the whole queue. Queue items carry the workflow of their run, or its agent
+-- for runs without one, so that the unclaimed items of a workflow can be
+-- read from an index.
+
+ALTER TABLE workflow_queue
+ADD COLUMN IF NOT EXISTS workflow_key UUID;
+
+UPDATE workflow_queue q
+SET workflow_key = COALESCE(r.workflow_id, r.agent_id)
+FROM workflow_runs r
+WHERE r.id = q.run_id AND q.workflow_key IS NULL;
+
+-- Items are inserted by the engine and by triggers, so the key is derived
+-- from the run on insert
+CREATE OR REPLACE FUNCTION set_workflow_queue_workflow_key()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.workflow_key IS NULL THEN
+        SELECT COALESCE(workflow_id, agent_id) INTO NEW.workflow_key
+        FROM workflow_runs WHERE id = NEW.run_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER set_workflow_queue_workflow_key BEFORE INSERT ON workflow_queue FOR EACH ROW EXECUTE FUNCTION set_workflow_queue_workflow_key();
+
+CREATE INDEX IF NOT EXISTS idx_workflow_queue_unclaimed_workflow
+ON workflow_queue(workflow_key, queue_type, priority, created_at)
+WHERE claimed_by IS NULL;
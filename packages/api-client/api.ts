@@ -18,6 +18,7 @@ export * from './api/assistant-api';
 export * from './api/connections-api';
 export * from './api/credential-types-api';
 export * from './api/credentials-api';
+export * from './api/dead-letters-api';
 export * from './api/integrations-api';
 export * from './api/node-types-api';
 export * from './api/system-api';
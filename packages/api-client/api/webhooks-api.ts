@@ -21,13 +21,15 @@ import globalAxios from 'axios';
 import { DUMMY_BASE_URL, assertParamExists, setApiKeyToObject, setBasicAuthToObject, setBearerAuthToObject, setOAuthToObject, setSearchParams, serializeDataIfNeeded, toPathString, createRequestFunction, replaceWithSerializableTypeIfNeeded } from '../common';
 // @ts-ignore
 import { BASE_PATH, COLLECTION_FORMATS, type RequestArgs, BaseAPI, RequiredError, operationServerMap } from '../base';
+// @ts-ignore
+import type { WebhookRun } from '../models';
 /**
  * WebhooksApi - axios parameter creator
  */
 export const WebhooksApiAxiosParamCreator = function (configuration?: Configuration) {
     return {
         /**
-         * 
+         * Starts a run of the deployed version of the workflow of the webhook trigger, with the headers, query and body of the request as input. In sync mode the request is held until an http_response node or the end of the run produces the response, or the response timeout of the trigger passes, at most 300 seconds. Webhooks are also served at /webhooks/{token}, their path before they moved below /api. 
          * @summary Webhook endpoint
          * @param {string} token 
          * @param {any} body 
@@ -39,7 +41,7 @@ export const WebhooksApiAxiosParamCreator = function (configuration?: Configurat
             assertParamExists('handleWebhook', 'token', token)
             // verify required parameter 'body' is not null or undefined
             assertParamExists('handleWebhook', 'body', body)
-            const localVarPath = `/webhooks/{token}`
+            const localVarPath = `/api/webhooks/{token}`
                 .replace('{token}', encodeURIComponent(String(token)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
@@ -82,14 +84,14 @@ export const WebhooksApiFp = function(configuration?: Configuration) {
     const localVarAxiosParamCreator = WebhooksApiAxiosParamCreator(configuration)
     return {
         /**
-         * 
+         * Starts a run of the deployed version of the workflow of the webhook trigger, with the headers, query and body of the request as input. In sync mode the request is held until an http_response node or the end of the run produces the response, or the response timeout of the trigger passes, at most 300 seconds. Webhooks are also served at /webhooks/{token}, their path before they moved below /api. 
          * @summary Webhook endpoint
          * @param {string} token 
          * @param {any} body 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async handleWebhook(token: string, body: any, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+        async handleWebhook(token: string, body: any, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WebhookRun>> {
             const localVarAxiosArgs = await localVarAxiosParamCreator.handleWebhook(token, body, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
             const localVarOperationServerBasePath = operationServerMap['WebhooksApi.handleWebhook']?.[localVarOperationServerIndex]?.url;
@@ -105,14 +107,14 @@ export const WebhooksApiFactory = function (configuration?: Configuration, baseP
     const localVarFp = WebhooksApiFp(configuration)
     return {
         /**
-         * 
+         * Starts a run of the deployed version of the workflow of the webhook trigger, with the headers, query and body of the request as input. In sync mode the request is held until an http_response node or the end of the run produces the response, or the response timeout of the trigger passes, at most 300 seconds. Webhooks are also served at /webhooks/{token}, their path before they moved below /api. 
          * @summary Webhook endpoint
          * @param {string} token 
          * @param {any} body 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        handleWebhook(token: string, body: any, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+        handleWebhook(token: string, body: any, options?: RawAxiosRequestConfig): AxiosPromise<WebhookRun> {
             return localVarFp.handleWebhook(token, body, options).then((request) => request(axios, basePath));
         },
     };
@@ -123,7 +125,7 @@ export const WebhooksApiFactory = function (configuration?: Configuration, baseP
  */
 export class WebhooksApi extends BaseAPI {
     /**
-     * 
+     * Starts a run of the deployed version of the workflow of the webhook trigger, with the headers, query and body of the request as input. In sync mode the request is held until an http_response node or the end of the run produces the response, or the response timeout of the trigger passes, at most 300 seconds. Webhooks are also served at /webhooks/{token}, their path before they moved below /api. 
      * @summary Webhook endpoint
      * @param {string} token 
      * @param {any} body 
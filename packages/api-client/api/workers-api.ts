@@ -26,31 +26,454 @@ import type { ClaimWorkRequest } from '../models';
 // @ts-ignore
 import type { CompleteWorkRequest } from '../models';
 // @ts-ignore
+import type { InactiveRunsRequest } from '../models';
+// @ts-ignore
+import type { InactiveRunsResponse } from '../models';
+// @ts-ignore
+import type { InitializeRunResponse } from '../models';
+// @ts-ignore
 import type { RegisterWorkerRequest } from '../models';
 // @ts-ignore
+import type { StepHeartbeatRequest } from '../models';
+// @ts-ignore
+import type { StepResultRequest } from '../models';
+// @ts-ignore
+import type { StepResultResponse } from '../models';
+// @ts-ignore
+import type { StoreWorkerDataRequest } from '../models';
+// @ts-ignore
 import type { WorkItem } from '../models';
 // @ts-ignore
 import type { Worker } from '../models';
+// @ts-ignore
+import type { WorkerData } from '../models';
+// @ts-ignore
+import type { WorkerStep } from '../models';
 /**
  * WorkersApi - axios parameter creator
  */
 export const WorkersApiAxiosParamCreator = function (configuration?: Configuration) {
     return {
         /**
-         * 
-         * @summary Claim work items
+         * 
+         * @summary Claim work items
+         * @param {string} id 
+         * @param {ClaimWorkRequest} claimWorkRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        claimWork: async (id: string, claimWorkRequest: ClaimWorkRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('claimWork', 'id', id)
+            // verify required parameter 'claimWorkRequest' is not null or undefined
+            assertParamExists('claimWork', 'claimWorkRequest', claimWorkRequest)
+            const localVarPath = `/api/workers/{id}/claim-work`
+                .replace('{id}', encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(claimWorkRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Complete a work item
+         * @param {string} id 
+         * @param {string} itemId 
+         * @param {CompleteWorkRequest} completeWorkRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        completeWork: async (id: string, itemId: string, completeWorkRequest: CompleteWorkRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('completeWork', 'id', id)
+            // verify required parameter 'itemId' is not null or undefined
+            assertParamExists('completeWork', 'itemId', itemId)
+            // verify required parameter 'completeWorkRequest' is not null or undefined
+            assertParamExists('completeWork', 'completeWorkRequest', completeWorkRequest)
+            const localVarPath = `/api/workers/{id}/complete-work/{itemId}`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{itemId}', encodeURIComponent(String(itemId)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(completeWorkRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Removes the data stored under a key.
+         * @summary Delete shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        deleteWorkerData: async (id: string, key: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('deleteWorkerData', 'id', id)
+            // verify required parameter 'key' is not null or undefined
+            assertParamExists('deleteWorkerData', 'key', key)
+            const localVarPath = `/api/workers/{id}/data/{key}`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{key}', encodeURIComponent(String(key)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Marks a run whose steps have all finished as completed
+         * @summary Finalize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        finalizeWorkerRun: async (id: string, runId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('finalizeWorkerRun', 'id', id)
+            // verify required parameter 'runId' is not null or undefined
+            assertParamExists('finalizeWorkerRun', 'runId', runId)
+            const localVarPath = `/api/workers/{id}/runs/{runId}/finalize`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{runId}', encodeURIComponent(String(runId)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Returns the data nodes stored under a key for cross-workflow communication. Remote workers use it to share data with all instances of a deployment.
+         * @summary Retrieve shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getWorkerData: async (id: string, key: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('getWorkerData', 'id', id)
+            // verify required parameter 'key' is not null or undefined
+            assertParamExists('getWorkerData', 'key', key)
+            const localVarPath = `/api/workers/{id}/data/{key}`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{key}', encodeURIComponent(String(key)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Creates the steps of a claimed run and returns the steps that are ready to execute
+         * @summary Initialize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        initializeWorkerRun: async (id: string, runId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('initializeWorkerRun', 'id', id)
+            // verify required parameter 'runId' is not null or undefined
+            assertParamExists('initializeWorkerRun', 'runId', runId)
+            const localVarPath = `/api/workers/{id}/runs/{runId}/initialize`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{runId}', encodeURIComponent(String(runId)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Returns which of the given runs are no longer active, so that the worker can stop executing their steps
+         * @summary Check for inactive runs
+         * @param {string} id 
+         * @param {InactiveRunsRequest} inactiveRunsRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listInactiveWorkerRuns: async (id: string, inactiveRunsRequest: InactiveRunsRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('listInactiveWorkerRuns', 'id', id)
+            // verify required parameter 'inactiveRunsRequest' is not null or undefined
+            assertParamExists('listInactiveWorkerRuns', 'inactiveRunsRequest', inactiveRunsRequest)
+            const localVarPath = `/api/workers/{id}/inactive-runs`
+                .replace('{id}', encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(inactiveRunsRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary List all workers
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listWorkers: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/workers`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 
+         * @summary Register a new worker
+         * @param {RegisterWorkerRequest} registerWorkerRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        registerWorker: async (registerWorkerRequest: RegisterWorkerRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'registerWorkerRequest' is not null or undefined
+            assertParamExists('registerWorker', 'registerWorkerRequest', registerWorkerRequest)
+            const localVarPath = `/api/workers`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(registerWorkerRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Renews the lease of a step the worker is executing, so that it is not recovered as orphaned while it runs. Workers send it periodically for every executing step.
+         * @summary Report a step heartbeat
          * @param {string} id 
-         * @param {ClaimWorkRequest} claimWorkRequest 
+         * @param {string} stepId 
+         * @param {StepHeartbeatRequest} stepHeartbeatRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        claimWork: async (id: string, claimWorkRequest: ClaimWorkRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        reportStepHeartbeat: async (id: string, stepId: string, stepHeartbeatRequest: StepHeartbeatRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'id' is not null or undefined
-            assertParamExists('claimWork', 'id', id)
-            // verify required parameter 'claimWorkRequest' is not null or undefined
-            assertParamExists('claimWork', 'claimWorkRequest', claimWorkRequest)
-            const localVarPath = `/api/workers/{id}/claim-work`
-                .replace('{id}', encodeURIComponent(String(id)));
+            assertParamExists('reportStepHeartbeat', 'id', id)
+            // verify required parameter 'stepId' is not null or undefined
+            assertParamExists('reportStepHeartbeat', 'stepId', stepId)
+            // verify required parameter 'stepHeartbeatRequest' is not null or undefined
+            assertParamExists('reportStepHeartbeat', 'stepHeartbeatRequest', stepHeartbeatRequest)
+            const localVarPath = `/api/workers/{id}/steps/{stepId}/heartbeat`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{stepId}', encodeURIComponent(String(stepId)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -58,7 +481,7 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
@@ -75,7 +498,7 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(claimWorkRequest, localVarRequestOptions, configuration)
+            localVarRequestOptions.data = serializeDataIfNeeded(stepHeartbeatRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -83,24 +506,24 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
-         * @summary Complete a work item
+         * Stores the output envelope or error of an executed step and returns how the run continues
+         * @summary Report a step result
          * @param {string} id 
-         * @param {string} itemId 
-         * @param {CompleteWorkRequest} completeWorkRequest 
+         * @param {string} stepId 
+         * @param {StepResultRequest} stepResultRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        completeWork: async (id: string, itemId: string, completeWorkRequest: CompleteWorkRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        reportStepResult: async (id: string, stepId: string, stepResultRequest: StepResultRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'id' is not null or undefined
-            assertParamExists('completeWork', 'id', id)
-            // verify required parameter 'itemId' is not null or undefined
-            assertParamExists('completeWork', 'itemId', itemId)
-            // verify required parameter 'completeWorkRequest' is not null or undefined
-            assertParamExists('completeWork', 'completeWorkRequest', completeWorkRequest)
-            const localVarPath = `/api/workers/{id}/complete-work/{itemId}`
+            assertParamExists('reportStepResult', 'id', id)
+            // verify required parameter 'stepId' is not null or undefined
+            assertParamExists('reportStepResult', 'stepId', stepId)
+            // verify required parameter 'stepResultRequest' is not null or undefined
+            assertParamExists('reportStepResult', 'stepResultRequest', stepResultRequest)
+            const localVarPath = `/api/workers/{id}/steps/{stepId}/result`
                 .replace('{id}', encodeURIComponent(String(id)))
-                .replace('{itemId}', encodeURIComponent(String(itemId)));
+                .replace('{stepId}', encodeURIComponent(String(stepId)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -120,11 +543,12 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             await setBearerAuthToObject(localVarHeaderParameter, configuration)
 
             localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
 
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(completeWorkRequest, localVarRequestOptions, configuration)
+            localVarRequestOptions.data = serializeDataIfNeeded(stepResultRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -132,13 +556,21 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
-         * @summary List all workers
+         * Leases a step that is ready to execute to the worker and returns its node configuration and input envelope. Every call starts a new attempt with a new lease epoch.
+         * @summary Start executing a step
+         * @param {string} id 
+         * @param {string} stepId 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        listWorkers: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            const localVarPath = `/api/workers`;
+        startWorkerStep: async (id: string, stepId: string, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('startWorkerStep', 'id', id)
+            // verify required parameter 'stepId' is not null or undefined
+            assertParamExists('startWorkerStep', 'stepId', stepId)
+            const localVarPath = `/api/workers/{id}/steps/{stepId}/start`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{stepId}', encodeURIComponent(String(stepId)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -146,7 +578,7 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
@@ -169,16 +601,24 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             };
         },
         /**
-         * 
-         * @summary Register a new worker
-         * @param {RegisterWorkerRequest} registerWorkerRequest 
+         * Stores data under a key until its time to live passed, replacing the data stored under the key before.
+         * @summary Store shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {StoreWorkerDataRequest} storeWorkerDataRequest 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        registerWorker: async (registerWorkerRequest: RegisterWorkerRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
-            // verify required parameter 'registerWorkerRequest' is not null or undefined
-            assertParamExists('registerWorker', 'registerWorkerRequest', registerWorkerRequest)
-            const localVarPath = `/api/workers`;
+        storeWorkerData: async (id: string, key: string, storeWorkerDataRequest: StoreWorkerDataRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('storeWorkerData', 'id', id)
+            // verify required parameter 'key' is not null or undefined
+            assertParamExists('storeWorkerData', 'key', key)
+            // verify required parameter 'storeWorkerDataRequest' is not null or undefined
+            assertParamExists('storeWorkerData', 'storeWorkerDataRequest', storeWorkerDataRequest)
+            const localVarPath = `/api/workers/{id}/data/{key}`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{key}', encodeURIComponent(String(key)));
             // use dummy base URL string because the URL constructor only accepts absolute URLs.
             const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
             let baseOptions;
@@ -186,7 +626,7 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
                 baseOptions = configuration.baseOptions;
             }
 
-            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
             const localVarHeaderParameter = {} as any;
             const localVarQueryParameter = {} as any;
 
@@ -203,7 +643,7 @@ export const WorkersApiAxiosParamCreator = function (configuration?: Configurati
             setSearchParams(localVarUrlObj, localVarQueryParameter);
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
-            localVarRequestOptions.data = serializeDataIfNeeded(registerWorkerRequest, localVarRequestOptions, configuration)
+            localVarRequestOptions.data = serializeDataIfNeeded(storeWorkerDataRequest, localVarRequestOptions, configuration)
 
             return {
                 url: toPathString(localVarUrlObj),
@@ -330,6 +770,76 @@ export const WorkersApiFp = function(configuration?: Configuration) {
             const localVarOperationServerBasePath = operationServerMap['WorkersApi.completeWork']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
+        /**
+         * Removes the data stored under a key.
+         * @summary Delete shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async deleteWorkerData(id: string, key: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.deleteWorkerData(id, key, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.deleteWorkerData']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Marks a run whose steps have all finished as completed
+         * @summary Finalize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async finalizeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.finalizeWorkerRun(id, runId, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.finalizeWorkerRun']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Returns the data nodes stored under a key for cross-workflow communication. Remote workers use it to share data with all instances of a deployment.
+         * @summary Retrieve shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async getWorkerData(id: string, key: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WorkerData>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.getWorkerData(id, key, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.getWorkerData']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Creates the steps of a claimed run and returns the steps that are ready to execute
+         * @summary Initialize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async initializeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<InitializeRunResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.initializeWorkerRun(id, runId, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.initializeWorkerRun']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Returns which of the given runs are no longer active, so that the worker can stop executing their steps
+         * @summary Check for inactive runs
+         * @param {string} id 
+         * @param {InactiveRunsRequest} inactiveRunsRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async listInactiveWorkerRuns(id: string, inactiveRunsRequest: InactiveRunsRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<InactiveRunsResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.listInactiveWorkerRuns(id, inactiveRunsRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.listInactiveWorkerRuns']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
         /**
          * 
          * @summary List all workers
@@ -355,6 +865,65 @@ export const WorkersApiFp = function(configuration?: Configuration) {
             const localVarOperationServerBasePath = operationServerMap['WorkersApi.registerWorker']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
+        /**
+         * Renews the lease of a step the worker is executing, so that it is not recovered as orphaned while it runs. Workers send it periodically for every executing step.
+         * @summary Report a step heartbeat
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {StepHeartbeatRequest} stepHeartbeatRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async reportStepHeartbeat(id: string, stepId: string, stepHeartbeatRequest: StepHeartbeatRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.reportStepHeartbeat(id, stepId, stepHeartbeatRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.reportStepHeartbeat']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Stores the output envelope or error of an executed step and returns how the run continues
+         * @summary Report a step result
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {StepResultRequest} stepResultRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async reportStepResult(id: string, stepId: string, stepResultRequest: StepResultRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<StepResultResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.reportStepResult(id, stepId, stepResultRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.reportStepResult']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Leases a step that is ready to execute to the worker and returns its node configuration and input envelope. Every call starts a new attempt with a new lease epoch.
+         * @summary Start executing a step
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async startWorkerStep(id: string, stepId: string, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WorkerStep>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.startWorkerStep(id, stepId, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.startWorkerStep']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Stores data under a key until its time to live passed, replacing the data stored under the key before.
+         * @summary Store shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {StoreWorkerDataRequest} storeWorkerDataRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async storeWorkerData(id: string, key: string, storeWorkerDataRequest: StoreWorkerDataRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.storeWorkerData(id, key, storeWorkerDataRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkersApi.storeWorkerData']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
         /**
          * 
          * @summary Unregister a worker
@@ -413,6 +982,61 @@ export const WorkersApiFactory = function (configuration?: Configuration, basePa
         completeWork(id: string, itemId: string, completeWorkRequest: CompleteWorkRequest, options?: RawAxiosRequestConfig): AxiosPromise<void> {
             return localVarFp.completeWork(id, itemId, completeWorkRequest, options).then((request) => request(axios, basePath));
         },
+        /**
+         * Removes the data stored under a key.
+         * @summary Delete shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        deleteWorkerData(id: string, key: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.deleteWorkerData(id, key, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Marks a run whose steps have all finished as completed
+         * @summary Finalize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        finalizeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.finalizeWorkerRun(id, runId, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Returns the data nodes stored under a key for cross-workflow communication. Remote workers use it to share data with all instances of a deployment.
+         * @summary Retrieve shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        getWorkerData(id: string, key: string, options?: RawAxiosRequestConfig): AxiosPromise<WorkerData> {
+            return localVarFp.getWorkerData(id, key, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Creates the steps of a claimed run and returns the steps that are ready to execute
+         * @summary Initialize a workflow run
+         * @param {string} id 
+         * @param {string} runId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        initializeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig): AxiosPromise<InitializeRunResponse> {
+            return localVarFp.initializeWorkerRun(id, runId, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Returns which of the given runs are no longer active, so that the worker can stop executing their steps
+         * @summary Check for inactive runs
+         * @param {string} id 
+         * @param {InactiveRunsRequest} inactiveRunsRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        listInactiveWorkerRuns(id: string, inactiveRunsRequest: InactiveRunsRequest, options?: RawAxiosRequestConfig): AxiosPromise<InactiveRunsResponse> {
+            return localVarFp.listInactiveWorkerRuns(id, inactiveRunsRequest, options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary List all workers
@@ -432,6 +1056,53 @@ export const WorkersApiFactory = function (configuration?: Configuration, basePa
         registerWorker(registerWorkerRequest: RegisterWorkerRequest, options?: RawAxiosRequestConfig): AxiosPromise<Worker> {
             return localVarFp.registerWorker(registerWorkerRequest, options).then((request) => request(axios, basePath));
         },
+        /**
+         * Renews the lease of a step the worker is executing, so that it is not recovered as orphaned while it runs. Workers send it periodically for every executing step.
+         * @summary Report a step heartbeat
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {StepHeartbeatRequest} stepHeartbeatRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        reportStepHeartbeat(id: string, stepId: string, stepHeartbeatRequest: StepHeartbeatRequest, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.reportStepHeartbeat(id, stepId, stepHeartbeatRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Stores the output envelope or error of an executed step and returns how the run continues
+         * @summary Report a step result
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {StepResultRequest} stepResultRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        reportStepResult(id: string, stepId: string, stepResultRequest: StepResultRequest, options?: RawAxiosRequestConfig): AxiosPromise<StepResultResponse> {
+            return localVarFp.reportStepResult(id, stepId, stepResultRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Leases a step that is ready to execute to the worker and returns its node configuration and input envelope. Every call starts a new attempt with a new lease epoch.
+         * @summary Start executing a step
+         * @param {string} id 
+         * @param {string} stepId 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        startWorkerStep(id: string, stepId: string, options?: RawAxiosRequestConfig): AxiosPromise<WorkerStep> {
+            return localVarFp.startWorkerStep(id, stepId, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Stores data under a key until its time to live passed, replacing the data stored under the key before.
+         * @summary Store shared data
+         * @param {string} id 
+         * @param {string} key 
+         * @param {StoreWorkerDataRequest} storeWorkerDataRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        storeWorkerData(id: string, key: string, storeWorkerDataRequest: StoreWorkerDataRequest, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.storeWorkerData(id, key, storeWorkerDataRequest, options).then((request) => request(axios, basePath));
+        },
         /**
          * 
          * @summary Unregister a worker
@@ -484,6 +1155,66 @@ export class WorkersApi extends BaseAPI {
         return WorkersApiFp(this.configuration).completeWork(id, itemId, completeWorkRequest, options).then((request) => request(this.axios, this.basePath));
     }
 
+    /**
+     * Removes the data stored under a key.
+     * @summary Delete shared data
+     * @param {string} id 
+     * @param {string} key 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public deleteWorkerData(id: string, key: string, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).deleteWorkerData(id, key, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Marks a run whose steps have all finished as completed
+     * @summary Finalize a workflow run
+     * @param {string} id 
+     * @param {string} runId 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public finalizeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).finalizeWorkerRun(id, runId, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Returns the data nodes stored under a key for cross-workflow communication. Remote workers use it to share data with all instances of a deployment.
+     * @summary Retrieve shared data
+     * @param {string} id 
+     * @param {string} key 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public getWorkerData(id: string, key: string, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).getWorkerData(id, key, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Creates the steps of a claimed run and returns the steps that are ready to execute
+     * @summary Initialize a workflow run
+     * @param {string} id 
+     * @param {string} runId 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public initializeWorkerRun(id: string, runId: string, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).initializeWorkerRun(id, runId, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Returns which of the given runs are no longer active, so that the worker can stop executing their steps
+     * @summary Check for inactive runs
+     * @param {string} id 
+     * @param {InactiveRunsRequest} inactiveRunsRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public listInactiveWorkerRuns(id: string, inactiveRunsRequest: InactiveRunsRequest, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).listInactiveWorkerRuns(id, inactiveRunsRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary List all workers
@@ -505,6 +1236,57 @@ export class WorkersApi extends BaseAPI {
         return WorkersApiFp(this.configuration).registerWorker(registerWorkerRequest, options).then((request) => request(this.axios, this.basePath));
     }
 
+    /**
+     * Renews the lease of a step the worker is executing, so that it is not recovered as orphaned while it runs. Workers send it periodically for every executing step.
+     * @summary Report a step heartbeat
+     * @param {string} id 
+     * @param {string} stepId 
+     * @param {StepHeartbeatRequest} stepHeartbeatRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public reportStepHeartbeat(id: string, stepId: string, stepHeartbeatRequest: StepHeartbeatRequest, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).reportStepHeartbeat(id, stepId, stepHeartbeatRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Stores the output envelope or error of an executed step and returns how the run continues
+     * @summary Report a step result
+     * @param {string} id 
+     * @param {string} stepId 
+     * @param {StepResultRequest} stepResultRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public reportStepResult(id: string, stepId: string, stepResultRequest: StepResultRequest, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).reportStepResult(id, stepId, stepResultRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Leases a step that is ready to execute to the worker and returns its node configuration and input envelope. Every call starts a new attempt with a new lease epoch.
+     * @summary Start executing a step
+     * @param {string} id 
+     * @param {string} stepId 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public startWorkerStep(id: string, stepId: string, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).startWorkerStep(id, stepId, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Stores data under a key until its time to live passed, replacing the data stored under the key before.
+     * @summary Store shared data
+     * @param {string} id 
+     * @param {string} key 
+     * @param {StoreWorkerDataRequest} storeWorkerDataRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public storeWorkerData(id: string, key: string, storeWorkerDataRequest: StoreWorkerDataRequest, options?: RawAxiosRequestConfig) {
+        return WorkersApiFp(this.configuration).storeWorkerData(id, key, storeWorkerDataRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
     /**
      * 
      * @summary Unregister a worker
@@ -22,6 +22,10 @@ import { DUMMY_BASE_URL, assertParamExists, setApiKeyToObject, setBasicAuthToObj
 // @ts-ignore
 import { BASE_PATH, COLLECTION_FORMATS, type RequestArgs, BaseAPI, RequiredError, operationServerMap } from '../base';
 // @ts-ignore
+import type { ReplayWorkflowRunRequest } from '../models';
+// @ts-ignore
+import type { SignalResponse } from '../models';
+// @ts-ignore
 import type { WorkflowRun } from '../models';
 // @ts-ignore
 import type { WorkflowRunList } from '../models';
@@ -168,6 +172,107 @@ export const WorkflowRunsApiAxiosParamCreator = function (configuration?: Config
             let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
             localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
 
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Starts a new run that executes the node and everything downstream of it again. The other nodes reuse the outputs checkpointed by the original run.
+         * @summary Replay a workflow run from a node
+         * @param {string} id 
+         * @param {ReplayWorkflowRunRequest} replayWorkflowRunRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        replayWorkflowRun: async (id: string, replayWorkflowRunRequest: ReplayWorkflowRunRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('replayWorkflowRun', 'id', id)
+            // verify required parameter 'replayWorkflowRunRequest' is not null or undefined
+            assertParamExists('replayWorkflowRun', 'replayWorkflowRunRequest', replayWorkflowRunRequest)
+            const localVarPath = `/api/workflow-runs/{id}/replay`
+                .replace('{id}', encodeURIComponent(String(id)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(replayWorkflowRunRequest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * Resumes the step waiting for the signal with the request body as payload. The token is part of the resume URL recorded on the waiting step.
+         * @summary Send a signal to a workflow run
+         * @param {string} id 
+         * @param {string} name 
+         * @param {string} token 
+         * @param {{ [key: string]: any; }} [requestBody] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        signalWorkflowRun: async (id: string, name: string, token: string, requestBody?: { [key: string]: any; }, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'id' is not null or undefined
+            assertParamExists('signalWorkflowRun', 'id', id)
+            // verify required parameter 'name' is not null or undefined
+            assertParamExists('signalWorkflowRun', 'name', name)
+            // verify required parameter 'token' is not null or undefined
+            assertParamExists('signalWorkflowRun', 'token', token)
+            const localVarPath = `/api/workflow-runs/{id}/signals/{name}`
+                .replace('{id}', encodeURIComponent(String(id)))
+                .replace('{name}', encodeURIComponent(String(name)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            // authentication ApiKeyAuth required
+            await setApiKeyToObject(localVarHeaderParameter, "X-API-Key", configuration)
+
+            // authentication BearerAuth required
+            // http bearer authentication required
+            await setBearerAuthToObject(localVarHeaderParameter, configuration)
+
+            if (token !== undefined) {
+                localVarQueryParameter['token'] = token;
+            }
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(requestBody, localVarRequestOptions, configuration)
+
             return {
                 url: toPathString(localVarUrlObj),
                 options: localVarRequestOptions,
@@ -224,6 +329,36 @@ export const WorkflowRunsApiFp = function(configuration?: Configuration) {
             const localVarOperationServerBasePath = operationServerMap['WorkflowRunsApi.listWorkflowRuns']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
+        /**
+         * Starts a new run that executes the node and everything downstream of it again. The other nodes reuse the outputs checkpointed by the original run.
+         * @summary Replay a workflow run from a node
+         * @param {string} id 
+         * @param {ReplayWorkflowRunRequest} replayWorkflowRunRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async replayWorkflowRun(id: string, replayWorkflowRunRequest: ReplayWorkflowRunRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WorkflowRun>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.replayWorkflowRun(id, replayWorkflowRunRequest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkflowRunsApi.replayWorkflowRun']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * Resumes the step waiting for the signal with the request body as payload. The token is part of the resume URL recorded on the waiting step.
+         * @summary Send a signal to a workflow run
+         * @param {string} id 
+         * @param {string} name 
+         * @param {string} token 
+         * @param {{ [key: string]: any; }} [requestBody] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async signalWorkflowRun(id: string, name: string, token: string, requestBody?: { [key: string]: any; }, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<SignalResponse>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.signalWorkflowRun(id, name, token, requestBody, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['WorkflowRunsApi.signalWorkflowRun']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
     }
 };
 
@@ -266,6 +401,30 @@ export const WorkflowRunsApiFactory = function (configuration?: Configuration, b
         listWorkflowRuns(workflowId?: string, status?: WorkflowRunStatus, page?: number, limit?: number, options?: RawAxiosRequestConfig): AxiosPromise<WorkflowRunList> {
             return localVarFp.listWorkflowRuns(workflowId, status, page, limit, options).then((request) => request(axios, basePath));
         },
+        /**
+         * Starts a new run that executes the node and everything downstream of it again. The other nodes reuse the outputs checkpointed by the original run.
+         * @summary Replay a workflow run from a node
+         * @param {string} id 
+         * @param {ReplayWorkflowRunRequest} replayWorkflowRunRequest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        replayWorkflowRun(id: string, replayWorkflowRunRequest: ReplayWorkflowRunRequest, options?: RawAxiosRequestConfig): AxiosPromise<WorkflowRun> {
+            return localVarFp.replayWorkflowRun(id, replayWorkflowRunRequest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * Resumes the step waiting for the signal with the request body as payload. The token is part of the resume URL recorded on the waiting step.
+         * @summary Send a signal to a workflow run
+         * @param {string} id 
+         * @param {string} name 
+         * @param {string} token 
+         * @param {{ [key: string]: any; }} [requestBody] 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        signalWorkflowRun(id: string, name: string, token: string, requestBody?: { [key: string]: any; }, options?: RawAxiosRequestConfig): AxiosPromise<SignalResponse> {
+            return localVarFp.signalWorkflowRun(id, name, token, requestBody, options).then((request) => request(axios, basePath));
+        },
     };
 };
 
@@ -308,5 +467,31 @@ export class WorkflowRunsApi extends BaseAPI {
     public listWorkflowRuns(workflowId?: string, status?: WorkflowRunStatus, page?: number, limit?: number, options?: RawAxiosRequestConfig) {
         return WorkflowRunsApiFp(this.configuration).listWorkflowRuns(workflowId, status, page, limit, options).then((request) => request(this.axios, this.basePath));
     }
+
+    /**
+     * Starts a new run that executes the node and everything downstream of it again. The other nodes reuse the outputs checkpointed by the original run.
+     * @summary Replay a workflow run from a node
+     * @param {string} id 
+     * @param {ReplayWorkflowRunRequest} replayWorkflowRunRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public replayWorkflowRun(id: string, replayWorkflowRunRequest: ReplayWorkflowRunRequest, options?: RawAxiosRequestConfig) {
+        return WorkflowRunsApiFp(this.configuration).replayWorkflowRun(id, replayWorkflowRunRequest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * Resumes the step waiting for the signal with the request body as payload. The token is part of the resume URL recorded on the waiting step.
+     * @summary Send a signal to a workflow run
+     * @param {string} id 
+     * @param {string} name 
+     * @param {string} token 
+     * @param {{ [key: string]: any; }} [requestBody] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public signalWorkflowRun(id: string, name: string, token: string, requestBody?: { [key: string]: any; }, options?: RawAxiosRequestConfig) {
+        return WorkflowRunsApiFp(this.configuration).signalWorkflowRun(id, name, token, requestBody, options).then((request) => request(this.axios, this.basePath));
+    }
 }
 
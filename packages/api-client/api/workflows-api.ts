@@ -463,11 +463,14 @@ export const WorkflowsApiAxiosParamCreator = function (configuration?: Configura
          * 
          * @summary Execute a workflow
          * @param {string} id 
+         * @param {string} [idempotencyKey] Repeated requests with the same key return the run the first request started, while the key is retained
+         * @param {boolean} [wait] Wait for the run to finish and return its output as the result
+         * @param {number} [timeout] Seconds to wait for the run to finish before returning its current status
          * @param {ExecuteWorkflowRequest} [executeWorkflowRequest] 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        executeWorkflow: async (id: string, executeWorkflowRequest?: ExecuteWorkflowRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+        executeWorkflow: async (id: string, idempotencyKey?: string, wait?: boolean, timeout?: number, executeWorkflowRequest?: ExecuteWorkflowRequest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
             // verify required parameter 'id' is not null or undefined
             assertParamExists('executeWorkflow', 'id', id)
             const localVarPath = `/api/workflows/{id}/execute`
@@ -490,6 +493,18 @@ export const WorkflowsApiAxiosParamCreator = function (configuration?: Configura
             // http bearer authentication required
             await setBearerAuthToObject(localVarHeaderParameter, configuration)
 
+            if (wait !== undefined) {
+                localVarQueryParameter['wait'] = wait;
+            }
+
+            if (timeout !== undefined) {
+                localVarQueryParameter['timeout'] = timeout;
+            }
+
+            if (idempotencyKey != null) {
+                localVarHeaderParameter['Idempotency-Key'] = String(idempotencyKey);
+            }
+
             localVarHeaderParameter['Content-Type'] = 'application/json';
             localVarHeaderParameter['Accept'] = 'application/json';
 
@@ -1179,12 +1194,15 @@ export const WorkflowsApiFp = function(configuration?: Configuration) {
          * 
          * @summary Execute a workflow
          * @param {string} id 
+         * @param {string} [idempotencyKey] Repeated requests with the same key return the run the first request started, while the key is retained
+         * @param {boolean} [wait] Wait for the run to finish and return its output as the result
+         * @param {number} [timeout] Seconds to wait for the run to finish before returning its current status
          * @param {ExecuteWorkflowRequest} [executeWorkflowRequest] 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async executeWorkflow(id: string, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WorkflowExecution>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.executeWorkflow(id, executeWorkflowRequest, options);
+        async executeWorkflow(id: string, idempotencyKey?: string, wait?: boolean, timeout?: number, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<WorkflowExecution>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.executeWorkflow(id, idempotencyKey, wait, timeout, executeWorkflowRequest, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
             const localVarOperationServerBasePath = operationServerMap['WorkflowsApi.executeWorkflow']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
@@ -1464,12 +1482,15 @@ export const WorkflowsApiFactory = function (configuration?: Configuration, base
          * 
          * @summary Execute a workflow
          * @param {string} id 
+         * @param {string} [idempotencyKey] Repeated requests with the same key return the run the first request started, while the key is retained
+         * @param {boolean} [wait] Wait for the run to finish and return its output as the result
+         * @param {number} [timeout] Seconds to wait for the run to finish before returning its current status
          * @param {ExecuteWorkflowRequest} [executeWorkflowRequest] 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        executeWorkflow(id: string, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig): AxiosPromise<WorkflowExecution> {
-            return localVarFp.executeWorkflow(id, executeWorkflowRequest, options).then((request) => request(axios, basePath));
+        executeWorkflow(id: string, idempotencyKey?: string, wait?: boolean, timeout?: number, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig): AxiosPromise<WorkflowExecution> {
+            return localVarFp.executeWorkflow(id, idempotencyKey, wait, timeout, executeWorkflowRequest, options).then((request) => request(axios, basePath));
         },
         /**
          * 
@@ -1717,12 +1738,15 @@ export class WorkflowsApi extends BaseAPI {
      * 
      * @summary Execute a workflow
      * @param {string} id 
+     * @param {string} [idempotencyKey] Repeated requests with the same key return the run the first request started, while the key is retained
+     * @param {boolean} [wait] Wait for the run to finish and return its output as the result
+     * @param {number} [timeout] Seconds to wait for the run to finish before returning its current status
      * @param {ExecuteWorkflowRequest} [executeWorkflowRequest] 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public executeWorkflow(id: string, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig) {
-        return WorkflowsApiFp(this.configuration).executeWorkflow(id, executeWorkflowRequest, options).then((request) => request(this.axios, this.basePath));
+    public executeWorkflow(id: string, idempotencyKey?: string, wait?: boolean, timeout?: number, executeWorkflowRequest?: ExecuteWorkflowRequest, options?: RawAxiosRequestConfig) {
+        return WorkflowsApiFp(this.configuration).executeWorkflow(id, idempotencyKey, wait, timeout, executeWorkflowRequest, options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
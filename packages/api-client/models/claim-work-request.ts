@@ -19,5 +19,9 @@ export interface ClaimWorkRequest {
      * Maximum number of work items to claim
      */
     'maxItems'?: number;
+    /**
+     * Seconds to wait for work when none is available. The request returns as soon as work was claimed, so workers can long-poll instead of polling.
+     */
+    'waitSeconds'?: number;
 }
 
@@ -15,6 +15,10 @@
 
 
 export interface CompleteWorkRequest {
+    /**
+     * Lease epoch of the claim on the work item. Workers whose claim was recovered and handed to another worker no longer hold the current lease.
+     */
+    'lease_epoch': number;
     /**
      * The result of the completed work item
      */
@@ -23,5 +27,17 @@ export interface CompleteWorkRequest {
      * Error message if the work item failed
      */
     'error'?: string;
+    /**
+     * Steps to queue after this work item
+     */
+    'next_steps'?: Array<string>;
+    /**
+     * Whether a failed work item should be retried
+     */
+    'should_retry'?: boolean;
+    /**
+     * Delay before the retry is attempted
+     */
+    'retry_delay_ms'?: number;
 }
 
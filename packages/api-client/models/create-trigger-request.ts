@@ -26,6 +26,10 @@ export interface CreateTriggerRequest {
      */
     'config'?: { [key: string]: any; };
     'enabled'?: boolean;
+    /**
+     * Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent or 0.
+     */
+    'max_concurrent_runs'?: number;
 }
 
 
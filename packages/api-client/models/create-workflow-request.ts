@@ -21,5 +21,17 @@ export interface CreateWorkflowRequest {
     'name': string;
     'description'?: string;
     'definition'?: WorkflowDefinition;
+    /**
+     * Workflow started with the details of the failure whenever a run of this workflow fails
+     */
+    'error_workflow_id'?: string;
+    /**
+     * Maximum number of runs of this workflow executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent or 0.
+     */
+    'max_concurrent_runs'?: number;
+    /**
+     * Maximum number of steps of this workflow executing at the same time across all of its runs. Unlimited when absent or 0.
+     */
+    'max_concurrent_steps'?: number;
 }
 
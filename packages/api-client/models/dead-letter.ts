@@ -0,0 +1,50 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MEL Agent API
+ * AI Agents SaaS platform API with visual workflow builder
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+/**
+ * A work item that ran out of attempts
+ */
+export interface DeadLetter {
+    /**
+     * ID of the work item
+     */
+    'id': string;
+    'run_id': string;
+    'step_id'?: string;
+    'queue_type': string;
+    'priority'?: number;
+    'attempt_count': number;
+    'max_attempts': number;
+    /**
+     * Generic payload object containing arbitrary data
+     */
+    'payload'?: { [key: string]: any; };
+    'last_error'?: string;
+    /**
+     * Worker that processed the item last
+     */
+    'worker_id'?: string;
+    /**
+     * Serialized data envelope flowing between workflow nodes
+     */
+    'envelope'?: { [key: string]: any; };
+    /**
+     * When the work item was queued
+     */
+    'created_at': string;
+    'dead_lettered_at': string;
+}
+
@@ -20,10 +20,15 @@ export * from './credential-status';
 export * from './credential-test-result';
 export * from './credential-type';
 export * from './credential-type-schema';
+export * from './dead-letter';
+export * from './dead-letter-list';
 export * from './execute-workflow-request';
 export * from './extension';
 export * from './function-call';
 export * from './get-health200-response';
+export * from './inactive-runs-request';
+export * from './inactive-runs-response';
+export * from './initialize-run-response';
 export * from './integration';
 export * from './integration-status';
 export * from './model-error';
@@ -37,6 +42,12 @@ export * from './node-test-result';
 export * from './node-type';
 export * from './param-spec';
 export * from './register-worker-request';
+export * from './replay-workflow-run-request';
+export * from './signal-response';
+export * from './step-heartbeat-request';
+export * from './step-result-request';
+export * from './step-result-response';
+export * from './store-worker-data-request';
 export * from './test-credentials-request';
 export * from './trigger';
 export * from './trigger-type';
@@ -46,9 +57,12 @@ export * from './update-workflow-draft-request';
 export * from './update-workflow-node-request';
 export * from './update-workflow-request';
 export * from './validator-spec';
+export * from './webhook-run';
 export * from './work-item';
 export * from './worker';
+export * from './worker-data';
 export * from './worker-status';
+export * from './worker-step';
 export * from './workflow';
 export * from './workflow-definition';
 export * from './workflow-draft';
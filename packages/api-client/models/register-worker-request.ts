@@ -18,5 +18,9 @@ export interface RegisterWorkerRequest {
     'id': string;
     'name'?: string;
     'concurrency'?: number;
+    /**
+     * Node types and labels the worker can execute. \"*\" matches every node type but no labels.
+     */
+    'capabilities'?: Array<string>;
 }
 
@@ -0,0 +1,27 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MEL Agent API
+ * AI Agents SaaS platform API with visual workflow builder
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+export interface ReplayWorkflowRunRequest {
+    /**
+     * Node to replay the run from
+     */
+    'node_id': string;
+    /**
+     * Input envelope of the node. Defaults to the input the node received in the original run.
+     */
+    'input_envelope'?: { [key: string]: any; };
+}
+
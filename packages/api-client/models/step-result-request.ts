@@ -0,0 +1,35 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MEL Agent API
+ * AI Agents SaaS platform API with visual workflow builder
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+export interface StepResultRequest {
+    /**
+     * Lease epoch of the execution, as returned when the step was fetched
+     */
+    'lease_epoch': number;
+    /**
+     * Serialized data envelope flowing between workflow nodes
+     */
+    'output_envelope'?: { [key: string]: any; };
+    /**
+     * Error message if the node execution failed
+     */
+    'error'?: string;
+    /**
+     * Code classifying the error, such as validation, timeout or upstream. Determines whether the step is retried.
+     */
+    'error_code'?: string;
+}
+
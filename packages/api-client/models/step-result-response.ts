@@ -0,0 +1,30 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MEL Agent API
+ * AI Agents SaaS platform API with visual workflow builder
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+export interface StepResultResponse {
+    'success': boolean;
+    'error'?: string;
+    /**
+     * Steps that became ready to execute
+     */
+    'next_steps'?: Array<string>;
+    'should_retry'?: boolean;
+    /**
+     * Delay before the step is retried
+     */
+    'retry_delay_ms'?: number;
+}
+
@@ -27,6 +27,10 @@ export interface Trigger {
      */
     'config'?: { [key: string]: any; };
     'enabled'?: boolean;
+    /**
+     * Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. Unlimited when absent.
+     */
+    'max_concurrent_runs'?: number;
     'created_at'?: string;
     'updated_at'?: string;
 }
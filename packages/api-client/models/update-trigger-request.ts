@@ -21,5 +21,9 @@ export interface UpdateTriggerRequest {
      */
     'config'?: { [key: string]: any; };
     'enabled'?: boolean;
+    /**
+     * Maximum number of runs started by this trigger executing at the same time. Further runs stay pending until a run finishes. 0 removes the limit.
+     */
+    'max_concurrent_runs'?: number;
 }
 
@@ -21,5 +21,17 @@ export interface UpdateWorkflowRequest {
     'name'?: string;
     'description'?: string;
     'definition'?: WorkflowDefinition;
+    /**
+     * Workflow started with the details of the failure whenever a run of this workflow fails. The nil UUID removes the error workflow.
+     */
+    'error_workflow_id'?: string;
+    /**
+     * Maximum number of runs of this workflow executing at the same time. Further runs stay pending until a run finishes. 0 removes the limit.
+     */
+    'max_concurrent_runs'?: number;
+    /**
+     * Maximum number of steps of this workflow executing at the same time across all of its runs. 0 removes the limit.
+     */
+    'max_concurrent_steps'?: number;
 }
 
@@ -22,5 +22,9 @@ export interface WorkItem {
      */
     'payload'?: { [key: string]: any; };
     'created_at'?: string;
+    /**
+     * Lease of the claim, to pass back when completing the work item
+     */
+    'lease_epoch'?: number;
 }
 
@@ -0,0 +1,37 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MEL Agent API
+ * AI Agents SaaS platform API with visual workflow builder
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+
+export interface WorkerStep {
+    'id': string;
+    'run_id': string;
+    'node_id': string;
+    'node_type': string;
+    /**
+     * Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
+     */
+    'node_config'?: { [key: string]: any; };
+    /**
+     * Serialized data envelope flowing between workflow nodes
+     */
+    'input_envelope'?: { [key: string]: any; };
+    'attempt_count'?: number;
+    'max_attempts'?: number;
+    /**
+     * Lease of this execution of the step, to pass back with its result
+     */
+    'lease_epoch': number;
+}
+
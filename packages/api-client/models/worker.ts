@@ -23,6 +23,7 @@ export interface Worker {
     'status'?: WorkerStatus;
     'last_heartbeat'?: string;
     'concurrency'?: number;
+    'capabilities'?: Array<string>;
     'registered_at'?: string;
 }
 
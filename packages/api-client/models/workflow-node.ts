@@ -19,7 +19,7 @@ export interface WorkflowNode {
     'name': string;
     'type': string;
     /**
-     * Node configuration containing node-specific parameters and settings
+     * Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
      */
     'config': { [key: string]: any; };
 }
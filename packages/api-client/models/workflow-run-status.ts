@@ -21,6 +21,7 @@
 export const WorkflowRunStatus = {
     Pending: 'pending',
     Running: 'running',
+    Paused: 'paused',
     Completed: 'completed',
     Failed: 'failed',
 } as const;
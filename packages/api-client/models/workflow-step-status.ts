@@ -24,6 +24,7 @@ export const WorkflowStepStatus = {
     Completed: 'completed',
     Failed: 'failed',
     Skipped: 'skipped',
+    Waiting: 'waiting',
 } as const;
 
 export type WorkflowStepStatus = typeof WorkflowStepStatus[keyof typeof WorkflowStepStatus];
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+var (
+	// ErrDataNotFound is returned for keys no data is stored under
+	ErrDataNotFound = errors.New("data not found")
+	// ErrDataExpired is returned for keys whose data expired
+	ErrDataExpired = errors.New("data expired")
+)
+
+// DataStore keeps the data nodes share across workflows until it expires.
+// Stores shared by several instances, like PostgresDataStore, make the data
+// visible to all of them.
+type DataStore interface {
+	// Store keeps data under a key until it expires, replacing the data
+	// stored under the key before
+	Store(ctx context.Context, key string, data interface{}, expiresAt time.Time) error
+	// Retrieve returns the data stored under a key, or ErrDataNotFound or
+	// ErrDataExpired
+	Retrieve(ctx context.Context, key string) (interface{}, error)
+	// Delete removes the data stored under a key
+	Delete(ctx context.Context, key string) error
+	// Sweep removes the expired data and returns the number of removed keys
+	Sweep(ctx context.Context) (int64, error)
+}
+
+// SweepDataStore removes the expired data of a store at every interval until
+// the context ends
+func SweepDataStore(ctx context.Context, store DataStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := store.Sweep(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to sweep expired data: %v", err)
+			}
+		}
+	}
+}
+
+// PostgresDataStore is a DataStore kept in the workflow_data_store table and
+// shared by all instances using the database. Data is stored as JSON, so it
+// is retrieved the way encoding/json decodes into an interface{}.
+type PostgresDataStore struct {
+	db *sql.DB
+}
+
+// NewPostgresDataStore creates a data store kept in the workflow_data_store table
+func NewPostgresDataStore(db *sql.DB) *PostgresDataStore {
+	return &PostgresDataStore{db: db}
+}
+
+// Store keeps data under a key until it expires
+func (s *PostgresDataStore) Store(ctx context.Context, key string, data interface{}, expiresAt time.Time) error {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	query := `
+		INSERT INTO workflow_data_store (key, data, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET data = EXCLUDED.data, expires_at = EXCLUDED.expires_at, created_at = NOW()`
+	if _, err := s.db.ExecContext(ctx, query, key, dataJSON, expiresAt); err != nil {
+		return fmt.Errorf("failed to store data: %w", err)
+	}
+	return nil
+}
+
+// Retrieve returns the data stored under a key
+func (s *PostgresDataStore) Retrieve(ctx context.Context, key string) (interface{}, error) {
+	var dataJSON []byte
+	var expired bool
+	err := s.db.QueryRowContext(ctx,
+		`SELECT data, expires_at <= NOW() FROM workflow_data_store WHERE key = $1`, key).Scan(&dataJSON, &expired)
+	if err == sql.ErrNoRows {
+		return nil, ErrDataNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data: %w", err)
+	}
+	if expired {
+		return nil, ErrDataExpired
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse data: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the data stored under a key
+func (s *PostgresDataStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM workflow_data_store WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("failed to delete data: %w", err)
+	}
+	return nil
+}
+
+// Sweep removes the expired data
+func (s *PostgresDataStore) Sweep(ctx context.Context) (int64, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM workflow_data_store WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sweep expired data: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// assert that PostgresDataStore implements the interface
+var _ DataStore = (*PostgresDataStore)(nil)
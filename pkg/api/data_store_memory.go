@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryDataStore is a DataStore kept in the memory of the process. Its data
+// is only visible to the instance that stored it.
+type MemoryDataStore struct {
+	mu      sync.Mutex
+	entries map[string]dataStoreEntry
+}
+
+// dataStoreEntry represents a stored data entry with TTL
+type dataStoreEntry struct {
+	Data      interface{}
+	ExpiresAt time.Time
+}
+
+// NewMemoryDataStore creates an empty in-memory data store
+func NewMemoryDataStore() *MemoryDataStore {
+	return &MemoryDataStore{entries: make(map[string]dataStoreEntry)}
+}
+
+// Store keeps data under a key until it expires
+func (s *MemoryDataStore) Store(ctx context.Context, key string, data interface{}, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = dataStoreEntry{Data: data, ExpiresAt: expiresAt}
+	return nil
+}
+
+// Retrieve returns the data stored under a key. Expired data is removed.
+func (s *MemoryDataStore) Retrieve(ctx context.Context, key string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[key]
+	if !exists {
+		return nil, ErrDataNotFound
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		delete(s.entries, key)
+		return nil, ErrDataExpired
+	}
+	return entry.Data, nil
+}
+
+// Delete removes the data stored under a key
+func (s *MemoryDataStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// Sweep removes the expired data
+func (s *MemoryDataStore) Sweep(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var removed int64
+	for key, entry := range s.entries {
+		if now.After(entry.ExpiresAt) {
+			delete(s.entries, key)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// assert that MemoryDataStore implements the interface
+var _ DataStore = (*MemoryDataStore)(nil)
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryDataStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryDataStore()
+
+	if err := store.Store(ctx, "live", "value", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Failed to store data: %v", err)
+	}
+	if err := store.Store(ctx, "expired", "value", time.Now().Add(-time.Second)); err != nil {
+		t.Fatalf("Failed to store data: %v", err)
+	}
+
+	data, err := store.Retrieve(ctx, "live")
+	if err != nil || data != "value" {
+		t.Errorf("Expected stored value, got %v (%v)", data, err)
+	}
+	if _, err := store.Retrieve(ctx, "missing"); err != ErrDataNotFound {
+		t.Errorf("Expected ErrDataNotFound, got %v", err)
+	}
+
+	// The sweeper removes expired data without anyone reading it
+	removed, err := store.Sweep(ctx)
+	if err != nil {
+		t.Fatalf("Failed to sweep: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Expected 1 expired entry to be removed, got %d", removed)
+	}
+	if _, err := store.Retrieve(ctx, "expired"); err != ErrDataNotFound {
+		t.Errorf("Expected swept data to be gone, got %v", err)
+	}
+	if _, err := store.Retrieve(ctx, "live"); err != nil {
+		t.Errorf("Expected live data to survive the sweep, got %v", err)
+	}
+}
@@ -76,10 +76,8 @@ func (e *Envelope[T]) AddError(nodeID, message string, err error) {
 		Message: message,
 	}
 
-	if nodeErr, ok := err.(*NodeError); ok {
-		execError.Code = nodeErr.Code
-		// Use the provided message, not the NodeError message
-	}
+	// Use the provided message, but keep the code classifying the error
+	execError.Code = ErrorCode(err)
 
 	e.Errors = append(e.Errors, execError)
 }
@@ -169,6 +167,72 @@ func (e *Envelope[T]) Clone() *Envelope[T] {
 	return clone
 }
 
+// MetaBranch is the metadata key under which branching nodes record the
+// branch they selected. Only edges labelled with that branch are followed.
+const MetaBranch = "branch"
+
+// ErrorBranch is the output label of error edges. A node whose error mode
+// routes failures to its error output follows only edges with this label.
+const ErrorBranch = "error"
+
+// DefaultBranch is the output label followed by branching nodes whose
+// selected branch labels none of their edges
+const DefaultBranch = "default"
+
+// MetaWaitUntil is the metadata key under which nodes that pause the
+// workflow record until when, as an RFC 3339 timestamp. The nodes following
+// them are not executed before that time.
+const MetaWaitUntil = "wait_until"
+
+// MetaWaitSignal is the metadata key under which nodes that wait for a signal
+// record its name. The step is parked until the signal is sent, or until the
+// time recorded under MetaWaitUntil when the wait times out.
+const MetaWaitSignal = "wait_signal"
+
+// MetaResumeURL is the metadata key under which the engine records the path
+// that sends the signal a parked step waits for
+const MetaResumeURL = "resume_url"
+
+// Output labels of nodes waiting for a signal: SignalBranch is followed with
+// the payload of the signal, TimeoutBranch when none arrived in time.
+const (
+	SignalBranch  = "signal"
+	TimeoutBranch = "timeout"
+)
+
+// MetaCallWorkflow is the metadata key under which nodes that call another
+// workflow record its ID. The execution engine starts the deployed version of
+// the workflow as a child run of the step, with the data of the envelope as
+// its input, and records the ID of the child run under MetaChildRunID. To wait
+// for the child to return, the node also waits for WorkflowReturnSignal.
+const MetaCallWorkflow = "call_workflow"
+
+// MetaChildRunID is the metadata key under which the engine records the ID of
+// the child run a workflow call started
+const MetaChildRunID = "child_run_id"
+
+// MetaReturnStatus is the metadata key under which nodes that return data to
+// the calling workflow record the status of the return. The engine resumes
+// the step waiting for the run with the data of the envelope.
+const MetaReturnStatus = "return_status"
+
+// WorkflowReturnSignal is the signal a workflow call waits for until the
+// called workflow returns
+const WorkflowReturnSignal = "workflow_return"
+
+// MetaHTTPResponse is the metadata key under which nodes that answer the
+// HTTP request that started the run record the response, as JSON encoded
+// RunResponse. Webhooks in sync mode reply with the first response of a run.
+const MetaHTTPResponse = "http_response"
+
+// RunResponse is the response to the HTTP request that started a run. Bodies
+// that are not strings are sent as JSON.
+type RunResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       any               `json:"body,omitempty"`
+}
+
 // SetMeta sets a metadata value
 func (e *Envelope[T]) SetMeta(key, value string) {
 	if e.Meta == nil {
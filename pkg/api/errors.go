@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// Standard NodeError codes. Nodes return them so the execution engine can
+// tell transient failures from permanent ones.
+const (
+	// ErrorCodeValidation marks invalid input or configuration. Validation
+	// errors are never retried.
+	ErrorCodeValidation = "validation"
+	// ErrorCodeBadRequest marks a request rejected by an upstream service
+	ErrorCodeBadRequest = "bad_request"
+	// ErrorCodeUnauthorized marks missing or rejected credentials
+	ErrorCodeUnauthorized = "unauthorized"
+	// ErrorCodeNotFound marks a resource that does not exist
+	ErrorCodeNotFound = "not_found"
+	// ErrorCodeRateLimited marks a request throttled by an upstream service
+	ErrorCodeRateLimited = "rate_limited"
+	// ErrorCodeTimeout marks an operation that did not finish in time
+	ErrorCodeTimeout = "timeout"
+	// ErrorCodeNetwork marks a connection failure
+	ErrorCodeNetwork = "network"
+	// ErrorCodeUpstream marks a server error of an upstream service
+	ErrorCodeUpstream = "upstream"
+	// ErrorCodeInternal marks an unexpected failure of the node itself
+	ErrorCodeInternal = "internal"
+)
+
+// NewNodeErrorWithCause creates a NodeError with an error code that wraps
+// the error that caused it.
+func NewNodeErrorWithCause(nodeID, nodeType, message, code string, cause error) *NodeError {
+	return &NodeError{
+		NodeID:  nodeID,
+		Type:    nodeType,
+		Message: message,
+		Code:    code,
+		Err:     cause,
+	}
+}
+
+// ErrorCode classifies an error. The code of a wrapped NodeError takes
+// precedence; otherwise deadlines, malformed URLs and network errors are
+// recognized. It returns an empty string for errors it cannot classify.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var nodeErr *NodeError
+	if errors.As(err, &nodeErr) && nodeErr.Code != "" {
+		return nodeErr.Code
+	}
+	var nodeErrValue NodeError
+	if errors.As(err, &nodeErrValue) && nodeErrValue.Code != "" {
+		return nodeErrValue.Code
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorCodeTimeout
+	}
+
+	// Malformed URLs are reported as url.Error, which is also a net.Error
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && urlErr.Op == "parse" {
+		return ErrorCodeValidation
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ErrorCodeTimeout
+		}
+		return ErrorCodeNetwork
+	}
+
+	return ""
+}
+
+// ErrorCodeForStatus returns the error code for an unsuccessful HTTP status
+// code, or an empty string for successful ones.
+func ErrorCodeForStatus(status int) string {
+	switch {
+	case status < http.StatusBadRequest:
+		return ""
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrorCodeUnauthorized
+	case status == http.StatusNotFound:
+		return ErrorCodeNotFound
+	case status == http.StatusRequestTimeout || status == http.StatusGatewayTimeout:
+		return ErrorCodeTimeout
+	case status == http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	case status >= http.StatusInternalServerError:
+		return ErrorCodeUpstream
+	default:
+		return ErrorCodeBadRequest
+	}
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"plain error", errors.New("boom"), ""},
+		{"node error", NewNodeErrorWithCode("n1", "llm", "bad input", ErrorCodeValidation), ErrorCodeValidation},
+		{"node error value", NodeError{Message: "slow", Code: ErrorCodeTimeout}, ErrorCodeTimeout},
+		{"wrapped node error", fmt.Errorf("step failed: %w", NewNodeErrorWithCode("n1", "llm", "throttled", ErrorCodeRateLimited)), ErrorCodeRateLimited},
+		{"node error without code", NewNodeErrorWithCause("n1", "http_request", "failed", "", context.DeadlineExceeded), ErrorCodeTimeout},
+		{"deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), ErrorCodeTimeout},
+		{"network", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, ErrorCodeNetwork},
+		{"malformed url", &url.Error{Op: "parse", URL: "::", Err: errors.New("missing protocol scheme")}, ErrorCodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorCodeForStatus(t *testing.T) {
+	tests := map[int]string{
+		200: "",
+		302: "",
+		400: ErrorCodeBadRequest,
+		401: ErrorCodeUnauthorized,
+		403: ErrorCodeUnauthorized,
+		404: ErrorCodeNotFound,
+		408: ErrorCodeTimeout,
+		422: ErrorCodeBadRequest,
+		429: ErrorCodeRateLimited,
+		500: ErrorCodeUpstream,
+		503: ErrorCodeUpstream,
+		504: ErrorCodeTimeout,
+	}
+
+	for status, want := range tests {
+		if got := ErrorCodeForStatus(status); got != want {
+			t.Errorf("ErrorCodeForStatus(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
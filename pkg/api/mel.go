@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"strings"
 	"sync"
@@ -67,6 +68,9 @@ type Mel interface {
 	StoreData(ctx context.Context, key string, data interface{}, ttl time.Duration) error
 	RetrieveData(ctx context.Context, key string) (interface{}, error)
 	DeleteData(ctx context.Context, key string) error
+	// SetDataStore replaces the store data is kept in, which defaults to
+	// the memory of the process
+	SetDataStore(store DataStore)
 }
 
 // melImpl is the concrete implementation of the Mel interface.
@@ -75,18 +79,11 @@ type melImpl struct {
 	definitions      []NodeDefinition
 	httpClient       *http.Client
 	workflowEndpoint string
-	dataStore        map[string]dataStoreEntry
-	dataStoreMu      sync.RWMutex
+	dataStore        DataStore
 	pendingCalls     map[string]pendingCallEntry
 	pendingCallsMu   sync.RWMutex
 }
 
-// dataStoreEntry represents a stored data entry with TTL
-type dataStoreEntry struct {
-	Data      interface{}
-	ExpiresAt time.Time
-}
-
 // pendingCallEntry tracks calls waiting for responses
 type pendingCallEntry struct {
 	CallID        string
@@ -101,7 +98,7 @@ func NewMel() Mel {
 		definitions:      make([]NodeDefinition, 0),
 		httpClient:       &http.Client{Timeout: 30 * time.Second},
 		workflowEndpoint: "http://localhost:8080/api", // Default to local API
-		dataStore:        make(map[string]dataStoreEntry),
+		dataStore:        NewMemoryDataStore(),
 		pendingCalls:     make(map[string]pendingCallEntry),
 	}
 }
@@ -112,7 +109,7 @@ func NewMelWithConfig(httpTimeout time.Duration, workflowEndpoint string) Mel {
 		definitions:      make([]NodeDefinition, 0),
 		httpClient:       &http.Client{Timeout: httpTimeout},
 		workflowEndpoint: workflowEndpoint,
-		dataStore:        make(map[string]dataStoreEntry),
+		dataStore:        NewMemoryDataStore(),
 		pendingCalls:     make(map[string]pendingCallEntry),
 	}
 }
@@ -306,20 +303,31 @@ func (m *melImpl) CallWorkflow(ctx context.Context, req WorkflowCallRequest) (*W
 			return nil, fmt.Errorf("workflow trigger failed with status %d: %s", resp.StatusCode, string(resp.Body))
 		}
 
-		// Step 3: Wait for workflow_return node to call ReturnToWorkflow
+		// Step 3: Wait for workflow_return node to call ReturnToWorkflow. A
+		// return on another instance arrives through the data store.
 		timeoutDuration := time.Duration(req.TimeoutSeconds) * time.Second
-		select {
-		case response := <-responseChan:
-			// Received response from workflow_return node
-			return &response, nil
-
-		case <-time.After(timeoutDuration):
-			// Timeout waiting for response
-			return nil, fmt.Errorf("timeout waiting for workflow response after %v", timeoutDuration)
-
-		case <-ctx.Done():
-			// Context cancelled
-			return nil, ctx.Err()
+		timeout := time.After(timeoutDuration)
+		poll := time.NewTicker(returnPollInterval)
+		defer poll.Stop()
+		for {
+			select {
+			case response := <-responseChan:
+				// Received response from workflow_return node
+				return &response, nil
+
+			case <-poll.C:
+				if response := m.storedReturn(ctx, callID); response != nil {
+					return response, nil
+				}
+
+			case <-timeout:
+				// Timeout waiting for response
+				return nil, fmt.Errorf("timeout waiting for workflow response after %v", timeoutDuration)
+
+			case <-ctx.Done():
+				// Context cancelled
+				return nil, ctx.Err()
+			}
 		}
 
 	} else {
@@ -362,7 +370,41 @@ func marshalJSON(v interface{}) string {
 	return string(data)
 }
 
-// ReturnToWorkflow returns data to a calling workflow
+// returnPollInterval is how often a synchronous workflow call checks the data
+// store for a return delivered to another instance
+const returnPollInterval = 500 * time.Millisecond
+
+// returnKey is the data store key a return is stored under when the call
+// does not wait on this instance
+func returnKey(callID string) string {
+	return "workflow_return:" + callID
+}
+
+// storedReturn takes the return of a call from the data store, or returns
+// nil if there is none yet
+func (m *melImpl) storedReturn(ctx context.Context, callID string) *WorkflowCallResponse {
+	stored, err := m.RetrieveData(ctx, returnKey(callID))
+	if err != nil {
+		return nil
+	}
+	if err := m.DeleteData(ctx, returnKey(callID)); err != nil {
+		log.Printf("Warning: failed to delete return of workflow call %s: %v", callID, err)
+	}
+
+	entry, _ := stored.(map[string]interface{})
+	data, _ := entry["data"].(map[string]interface{})
+	status, _ := entry["status"].(string)
+	return &WorkflowCallResponse{
+		CallID:      callID,
+		Status:      status,
+		Data:        data,
+		Message:     fmt.Sprintf("Workflow return received with status: %s", status),
+		CompletedAt: time.Now(),
+	}
+}
+
+// ReturnToWorkflow returns data to a calling workflow. Calls waiting on
+// another instance receive it through the data store.
 func (m *melImpl) ReturnToWorkflow(ctx context.Context, callID string, data map[string]interface{}, status string) error {
 	m.pendingCallsMu.Lock()
 	defer m.pendingCallsMu.Unlock()
@@ -371,7 +413,7 @@ func (m *melImpl) ReturnToWorkflow(ctx context.Context, callID string, data map[
 	pendingCall, exists := m.pendingCalls[callID]
 	if !exists {
 		// If no pending call found, store the response in case it's retrieved later
-		return m.StoreData(ctx, "workflow_return:"+callID, map[string]interface{}{
+		return m.StoreData(ctx, returnKey(callID), map[string]interface{}{
 			"data":       data,
 			"status":     status,
 			"returnedAt": time.Now().Format(time.RFC3339),
@@ -408,47 +450,29 @@ func (m *melImpl) ReturnToWorkflow(ctx context.Context, callID string, data map[
 
 // StoreData stores data with TTL for cross-workflow communication
 func (m *melImpl) StoreData(ctx context.Context, key string, data interface{}, ttl time.Duration) error {
-	m.dataStoreMu.Lock()
-	defer m.dataStoreMu.Unlock()
-
 	expiresAt := time.Now().Add(ttl)
 	if ttl <= 0 {
 		expiresAt = time.Now().Add(24 * time.Hour) // Default 24h TTL
 	}
 
-	m.dataStore[key] = dataStoreEntry{
-		Data:      data,
-		ExpiresAt: expiresAt,
-	}
-
-	return nil
+	return m.dataStore.Store(ctx, key, data, expiresAt)
 }
 
 // RetrieveData retrieves stored data
 func (m *melImpl) RetrieveData(ctx context.Context, key string) (interface{}, error) {
-	m.dataStoreMu.RLock()
-	defer m.dataStoreMu.RUnlock()
-
-	entry, exists := m.dataStore[key]
-	if !exists {
-		return nil, fmt.Errorf("data not found for key: %s", key)
-	}
-
-	// Check if expired
-	if time.Now().After(entry.ExpiresAt) {
-		// Clean up expired entry (we do this in read to avoid needing a background cleanup goroutine)
-		delete(m.dataStore, key)
-		return nil, fmt.Errorf("data expired for key: %s", key)
+	data, err := m.dataStore.Retrieve(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("%w for key: %s", err, key)
 	}
-
-	return entry.Data, nil
+	return data, nil
 }
 
 // DeleteData deletes stored data
 func (m *melImpl) DeleteData(ctx context.Context, key string) error {
-	m.dataStoreMu.Lock()
-	defer m.dataStoreMu.Unlock()
+	return m.dataStore.Delete(ctx, key)
+}
 
-	delete(m.dataStore, key)
-	return nil
+// SetDataStore replaces the store data is kept in
+func (m *melImpl) SetDataStore(store DataStore) {
+	m.dataStore = store
 }
@@ -304,6 +304,48 @@ func TestMelWorkflowReturn(t *testing.T) {
 	}
 }
 
+func TestMelWorkflowReturnAcrossInstances(t *testing.T) {
+	// The called workflow is triggered on one instance
+	callIDs := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		callIDs <- payload["callId"].(string)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	// Both instances share their data store
+	store := NewMemoryDataStore()
+	caller := NewMelWithConfig(5*time.Second, server.URL)
+	caller.SetDataStore(store)
+	returner := NewMel()
+	returner.SetDataStore(store)
+
+	// The workflow returns on the other instance
+	go func() {
+		callID := <-callIDs
+		returner.ReturnToWorkflow(context.Background(), callID, map[string]interface{}{"result": "done"}, "success")
+	}()
+
+	resp, err := caller.CallWorkflow(context.Background(), WorkflowCallRequest{
+		TargetWorkflowID: "target-workflow",
+		CallMode:         "sync",
+		TimeoutSeconds:   5,
+	})
+	if err != nil {
+		t.Fatalf("Sync workflow call failed: %v", err)
+	}
+	if resp.Status != "success" || resp.Data["result"] != "done" {
+		t.Errorf("Expected the returned data, got %+v", resp)
+	}
+
+	// The return is taken from the store
+	if _, err := store.Retrieve(context.Background(), returnKey(resp.CallID)); err != ErrDataNotFound {
+		t.Errorf("Expected the return to be removed from the store, got %v", err)
+	}
+}
+
 func TestMelWithConfig(t *testing.T) {
 	timeout := 10 * time.Second
 	endpoint := "http://custom-endpoint:8080/api"
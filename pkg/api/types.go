@@ -1,5 +1,7 @@
 package api
 
+import "context"
+
 // ParameterType represents the type of a parameter with JSON schema support.
 type ParameterType string
 
@@ -112,6 +114,9 @@ type NodeType struct {
 	EntryPoint bool                  `json:"entry_point,omitempty"`
 	Branching  bool                  `json:"branching,omitempty"`
 	Parameters []ParameterDefinition `json:"parameters,omitempty"`
+	// RequiredCapabilities lists capabilities, such as "has-python" or
+	// "region=eu", a worker must advertise to execute the node
+	RequiredCapabilities []string `json:"required_capabilities,omitempty"`
 }
 
 // ExecutionContext provides context for node execution.
@@ -120,6 +125,16 @@ type ExecutionContext struct {
 	RunID     string                 `json:"run_id,omitempty"`
 	Variables map[string]interface{} `json:"variables,omitempty"`
 	Mel       Mel                    `json:"-"` // Platform utilities (not serialized)
+	Context   context.Context        `json:"-"` // Cancelled when the step times out (not serialized)
+}
+
+// GoContext returns the context nodes should pass to blocking calls. It
+// falls back to context.Background when the engine did not set one.
+func (c ExecutionContext) GoContext() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
 }
 
 // ExecutionResult represents the result of node execution.
@@ -134,12 +149,18 @@ type NodeError struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
 	Code    string `json:"code,omitempty"`
+	Err     error  `json:"-"` // Underlying cause, if any
 }
 
 func (e NodeError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying cause of the error.
+func (e NodeError) Unwrap() error {
+	return e.Err
+}
+
 // NewNodeError creates a new NodeError.
 func NewNodeError(nodeID, nodeType, message string) *NodeError {
 	return &NodeError{
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -32,15 +33,33 @@ const (
 type ClaimWorkRequest struct {
 	// MaxItems Maximum number of work items to claim
 	MaxItems *int `json:"maxItems,omitempty"`
+
+	// WaitSeconds Seconds to wait for work when none is available. The request returns as soon as work was claimed, so workers can long-poll instead of polling.
+	WaitSeconds *int `json:"waitSeconds,omitempty"`
 }
 
 // CompleteWorkRequest defines model for CompleteWorkRequest.
 type CompleteWorkRequest struct {
 	// Error Error message if the work item failed
-	Error  *string                 `json:"error,omitempty"`
-	Result *map[string]interface{} `json:"result,omitempty"`
+	Error *string `json:"error,omitempty"`
+
+	// LeaseEpoch Lease epoch of the claim on the work item. Workers whose claim was recovered and handed to another worker no longer hold the current lease.
+	LeaseEpoch int64 `json:"lease_epoch"`
+
+	// NextSteps Steps to queue after this work item
+	NextSteps *[]openapi_types.UUID   `json:"next_steps,omitempty"`
+	Result    *map[string]interface{} `json:"result,omitempty"`
+
+	// RetryDelayMs Delay before the retry is attempted
+	RetryDelayMs *int64 `json:"retry_delay_ms,omitempty"`
+
+	// ShouldRetry Whether a failed work item should be retried
+	ShouldRetry *bool `json:"should_retry,omitempty"`
 }
 
+// Envelope Serialized data envelope flowing between workflow nodes
+type Envelope map[string]interface{}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *int    `json:"code,omitempty"`
@@ -54,11 +73,77 @@ type GenericPayload map[string]interface{}
 // GenericResult Generic result object containing arbitrary result data
 type GenericResult map[string]interface{}
 
+// InactiveRunsRequest defines model for InactiveRunsRequest.
+type InactiveRunsRequest struct {
+	// RunIds Runs the worker is executing steps for
+	RunIds []openapi_types.UUID `json:"run_ids"`
+}
+
+// InactiveRunsResponse defines model for InactiveRunsResponse.
+type InactiveRunsResponse struct {
+	// RunIds Runs that were cancelled or have otherwise finished. Their steps should stop executing.
+	RunIds []openapi_types.UUID `json:"run_ids"`
+}
+
+// InitializeRunResponse defines model for InitializeRunResponse.
+type InitializeRunResponse struct {
+	// NextSteps Entry point steps that are ready to execute
+	NextSteps []openapi_types.UUID `json:"next_steps"`
+}
+
+// NodeConfig Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
+type NodeConfig map[string]interface{}
+
 // RegisterWorkerRequest defines model for RegisterWorkerRequest.
 type RegisterWorkerRequest struct {
-	Concurrency *int    `json:"concurrency,omitempty"`
-	Id          string  `json:"id"`
-	Name        *string `json:"name,omitempty"`
+	// Capabilities Node types and labels the worker can execute. "*" matches every node type but no labels.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+	Concurrency  *int      `json:"concurrency,omitempty"`
+	Id           string    `json:"id"`
+	Name         *string   `json:"name,omitempty"`
+}
+
+// StepHeartbeatRequest defines model for StepHeartbeatRequest.
+type StepHeartbeatRequest struct {
+	// LeaseEpoch Lease epoch of the execution, as returned when the step was fetched
+	LeaseEpoch int64 `json:"lease_epoch"`
+}
+
+// StepResultRequest defines model for StepResultRequest.
+type StepResultRequest struct {
+	// Error Error message if the node execution failed
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Code classifying the error, such as validation, timeout or upstream. Determines whether the step is retried.
+	ErrorCode *string `json:"error_code,omitempty"`
+
+	// LeaseEpoch Lease epoch of the execution, as returned when the step was fetched
+	LeaseEpoch int64 `json:"lease_epoch"`
+
+	// OutputEnvelope Serialized data envelope flowing between workflow nodes
+	OutputEnvelope *Envelope `json:"output_envelope,omitempty"`
+}
+
+// StepResultResponse defines model for StepResultResponse.
+type StepResultResponse struct {
+	Error *string `json:"error,omitempty"`
+
+	// NextSteps Steps that became ready to execute
+	NextSteps *[]openapi_types.UUID `json:"next_steps,omitempty"`
+
+	// RetryDelayMs Delay before the step is retried
+	RetryDelayMs *int64 `json:"retry_delay_ms,omitempty"`
+	ShouldRetry  *bool  `json:"should_retry,omitempty"`
+	Success      bool   `json:"success"`
+}
+
+// StoreWorkerDataRequest defines model for StoreWorkerDataRequest.
+type StoreWorkerDataRequest struct {
+	// Data The data to store, of any JSON type
+	Data interface{} `json:"data"`
+
+	// TtlSeconds Seconds until the data expires
+	TtlSeconds float64 `json:"ttl_seconds"`
 }
 
 // WorkItem defines model for WorkItem.
@@ -66,6 +151,9 @@ type WorkItem struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	Id        *string    `json:"id,omitempty"`
 
+	// LeaseEpoch Lease of the claim, to pass back when completing the work item
+	LeaseEpoch *int64 `json:"lease_epoch,omitempty"`
+
 	// Payload Generic payload object containing arbitrary data
 	Payload *GenericPayload `json:"payload,omitempty"`
 	Type    *string         `json:"type,omitempty"`
@@ -73,6 +161,7 @@ type WorkItem struct {
 
 // Worker defines model for Worker.
 type Worker struct {
+	Capabilities  *[]string  `json:"capabilities,omitempty"`
 	Concurrency   *int       `json:"concurrency,omitempty"`
 	Id            *string    `json:"id,omitempty"`
 	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
@@ -83,9 +172,34 @@ type Worker struct {
 	Status *WorkerStatus `json:"status,omitempty"`
 }
 
+// WorkerData defines model for WorkerData.
+type WorkerData struct {
+	// Data The stored data, of any JSON type
+	Data interface{} `json:"data"`
+}
+
 // WorkerStatus Status of a worker
 type WorkerStatus string
 
+// WorkerStep defines model for WorkerStep.
+type WorkerStep struct {
+	AttemptCount *int               `json:"attempt_count,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// InputEnvelope Serialized data envelope flowing between workflow nodes
+	InputEnvelope *Envelope `json:"input_envelope,omitempty"`
+
+	// LeaseEpoch Lease of this execution of the step, to pass back with its result
+	LeaseEpoch  int64 `json:"lease_epoch"`
+	MaxAttempts *int  `json:"max_attempts,omitempty"`
+
+	// NodeConfig Node configuration containing node-specific parameters and settings. Every node additionally accepts `retry` (an object with `maxAttempts`, `backoffMultiplier`, `initialDelayMs` and `maxDelayMs` overriding the retry policy of the run), `timeoutSeconds` (cancels the node execution once exceeded) and `onError` (`stop`, `continue` or `errorOutput`, deciding what happens once the node failed for good; `errorOutput` follows only edges whose source output is `error`).
+	NodeConfig *NodeConfig        `json:"node_config,omitempty"`
+	NodeId     string             `json:"node_id"`
+	NodeType   string             `json:"node_type"`
+	RunId      openapi_types.UUID `json:"run_id"`
+}
+
 // RegisterWorkerJSONRequestBody defines body for RegisterWorker for application/json ContentType.
 type RegisterWorkerJSONRequestBody = RegisterWorkerRequest
 
@@ -95,6 +209,18 @@ type ClaimWorkJSONRequestBody = ClaimWorkRequest
 // CompleteWorkJSONRequestBody defines body for CompleteWork for application/json ContentType.
 type CompleteWorkJSONRequestBody = CompleteWorkRequest
 
+// StoreWorkerDataJSONRequestBody defines body for StoreWorkerData for application/json ContentType.
+type StoreWorkerDataJSONRequestBody = StoreWorkerDataRequest
+
+// ListInactiveWorkerRunsJSONRequestBody defines body for ListInactiveWorkerRuns for application/json ContentType.
+type ListInactiveWorkerRunsJSONRequestBody = InactiveRunsRequest
+
+// ReportStepHeartbeatJSONRequestBody defines body for ReportStepHeartbeat for application/json ContentType.
+type ReportStepHeartbeatJSONRequestBody = StepHeartbeatRequest
+
+// ReportStepResultJSONRequestBody defines body for ReportStepResult for application/json ContentType.
+type ReportStepResultJSONRequestBody = StepResultRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -189,8 +315,43 @@ type ClientInterface interface {
 
 	CompleteWork(ctx context.Context, id string, itemId string, body CompleteWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteWorkerData request
+	DeleteWorkerData(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkerData request
+	GetWorkerData(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StoreWorkerDataWithBody request with any body
+	StoreWorkerDataWithBody(ctx context.Context, id string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StoreWorkerData(ctx context.Context, id string, key string, body StoreWorkerDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateWorkerHeartbeat request
 	UpdateWorkerHeartbeat(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInactiveWorkerRunsWithBody request with any body
+	ListInactiveWorkerRunsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ListInactiveWorkerRuns(ctx context.Context, id string, body ListInactiveWorkerRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinalizeWorkerRun request
+	FinalizeWorkerRun(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InitializeWorkerRun request
+	InitializeWorkerRun(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReportStepHeartbeatWithBody request with any body
+	ReportStepHeartbeatWithBody(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReportStepHeartbeat(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReportStepResultWithBody request with any body
+	ReportStepResultWithBody(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReportStepResult(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepResultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartWorkerStep request
+	StartWorkerStep(ctx context.Context, id string, stepId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ListWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -289,6 +450,54 @@ func (c *Client) CompleteWork(ctx context.Context, id string, itemId string, bod
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteWorkerData(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWorkerDataRequest(c.Server, id, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkerData(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkerDataRequest(c.Server, id, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StoreWorkerDataWithBody(ctx context.Context, id string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStoreWorkerDataRequestWithBody(c.Server, id, key, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StoreWorkerData(ctx context.Context, id string, key string, body StoreWorkerDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStoreWorkerDataRequest(c.Server, id, key, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UpdateWorkerHeartbeat(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpdateWorkerHeartbeatRequest(c.Server, id)
 	if err != nil {
@@ -301,6 +510,114 @@ func (c *Client) UpdateWorkerHeartbeat(ctx context.Context, id string, reqEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListInactiveWorkerRunsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInactiveWorkerRunsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInactiveWorkerRuns(ctx context.Context, id string, body ListInactiveWorkerRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInactiveWorkerRunsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FinalizeWorkerRun(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinalizeWorkerRunRequest(c.Server, id, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InitializeWorkerRun(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitializeWorkerRunRequest(c.Server, id, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportStepHeartbeatWithBody(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportStepHeartbeatRequestWithBody(c.Server, id, stepId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportStepHeartbeat(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportStepHeartbeatRequest(c.Server, id, stepId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportStepResultWithBody(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportStepResultRequestWithBody(c.Server, id, stepId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportStepResult(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepResultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportStepResultRequest(c.Server, id, stepId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartWorkerStep(ctx context.Context, id string, stepId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartWorkerStepRequest(c.Server, id, stepId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewListWorkersRequest generates requests for ListWorkers
 func NewListWorkersRequest(server string) (*http.Request, error) {
 	var err error
@@ -503,8 +820,8 @@ func NewCompleteWorkRequestWithBody(server string, id string, itemId string, con
 	return req, nil
 }
 
-// NewUpdateWorkerHeartbeatRequest generates requests for UpdateWorkerHeartbeat
-func NewUpdateWorkerHeartbeatRequest(server string, id string) (*http.Request, error) {
+// NewDeleteWorkerDataRequest generates requests for DeleteWorkerData
+func NewDeleteWorkerDataRequest(server string, id string, key string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -514,12 +831,19 @@ func NewUpdateWorkerHeartbeatRequest(server string, id string) (*http.Request, e
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/workers/%s/heartbeat", pathParam0)
+	operationPath := fmt.Sprintf("/api/workers/%s/data/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -529,7 +853,7 @@ func NewUpdateWorkerHeartbeatRequest(server string, id string) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -537,308 +861,1367 @@ func NewUpdateWorkerHeartbeatRequest(server string, id string) (*http.Request, e
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetWorkerDataRequest generates requests for GetWorkerData
+func NewGetWorkerDataRequest(server string, id string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam1 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListWorkersWithResponse request
-	ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error)
+	operationPath := fmt.Sprintf("/api/workers/%s/data/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// RegisterWorkerWithBodyWithResponse request with any body
-	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UnregisterWorkerWithResponse request
-	UnregisterWorkerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnregisterWorkerResponse, error)
+	return req, nil
+}
+
+// NewStoreWorkerDataRequest calls the generic StoreWorkerData builder with application/json body
+func NewStoreWorkerDataRequest(server string, id string, key string, body StoreWorkerDataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStoreWorkerDataRequestWithBody(server, id, key, "application/json", bodyReader)
+}
+
+// NewStoreWorkerDataRequestWithBody generates requests for StoreWorkerData with any type of body
+func NewStoreWorkerDataRequestWithBody(server string, id string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/data/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateWorkerHeartbeatRequest generates requests for UpdateWorkerHeartbeat
+func NewUpdateWorkerHeartbeatRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/heartbeat", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInactiveWorkerRunsRequest calls the generic ListInactiveWorkerRuns builder with application/json body
+func NewListInactiveWorkerRunsRequest(server string, id string, body ListInactiveWorkerRunsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewListInactiveWorkerRunsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewListInactiveWorkerRunsRequestWithBody generates requests for ListInactiveWorkerRuns with any type of body
+func NewListInactiveWorkerRunsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/inactive-runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFinalizeWorkerRunRequest generates requests for FinalizeWorkerRun
+func NewFinalizeWorkerRunRequest(server string, id string, runId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "runId", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/runs/%s/finalize", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInitializeWorkerRunRequest generates requests for InitializeWorkerRun
+func NewInitializeWorkerRunRequest(server string, id string, runId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "runId", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/runs/%s/initialize", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReportStepHeartbeatRequest calls the generic ReportStepHeartbeat builder with application/json body
+func NewReportStepHeartbeatRequest(server string, id string, stepId openapi_types.UUID, body ReportStepHeartbeatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReportStepHeartbeatRequestWithBody(server, id, stepId, "application/json", bodyReader)
+}
+
+// NewReportStepHeartbeatRequestWithBody generates requests for ReportStepHeartbeat with any type of body
+func NewReportStepHeartbeatRequestWithBody(server string, id string, stepId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "stepId", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/steps/%s/heartbeat", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReportStepResultRequest calls the generic ReportStepResult builder with application/json body
+func NewReportStepResultRequest(server string, id string, stepId openapi_types.UUID, body ReportStepResultJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReportStepResultRequestWithBody(server, id, stepId, "application/json", bodyReader)
+}
+
+// NewReportStepResultRequestWithBody generates requests for ReportStepResult with any type of body
+func NewReportStepResultRequestWithBody(server string, id string, stepId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "stepId", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/steps/%s/result", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStartWorkerStepRequest generates requests for StartWorkerStep
+func NewStartWorkerStepRequest(server string, id string, stepId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "stepId", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workers/%s/steps/%s/start", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListWorkersWithResponse request
+	ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error)
+
+	// RegisterWorkerWithBodyWithResponse request with any body
+	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	// UnregisterWorkerWithResponse request
+	UnregisterWorkerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnregisterWorkerResponse, error)
+
+	// ClaimWorkWithBodyWithResponse request with any body
+	ClaimWorkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error)
+
+	ClaimWorkWithResponse(ctx context.Context, id string, body ClaimWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error)
+
+	// CompleteWorkWithBodyWithResponse request with any body
+	CompleteWorkWithBodyWithResponse(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error)
+
+	CompleteWorkWithResponse(ctx context.Context, id string, itemId string, body CompleteWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error)
+
+	// DeleteWorkerDataWithResponse request
+	DeleteWorkerDataWithResponse(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*DeleteWorkerDataResponse, error)
+
+	// GetWorkerDataWithResponse request
+	GetWorkerDataWithResponse(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*GetWorkerDataResponse, error)
+
+	// StoreWorkerDataWithBodyWithResponse request with any body
+	StoreWorkerDataWithBodyWithResponse(ctx context.Context, id string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StoreWorkerDataResponse, error)
+
+	StoreWorkerDataWithResponse(ctx context.Context, id string, key string, body StoreWorkerDataJSONRequestBody, reqEditors ...RequestEditorFn) (*StoreWorkerDataResponse, error)
+
+	// UpdateWorkerHeartbeatWithResponse request
+	UpdateWorkerHeartbeatWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UpdateWorkerHeartbeatResponse, error)
+
+	// ListInactiveWorkerRunsWithBodyWithResponse request with any body
+	ListInactiveWorkerRunsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListInactiveWorkerRunsResponse, error)
+
+	ListInactiveWorkerRunsWithResponse(ctx context.Context, id string, body ListInactiveWorkerRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListInactiveWorkerRunsResponse, error)
+
+	// FinalizeWorkerRunWithResponse request
+	FinalizeWorkerRunWithResponse(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*FinalizeWorkerRunResponse, error)
+
+	// InitializeWorkerRunWithResponse request
+	InitializeWorkerRunWithResponse(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*InitializeWorkerRunResponse, error)
+
+	// ReportStepHeartbeatWithBodyWithResponse request with any body
+	ReportStepHeartbeatWithBodyWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportStepHeartbeatResponse, error)
+
+	ReportStepHeartbeatWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportStepHeartbeatResponse, error)
+
+	// ReportStepResultWithBodyWithResponse request with any body
+	ReportStepResultWithBodyWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportStepResultResponse, error)
+
+	ReportStepResultWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepResultJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportStepResultResponse, error)
+
+	// StartWorkerStepWithResponse request
+	StartWorkerStepWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StartWorkerStepResponse, error)
+}
+
+type ListWorkersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Worker
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterWorkerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Worker
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterWorkerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterWorkerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnregisterWorkerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnregisterWorkerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnregisterWorkerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClaimWorkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WorkItem
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ClaimWorkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClaimWorkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteWorkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteWorkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteWorkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWorkerDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWorkerDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWorkerDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerData
+	JSON404      *Error
+	JSON410      *Error
+	JSON500      *Error
+}
 
-	// ClaimWorkWithBodyWithResponse request with any body
-	ClaimWorkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetWorkerDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	ClaimWorkWithResponse(ctx context.Context, id string, body ClaimWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkerDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CompleteWorkWithBodyWithResponse request with any body
-	CompleteWorkWithBodyWithResponse(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error)
+type StoreWorkerDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
 
-	CompleteWorkWithResponse(ctx context.Context, id string, itemId string, body CompleteWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error)
+// Status returns HTTPResponse.Status
+func (r StoreWorkerDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateWorkerHeartbeatWithResponse request
-	UpdateWorkerHeartbeatWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UpdateWorkerHeartbeatResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StoreWorkerDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type ListWorkersResponse struct {
+type UpdateWorkerHeartbeatResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Worker
+	JSON404      *Error
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ListWorkersResponse) Status() string {
+func (r UpdateWorkerHeartbeatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWorkerHeartbeatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInactiveWorkerRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InactiveRunsResponse
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInactiveWorkerRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInactiveWorkerRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinalizeWorkerRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FinalizeWorkerRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinalizeWorkerRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InitializeWorkerRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InitializeRunResponse
+	JSON404      *Error
+	JSON410      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InitializeWorkerRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InitializeWorkerRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReportStepHeartbeatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReportStepHeartbeatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReportStepHeartbeatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReportStepResultResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepResultResponse
+	JSON404      *Error
+	JSON409      *Error
+	JSON410      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReportStepResultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReportStepResultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartWorkerStepResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerStep
+	JSON404      *Error
+	JSON409      *Error
+	JSON410      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StartWorkerStepResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartWorkerStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListWorkersWithResponse request returning *ListWorkersResponse
+func (c *ClientWithResponses) ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error) {
+	rsp, err := c.ListWorkers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkersResponse(rsp)
+}
+
+// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
+func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterWorkerResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterWorkerResponse(rsp)
+}
+
+// UnregisterWorkerWithResponse request returning *UnregisterWorkerResponse
+func (c *ClientWithResponses) UnregisterWorkerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnregisterWorkerResponse, error) {
+	rsp, err := c.UnregisterWorker(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnregisterWorkerResponse(rsp)
+}
+
+// ClaimWorkWithBodyWithResponse request with arbitrary body returning *ClaimWorkResponse
+func (c *ClientWithResponses) ClaimWorkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error) {
+	rsp, err := c.ClaimWorkWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimWorkResponse(rsp)
+}
+
+func (c *ClientWithResponses) ClaimWorkWithResponse(ctx context.Context, id string, body ClaimWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error) {
+	rsp, err := c.ClaimWork(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimWorkResponse(rsp)
+}
+
+// CompleteWorkWithBodyWithResponse request with arbitrary body returning *CompleteWorkResponse
+func (c *ClientWithResponses) CompleteWorkWithBodyWithResponse(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error) {
+	rsp, err := c.CompleteWorkWithBody(ctx, id, itemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteWorkResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompleteWorkWithResponse(ctx context.Context, id string, itemId string, body CompleteWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error) {
+	rsp, err := c.CompleteWork(ctx, id, itemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteWorkResponse(rsp)
+}
+
+// DeleteWorkerDataWithResponse request returning *DeleteWorkerDataResponse
+func (c *ClientWithResponses) DeleteWorkerDataWithResponse(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*DeleteWorkerDataResponse, error) {
+	rsp, err := c.DeleteWorkerData(ctx, id, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWorkerDataResponse(rsp)
+}
+
+// GetWorkerDataWithResponse request returning *GetWorkerDataResponse
+func (c *ClientWithResponses) GetWorkerDataWithResponse(ctx context.Context, id string, key string, reqEditors ...RequestEditorFn) (*GetWorkerDataResponse, error) {
+	rsp, err := c.GetWorkerData(ctx, id, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerDataResponse(rsp)
+}
+
+// StoreWorkerDataWithBodyWithResponse request with arbitrary body returning *StoreWorkerDataResponse
+func (c *ClientWithResponses) StoreWorkerDataWithBodyWithResponse(ctx context.Context, id string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StoreWorkerDataResponse, error) {
+	rsp, err := c.StoreWorkerDataWithBody(ctx, id, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStoreWorkerDataResponse(rsp)
+}
+
+func (c *ClientWithResponses) StoreWorkerDataWithResponse(ctx context.Context, id string, key string, body StoreWorkerDataJSONRequestBody, reqEditors ...RequestEditorFn) (*StoreWorkerDataResponse, error) {
+	rsp, err := c.StoreWorkerData(ctx, id, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStoreWorkerDataResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListWorkersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateWorkerHeartbeatWithResponse request returning *UpdateWorkerHeartbeatResponse
+func (c *ClientWithResponses) UpdateWorkerHeartbeatWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UpdateWorkerHeartbeatResponse, error) {
+	rsp, err := c.UpdateWorkerHeartbeat(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateWorkerHeartbeatResponse(rsp)
 }
 
-type RegisterWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Worker
-	JSON400      *Error
-	JSON500      *Error
+// ListInactiveWorkerRunsWithBodyWithResponse request with arbitrary body returning *ListInactiveWorkerRunsResponse
+func (c *ClientWithResponses) ListInactiveWorkerRunsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListInactiveWorkerRunsResponse, error) {
+	rsp, err := c.ListInactiveWorkerRunsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInactiveWorkerRunsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RegisterWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ListInactiveWorkerRunsWithResponse(ctx context.Context, id string, body ListInactiveWorkerRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListInactiveWorkerRunsResponse, error) {
+	rsp, err := c.ListInactiveWorkerRuns(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListInactiveWorkerRunsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FinalizeWorkerRunWithResponse request returning *FinalizeWorkerRunResponse
+func (c *ClientWithResponses) FinalizeWorkerRunWithResponse(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*FinalizeWorkerRunResponse, error) {
+	rsp, err := c.FinalizeWorkerRun(ctx, id, runId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFinalizeWorkerRunResponse(rsp)
 }
 
-type UnregisterWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
+// InitializeWorkerRunWithResponse request returning *InitializeWorkerRunResponse
+func (c *ClientWithResponses) InitializeWorkerRunWithResponse(ctx context.Context, id string, runId openapi_types.UUID, reqEditors ...RequestEditorFn) (*InitializeWorkerRunResponse, error) {
+	rsp, err := c.InitializeWorkerRun(ctx, id, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInitializeWorkerRunResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UnregisterWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ReportStepHeartbeatWithBodyWithResponse request with arbitrary body returning *ReportStepHeartbeatResponse
+func (c *ClientWithResponses) ReportStepHeartbeatWithBodyWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportStepHeartbeatResponse, error) {
+	rsp, err := c.ReportStepHeartbeatWithBody(ctx, id, stepId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseReportStepHeartbeatResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnregisterWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ReportStepHeartbeatWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportStepHeartbeatResponse, error) {
+	rsp, err := c.ReportStepHeartbeat(ctx, id, stepId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseReportStepHeartbeatResponse(rsp)
 }
 
-type ClaimWorkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]WorkItem
+// ReportStepResultWithBodyWithResponse request with arbitrary body returning *ReportStepResultResponse
+func (c *ClientWithResponses) ReportStepResultWithBodyWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportStepResultResponse, error) {
+	rsp, err := c.ReportStepResultWithBody(ctx, id, stepId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportStepResultResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ClaimWorkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ReportStepResultWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, body ReportStepResultJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportStepResultResponse, error) {
+	rsp, err := c.ReportStepResult(ctx, id, stepId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseReportStepResultResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ClaimWorkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StartWorkerStepWithResponse request returning *StartWorkerStepResponse
+func (c *ClientWithResponses) StartWorkerStepWithResponse(ctx context.Context, id string, stepId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StartWorkerStepResponse, error) {
+	rsp, err := c.StartWorkerStep(ctx, id, stepId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStartWorkerStepResponse(rsp)
 }
 
-type CompleteWorkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ParseListWorkersResponse parses an HTTP response from a ListWorkersWithResponse call
+func ParseListWorkersResponse(rsp *http.Response) (*ListWorkersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Worker
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CompleteWorkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRegisterWorkerResponse parses an HTTP response from a RegisterWorkerWithResponse call
+func ParseRegisterWorkerResponse(rsp *http.Response) (*RegisterWorkerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &RegisterWorkerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Worker
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CompleteWorkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUnregisterWorkerResponse parses an HTTP response from a UnregisterWorkerWithResponse call
+func ParseUnregisterWorkerResponse(rsp *http.Response) (*UnregisterWorkerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &UnregisterWorkerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-type UpdateWorkerHeartbeatResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
+// ParseClaimWorkResponse parses an HTTP response from a ClaimWorkWithResponse call
+func ParseClaimWorkResponse(rsp *http.Response) (*ClaimWorkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClaimWorkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WorkItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateWorkerHeartbeatResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// ParseCompleteWorkResponse parses an HTTP response from a CompleteWorkWithResponse call
+func ParseCompleteWorkResponse(rsp *http.Response) (*CompleteWorkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteWorkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateWorkerHeartbeatResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// ListWorkersWithResponse request returning *ListWorkersResponse
-func (c *ClientWithResponses) ListWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkersResponse, error) {
-	rsp, err := c.ListWorkers(ctx, reqEditors...)
+// ParseDeleteWorkerDataResponse parses an HTTP response from a DeleteWorkerDataWithResponse call
+func ParseDeleteWorkerDataResponse(rsp *http.Response) (*DeleteWorkerDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListWorkersResponse(rsp)
-}
 
-// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
-func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteWorkerDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRegisterWorkerResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseRegisterWorkerResponse(rsp)
+
+	return response, nil
 }
 
-// UnregisterWorkerWithResponse request returning *UnregisterWorkerResponse
-func (c *ClientWithResponses) UnregisterWorkerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnregisterWorkerResponse, error) {
-	rsp, err := c.UnregisterWorker(ctx, id, reqEditors...)
+// ParseGetWorkerDataResponse parses an HTTP response from a GetWorkerDataWithResponse call
+func ParseGetWorkerDataResponse(rsp *http.Response) (*GetWorkerDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnregisterWorkerResponse(rsp)
-}
 
-// ClaimWorkWithBodyWithResponse request with arbitrary body returning *ClaimWorkResponse
-func (c *ClientWithResponses) ClaimWorkWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error) {
-	rsp, err := c.ClaimWorkWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWorkerDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseClaimWorkResponse(rsp)
-}
 
-func (c *ClientWithResponses) ClaimWorkWithResponse(ctx context.Context, id string, body ClaimWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWorkResponse, error) {
-	rsp, err := c.ClaimWork(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseClaimWorkResponse(rsp)
+
+	return response, nil
 }
 
-// CompleteWorkWithBodyWithResponse request with arbitrary body returning *CompleteWorkResponse
-func (c *ClientWithResponses) CompleteWorkWithBodyWithResponse(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error) {
-	rsp, err := c.CompleteWorkWithBody(ctx, id, itemId, contentType, body, reqEditors...)
+// ParseStoreWorkerDataResponse parses an HTTP response from a StoreWorkerDataWithResponse call
+func ParseStoreWorkerDataResponse(rsp *http.Response) (*StoreWorkerDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCompleteWorkResponse(rsp)
-}
 
-func (c *ClientWithResponses) CompleteWorkWithResponse(ctx context.Context, id string, itemId string, body CompleteWorkJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteWorkResponse, error) {
-	rsp, err := c.CompleteWork(ctx, id, itemId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StoreWorkerDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCompleteWorkResponse(rsp)
-}
 
-// UpdateWorkerHeartbeatWithResponse request returning *UpdateWorkerHeartbeatResponse
-func (c *ClientWithResponses) UpdateWorkerHeartbeatWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UpdateWorkerHeartbeatResponse, error) {
-	rsp, err := c.UpdateWorkerHeartbeat(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateWorkerHeartbeatResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListWorkersResponse parses an HTTP response from a ListWorkersWithResponse call
-func ParseListWorkersResponse(rsp *http.Response) (*ListWorkersResponse, error) {
+// ParseUpdateWorkerHeartbeatResponse parses an HTTP response from a UpdateWorkerHeartbeatWithResponse call
+func ParseUpdateWorkerHeartbeatResponse(rsp *http.Response) (*UpdateWorkerHeartbeatResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListWorkersResponse{
+	response := &UpdateWorkerHeartbeatResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Worker
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -852,34 +2235,53 @@ func ParseListWorkersResponse(rsp *http.Response) (*ListWorkersResponse, error)
 	return response, nil
 }
 
-// ParseRegisterWorkerResponse parses an HTTP response from a RegisterWorkerWithResponse call
-func ParseRegisterWorkerResponse(rsp *http.Response) (*RegisterWorkerResponse, error) {
+// ParseListInactiveWorkerRunsResponse parses an HTTP response from a ListInactiveWorkerRunsWithResponse call
+func ParseListInactiveWorkerRunsResponse(rsp *http.Response) (*ListInactiveWorkerRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RegisterWorkerResponse{
+	response := &ListInactiveWorkerRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Worker
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InactiveRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFinalizeWorkerRunResponse parses an HTTP response from a FinalizeWorkerRunWithResponse call
+func ParseFinalizeWorkerRunResponse(rsp *http.Response) (*FinalizeWorkerRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &FinalizeWorkerRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -892,20 +2294,27 @@ func ParseRegisterWorkerResponse(rsp *http.Response) (*RegisterWorkerResponse, e
 	return response, nil
 }
 
-// ParseUnregisterWorkerResponse parses an HTTP response from a UnregisterWorkerWithResponse call
-func ParseUnregisterWorkerResponse(rsp *http.Response) (*UnregisterWorkerResponse, error) {
+// ParseInitializeWorkerRunResponse parses an HTTP response from a InitializeWorkerRunWithResponse call
+func ParseInitializeWorkerRunResponse(rsp *http.Response) (*InitializeWorkerRunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnregisterWorkerResponse{
+	response := &InitializeWorkerRunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InitializeRunResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -913,6 +2322,13 @@ func ParseUnregisterWorkerResponse(rsp *http.Response) (*UnregisterWorkerRespons
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -925,62 +2341,114 @@ func ParseUnregisterWorkerResponse(rsp *http.Response) (*UnregisterWorkerRespons
 	return response, nil
 }
 
-// ParseClaimWorkResponse parses an HTTP response from a ClaimWorkWithResponse call
-func ParseClaimWorkResponse(rsp *http.Response) (*ClaimWorkResponse, error) {
+// ParseReportStepHeartbeatResponse parses an HTTP response from a ReportStepHeartbeatWithResponse call
+func ParseReportStepHeartbeatResponse(rsp *http.Response) (*ReportStepHeartbeatResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ClaimWorkResponse{
+	response := &ReportStepHeartbeatResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []WorkItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCompleteWorkResponse parses an HTTP response from a CompleteWorkWithResponse call
-func ParseCompleteWorkResponse(rsp *http.Response) (*CompleteWorkResponse, error) {
+// ParseReportStepResultResponse parses an HTTP response from a ReportStepResultWithResponse call
+func ParseReportStepResultResponse(rsp *http.Response) (*ReportStepResultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CompleteWorkResponse{
+	response := &ReportStepResultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepResultResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseUpdateWorkerHeartbeatResponse parses an HTTP response from a UpdateWorkerHeartbeatWithResponse call
-func ParseUpdateWorkerHeartbeatResponse(rsp *http.Response) (*UpdateWorkerHeartbeatResponse, error) {
+// ParseStartWorkerStepResponse parses an HTTP response from a StartWorkerStepWithResponse call
+func ParseStartWorkerStepResponse(rsp *http.Response) (*StartWorkerStepResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateWorkerHeartbeatResponse{
+	response := &StartWorkerStepResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerStep
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -988,6 +2456,20 @@ func ParseUpdateWorkerHeartbeatResponse(rsp *http.Response) (*UpdateWorkerHeartb
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -7,13 +7,19 @@ import (
 	"github.com/cedricziel/mel-agent/pkg/api"
 )
 
+// Node types of the split and aggregate pattern nodes
+const (
+	SplitterNodeType   = "envelope_splitter"
+	AggregatorNodeType = "envelope_aggregator"
+)
+
 // SplitterNode implements the split pattern for envelope arrays
 type SplitterNode struct{}
 
 // Meta returns metadata for the Splitter node
 func (s *SplitterNode) Meta() api.NodeType {
 	return api.NodeType{
-		Type:     "envelope_splitter",
+		Type:     SplitterNodeType,
 		Label:    "Split Array",
 		Icon:     "🔀",
 		Category: "Control Flow",
@@ -34,7 +40,7 @@ func (s *SplitterNode) Initialize(mel api.Mel) error {
 	return nil
 }
 
-// ExecuteEnvelope splits an envelope containing an array into multiple envelopes
+// ExecuteEnvelope extracts the array to split from an envelope
 func (s *SplitterNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
 	keyPath, _ := node.Data["keyPath"].(string)
 	preserveEmpty, _ := node.Data["preserveEmpty"].(bool)
@@ -62,48 +68,28 @@ func (s *SplitterNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node,
 		return envelope, api.NewNodeError(node.ID, node.Type, "input array is empty")
 	}
 
-	// Split the array into individual envelopes
-	splitEnvelopes := SplitEnvelope(&api.Envelope[[]interface{}]{
-		ID:        envelope.ID,
+	// Emit the whole array marked as a split. The execution engine fans the
+	// items out with SplitEnvelope so each one runs its own downstream steps.
+	result := &api.Envelope[interface{}]{
+		ID:        GenerateEnvelopeID(),
 		IssuedAt:  envelope.IssuedAt,
 		Version:   envelope.Version,
 		DataType:  "array",
 		Data:      arrayData,
 		Binary:    envelope.Binary,
-		Meta:      envelope.Meta,
 		Variables: envelope.Variables,
-		Trace:     envelope.Trace,
+		Trace:     envelope.Trace.Next(node.ID),
 		Errors:    envelope.Errors,
-	})
-
-	// Return the first envelope and store the rest for subsequent calls
-	// Note: In a real implementation, this would need a proper queue system
-	if len(splitEnvelopes) > 0 {
-		// Convert back to generic envelope
-		result := &api.Envelope[interface{}]{
-			ID:        splitEnvelopes[0].ID,
-			IssuedAt:  splitEnvelopes[0].IssuedAt,
-			Version:   splitEnvelopes[0].Version,
-			DataType:  splitEnvelopes[0].DataType,
-			Data:      splitEnvelopes[0].Data,
-			Binary:    splitEnvelopes[0].Binary,
-			Meta:      splitEnvelopes[0].Meta,
-			Variables: splitEnvelopes[0].Variables,
-			Trace:     splitEnvelopes[0].Trace,
-			Errors:    splitEnvelopes[0].Errors,
-		}
-
-		// Add metadata about the split operation
-		if result.Meta == nil {
-			result.Meta = make(map[string]string)
-		}
-		result.Meta["split_operation"] = "true"
-		result.Meta["split_total"] = fmt.Sprintf("%d", len(splitEnvelopes))
+	}
 
-		return result, nil
+	result.Meta = make(map[string]string, len(envelope.Meta)+2)
+	for k, v := range envelope.Meta {
+		result.Meta[k] = v
 	}
+	result.Meta["split_operation"] = "true"
+	result.Meta["split_total"] = fmt.Sprintf("%d", len(arrayData))
 
-	return envelope, nil
+	return result, nil
 }
 
 // AggregatorNode implements the aggregate pattern for collecting envelopes
@@ -122,7 +108,7 @@ func NewAggregatorNode() *AggregatorNode {
 // Meta returns metadata for the Aggregator node
 func (a *AggregatorNode) Meta() api.NodeType {
 	return api.NodeType{
-		Type:     "envelope_aggregator",
+		Type:     AggregatorNodeType,
 		Label:    "Aggregate Items",
 		Icon:     "🔗",
 		Category: "Control Flow",
@@ -148,6 +134,13 @@ func (a *AggregatorNode) Initialize(mel api.Mel) error {
 
 // ExecuteEnvelope collects envelopes and emits when quorum is met
 func (a *AggregatorNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
+	// Envelopes merged by the durable engine already hold every item
+	if complete, _ := envelope.GetMeta("aggregation_complete"); complete == "true" {
+		result := envelope.Clone()
+		result.Trace = envelope.Trace.Next(node.ID)
+		return result, nil
+	}
+
 	expectedCount, _ := node.Data["expectedCount"].(int)
 	partialResults, _ := node.Data["partialResults"].(bool)
 
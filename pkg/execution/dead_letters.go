@@ -0,0 +1,139 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// deadLetterTx keeps a work item that failed for good as a dead letter,
+// together with the input envelope of its step
+func (e *DurableExecutionEngine) deadLetterTx(ctx context.Context, tx *sql.Tx, item *QueueItem, lastError *string) error {
+	letter := &DeadLetter{
+		ID:           item.ID,
+		RunID:        item.RunID,
+		StepID:       item.StepID,
+		QueueType:    item.QueueType,
+		Priority:     item.Priority,
+		AttemptCount: item.AttemptCount,
+		MaxAttempts:  item.MaxAttempts,
+		Payload:      item.Payload,
+		LastError:    lastError,
+		WorkerID:     item.ClaimedBy,
+		CreatedAt:    item.CreatedAt,
+	}
+
+	if item.StepID != nil {
+		var inputJSON []byte
+		err := tx.QueryRowContext(ctx, `SELECT input_envelope FROM workflow_steps WHERE id = $1`, *item.StepID).Scan(&inputJSON)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to load step input: %w", err)
+		}
+		if len(inputJSON) > 0 {
+			if err := json.Unmarshal(inputJSON, &letter.Envelope); err != nil {
+				return fmt.Errorf("failed to parse input envelope: %w", err)
+			}
+		}
+	}
+
+	return e.queue.DeadLetter(ctx, tx, letter)
+}
+
+// attemptsExhaustedTx reports whether a failed work item used up its
+// attempts: those of its step, or for items without a step its own
+func attemptsExhaustedTx(ctx context.Context, tx *sql.Tx, item *QueueItem) (bool, error) {
+	if item.StepID == nil {
+		return item.AttemptCount+1 >= item.MaxAttempts, nil
+	}
+
+	// Steps without attempts of their own retry by the policy of their run
+	query := `
+		SELECT s.attempt_count >= COALESCE(NULLIF(s.max_attempts, 0), (r.retry_policy->>'max_attempts')::int, $2)
+		FROM workflow_steps s
+		JOIN workflow_runs r ON r.id = s.run_id
+		WHERE s.id = $1`
+	var exhausted bool
+	err := tx.QueryRowContext(ctx, query, *item.StepID, DefaultRetryPolicy().MaxAttempts).Scan(&exhausted)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to load step attempts: %w", err)
+	}
+	return exhausted, nil
+}
+
+// ListDeadLetters lists the work items that ran out of attempts, newest
+// first, optionally only those of a run. It also returns their total number.
+func (e *DurableExecutionEngine) ListDeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error) {
+	return e.queue.DeadLetters(ctx, runID, limit, offset)
+}
+
+// GetDeadLetter returns a work item that ran out of attempts
+func (e *DurableExecutionEngine) GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	return e.queue.GetDeadLetter(ctx, id)
+}
+
+// RequeueDeadLetter queues a dead-lettered work item again with fresh
+// attempts. A run that failed is resumed, and a step that failed becomes
+// pending again with fresh retries. The error workflow started when the run
+// failed is not undone: it handles the failure that was dead-lettered, and
+// starts again if the resumed run fails.
+func (e *DurableExecutionEngine) RequeueDeadLetter(ctx context.Context, id uuid.UUID) error {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	letter, err := e.queue.RemoveDeadLetter(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	// Runs that never started are initialized by their start_run item
+	runQuery := `
+		UPDATE workflow_runs
+		SET status = CASE WHEN started_at IS NULL THEN 'pending' ELSE 'running' END,
+		    error_data = NULL, completed_at = NULL
+		WHERE id = $1 AND status = 'failed'`
+	if _, err := tx.ExecContext(ctx, runQuery, letter.RunID); err != nil {
+		return fmt.Errorf("failed to resume run: %w", err)
+	}
+
+	if letter.StepID != nil {
+		stepQuery := `
+			UPDATE workflow_steps
+			SET status = 'pending', attempt_count = 0, assigned_worker_id = NULL
+			WHERE id = $1 AND status = 'failed'`
+		if _, err := tx.ExecContext(ctx, stepQuery, *letter.StepID); err != nil {
+			return fmt.Errorf("failed to reset step: %w", err)
+		}
+	}
+
+	item := &QueueItem{
+		ID:          letter.ID,
+		RunID:       letter.RunID,
+		StepID:      letter.StepID,
+		QueueType:   letter.QueueType,
+		Priority:    letter.Priority,
+		AvailableAt: time.Now(),
+		MaxAttempts: letter.MaxAttempts,
+		Payload:     letter.Payload,
+	}
+	if err := e.enqueueItemTx(ctx, tx, item); err != nil {
+		return fmt.Errorf("failed to requeue work item: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// DiscardDeadLetter drops a work item that ran out of attempts for good
+func (e *DurableExecutionEngine) DiscardDeadLetter(ctx context.Context, id uuid.UUID) error {
+	_, err := e.queue.RemoveDeadLetter(ctx, nil, id)
+	return err
+}
@@ -3,7 +3,11 @@ package execution
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -47,6 +51,50 @@ func TestDurableExecutionWithPostgreSQL(t *testing.T) {
 	t.Run("CompleteEndToEndScenario", func(t *testing.T) {
 		testCompleteEndToEndScenario(t, engine, db)
 	})
+
+	t.Run("RunTimeout", func(t *testing.T) {
+		testRunTimeout(t, engine, db)
+	})
+
+	t.Run("DurableWait", func(t *testing.T) {
+		testDurableWait(t, engine, db)
+	})
+
+	t.Run("WaitForSignal", func(t *testing.T) {
+		testWaitForSignal(t, engine, db)
+	})
+
+	t.Run("ReplayRun", func(t *testing.T) {
+		testReplayRun(t, engine, db)
+	})
+
+	t.Run("CapabilityRouting", func(t *testing.T) {
+		testCapabilityRouting(t, engine, db)
+	})
+
+	t.Run("ConcurrencyLimits", func(t *testing.T) {
+		testConcurrencyLimits(t, engine, db)
+	})
+
+	t.Run("DeadLetters", func(t *testing.T) {
+		testDeadLetters(t, engine, db)
+	})
+
+	t.Run("LeaseFencing", func(t *testing.T) {
+		testLeaseFencing(t, engine, db)
+	})
+
+	t.Run("WorkflowCall", func(t *testing.T) {
+		testWorkflowCall(t, engine, db)
+	})
+
+	t.Run("IdempotentStart", func(t *testing.T) {
+		testIdempotentStart(t, engine, db)
+	})
+
+	t.Run("RunOutput", func(t *testing.T) {
+		testRunOutput(t, engine, db)
+	})
 }
 
 func testBasicWorkflowExecution(t *testing.T, engine ExecutionEngine, db *sql.DB) {
@@ -123,7 +171,7 @@ func testWorkerFailureRecovery(t *testing.T, engine ExecutionEngine, db *sql.DB)
 	assert.Equal(t, QueueTypeStartRun, work[0].QueueType)
 
 	// Simulate Worker 1 processing but then "crashing" (we'll simulate this by not completing the work)
-	workItemID := work[0].ID
+	workItemID, lease := work[0].ID, work[0].LeaseEpoch
 
 	// Complete the work (simulating successful start_run processing)
 	result := &WorkResult{
@@ -131,7 +179,7 @@ func testWorkerFailureRecovery(t *testing.T, engine ExecutionEngine, db *sql.DB)
 		NextSteps: []uuid.UUID{}, // No next steps for this simple test
 	}
 
-	err = engine.CompleteWork(ctx, worker1.ID, workItemID, result)
+	err = engine.CompleteWork(ctx, worker1.ID, workItemID, lease, result)
 	require.NoError(t, err, "Failed to complete work")
 
 	// Verify the queue item was removed
@@ -325,6 +373,11 @@ func testWorkflowPauseResumeCancel(t *testing.T, engine ExecutionEngine, db *sql
 	assert.Equal(t, "cancelled", cancelledStatus, "Run should be cancelled")
 	assert.NotNil(t, completedAt, "Cancelled run should have completed_at timestamp")
 
+	// Workers learn about the cancellation to stop in-flight steps
+	inactive, err := engine.InactiveRuns(ctx, []uuid.UUID{runID, uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{runID}, inactive)
+
 	t.Logf("✅ Workflow pause/resume/cancel test passed - All control operations work correctly")
 }
 
@@ -443,7 +496,7 @@ func testCompleteEndToEndScenario(t *testing.T, engine ExecutionEngine, db *sql.
 		NextSteps: []uuid.UUID{stepIDs[0]}, // Next: validate_order
 	}
 
-	err = engine.CompleteWork(ctx, "worker-general", startRunItem.ID, result)
+	err = engine.CompleteWork(ctx, "worker-general", startRunItem.ID, startRunItem.LeaseEpoch, result)
 	require.NoError(t, err, "Failed to complete start_run work")
 
 	// 5. Simulate step execution with worker specialization
@@ -583,5 +636,1058 @@ func simulateStepExecution(ctx context.Context, engine ExecutionEngine, db *sql.
 		NextSteps: nextSteps,
 	}
 
-	return engine.CompleteWork(ctx, workerID, work[0].ID, result)
+	return engine.CompleteWork(ctx, workerID, work[0].ID, work[0].LeaseEpoch, result)
+}
+
+func testRunTimeout(t *testing.T, engine ExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+	agentID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	runID := uuid.New()
+
+	run := &WorkflowRun{
+		ID:             runID,
+		AgentID:        agentID,
+		VersionID:      uuid.New(),
+		Status:         RunStatusPending,
+		InputData:      map[string]any{"test": "run_timeout"},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 60,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+
+	err := engine.StartRun(ctx, run)
+	require.NoError(t, err)
+
+	runningStepID, pendingStepID := uuid.New(), uuid.New()
+	_, err = db.Exec(`
+		INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status)
+		VALUES ($1, $3, 'slow', 'agent', 1, 'running'), ($2, $3, 'next', 'log', 2, 'pending')`,
+		runningStepID, pendingStepID, runID)
+	require.NoError(t, err)
+
+	// Runs within their timeout are left alone
+	runIDs, err := engine.TimeoutRuns(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, runIDs, runID)
+
+	_, err = db.Exec("UPDATE workflow_runs SET status = 'running', started_at = NOW() - INTERVAL '2 minutes' WHERE id = $1", runID)
+	require.NoError(t, err)
+
+	runIDs, err = engine.TimeoutRuns(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, runIDs, runID)
+
+	var status string
+	var errorData []byte
+	err = db.QueryRow("SELECT status, error_data FROM workflow_runs WHERE id = $1", runID).Scan(&status, &errorData)
+	require.NoError(t, err)
+	assert.Equal(t, "failed", status)
+	assert.Contains(t, string(errorData), api.ErrorCodeTimeout)
+
+	stepStatuses := map[uuid.UUID]string{}
+	rows, err := db.Query("SELECT id, status FROM workflow_steps WHERE run_id = $1", runID)
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var id uuid.UUID
+		var stepStatus string
+		require.NoError(t, rows.Scan(&id, &stepStatus))
+		stepStatuses[id] = stepStatus
+	}
+	assert.Equal(t, "failed", stepStatuses[runningStepID])
+	assert.Equal(t, "skipped", stepStatuses[pendingStepID])
+
+	var queueCount int
+	err = db.QueryRow("SELECT COUNT(*) FROM workflow_queue WHERE run_id = $1", runID).Scan(&queueCount)
+	require.NoError(t, err)
+	assert.Equal(t, 0, queueCount, "Outstanding work should be removed")
+
+	// A run only times out once
+	runIDs, err = engine.TimeoutRuns(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, runIDs, runID)
+}
+
+func testDurableWait(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+	runID := uuid.New()
+
+	run := &WorkflowRun{
+		ID:             runID,
+		AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		VersionID:      uuid.New(),
+		Status:         RunStatusPending,
+		InputData:      map[string]any{"test": "durable_wait"},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 3600,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+	require.NoError(t, engine.StartRun(ctx, run))
+
+	waitStepID, nextStepID := uuid.New(), uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on)
+		VALUES ($1, $3, 'wait', 'delay', 1, 'running', '{}'), ($2, $3, 'next', 'log', 2, 'pending', ARRAY[$1]::uuid[])`,
+		waitStepID, nextStepID, runID)
+	require.NoError(t, err)
+
+	// The delay completes right away and records the end of the wait
+	waitUntil := time.Now().Add(2 * time.Hour)
+	output := &api.Envelope[any]{ID: "wait-output", Data: "payload"}
+	output.SetMeta(api.MetaWaitUntil, waitUntil.UTC().Format(time.RFC3339Nano))
+
+	result, err := engine.RecordStepResult(ctx, waitStepID, 0, output, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{nextStepID}, result.NextSteps)
+
+	// The following step is queued to run once the wait is over
+	item := &QueueItem{
+		ID:          uuid.New(),
+		RunID:       runID,
+		StepID:      &nextStepID,
+		QueueType:   QueueTypeExecuteStep,
+		Priority:    5,
+		AvailableAt: time.Now(),
+		MaxAttempts: 3,
+	}
+	require.NoError(t, engine.enqueueItem(ctx, item))
+
+	var availableAt time.Time
+	err = db.QueryRow("SELECT available_at FROM workflow_queue WHERE id = $1", item.ID).Scan(&availableAt)
+	require.NoError(t, err)
+	assert.WithinDuration(t, waitUntil, availableAt, time.Second)
+}
+
+func testWaitForSignal(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	// startWaitingRun parks a run on a step waiting for the approval signal,
+	// followed by a step on each of its branches
+	startWaitingRun := func(timeout time.Duration) (runID, waitStepID, approvedStepID, expiredStepID uuid.UUID, resumeURL string) {
+		runID = uuid.New()
+		run := &WorkflowRun{
+			ID:             runID,
+			AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			VersionID:      uuid.New(),
+			Status:         RunStatusPending,
+			InputData:      map[string]any{"test": "wait_for_signal"},
+			Variables:      map[string]any{},
+			TimeoutSeconds: 3600,
+			RetryPolicy:    DefaultRetryPolicy(),
+		}
+		require.NoError(t, engine.StartRun(ctx, run))
+		_, err := db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, runID)
+		require.NoError(t, err)
+
+		waitStepID, approvedStepID, expiredStepID = uuid.New(), uuid.New(), uuid.New()
+		_, err = db.Exec(`
+			INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on, branch_conditions)
+			VALUES ($1, $4, 'approve', 'wait_for_signal', 1, 'running', '{}', NULL),
+			       ($2, $4, 'send', 'email', 2, 'pending', ARRAY[$1]::uuid[], jsonb_build_object($1::text, jsonb_build_array('signal'))),
+			       ($3, $4, 'expire', 'log', 3, 'pending', ARRAY[$1]::uuid[], jsonb_build_object($1::text, jsonb_build_array('timeout')))`,
+			waitStepID, approvedStepID, expiredStepID, runID)
+		require.NoError(t, err)
+
+		output := &api.Envelope[any]{ID: "draft", Data: map[string]any{"draft": "Dear customer"}}
+		output.SetMeta(api.MetaWaitSignal, "approval")
+		if timeout != 0 {
+			output.SetMeta(api.MetaWaitUntil, time.Now().Add(timeout).UTC().Format(time.RFC3339Nano))
+		}
+
+		result, err := engine.RecordStepResult(ctx, waitStepID, 0, output, nil)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Empty(t, result.NextSteps)
+
+		// The step is parked and its run paused
+		var stepStatus, runStatus string
+		var outputJSON []byte
+		err = db.QueryRow(`SELECT status, output_envelope FROM workflow_steps WHERE id = $1`, waitStepID).Scan(&stepStatus, &outputJSON)
+		require.NoError(t, err)
+		assert.Equal(t, string(StepStatusWaiting), stepStatus)
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, runID).Scan(&runStatus))
+		assert.Equal(t, string(RunStatusPaused), runStatus)
+
+		var parked api.Envelope[any]
+		require.NoError(t, json.Unmarshal(outputJSON, &parked))
+		resumeURL, ok := parked.GetMeta(api.MetaResumeURL)
+		require.True(t, ok)
+		assert.True(t, strings.HasPrefix(resumeURL, fmt.Sprintf("/api/workflow-runs/%s/signals/approval?token=", runID)))
+		return runID, waitStepID, approvedStepID, expiredStepID, resumeURL
+	}
+
+	queuedSteps := func(runID uuid.UUID) []uuid.UUID {
+		var stepIDs []uuid.UUID
+		rows, err := db.Query(`SELECT step_id FROM workflow_queue WHERE run_id = $1 AND step_id IS NOT NULL`, runID)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var stepID uuid.UUID
+			require.NoError(t, rows.Scan(&stepID))
+			stepIDs = append(stepIDs, stepID)
+		}
+		return stepIDs
+	}
+
+	t.Run("Signal", func(t *testing.T) {
+		runID, waitStepID, approvedStepID, expiredStepID, resumeURL := startWaitingRun(time.Hour)
+		token := resumeURL[strings.Index(resumeURL, "token=")+len("token="):]
+
+		_, err := engine.SignalRun(ctx, runID, "approval", "forged", nil)
+		assert.ErrorIs(t, err, ErrInvalidSignalToken)
+		_, err = engine.SignalRun(ctx, runID, "rejection", token, nil)
+		assert.ErrorIs(t, err, ErrSignalNotAwaited)
+
+		stepID, err := engine.SignalRun(ctx, runID, "approval", token, map[string]any{"approved_by": "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, waitStepID, stepID)
+
+		// The step continues on the signal branch with the payload
+		var stepStatus, branch string
+		var outputJSON []byte
+		err = db.QueryRow(`SELECT status, selected_branch, output_envelope FROM workflow_steps WHERE id = $1`, waitStepID).
+			Scan(&stepStatus, &branch, &outputJSON)
+		require.NoError(t, err)
+		assert.Equal(t, string(StepStatusCompleted), stepStatus)
+		assert.Equal(t, api.SignalBranch, branch)
+
+		var output api.Envelope[any]
+		require.NoError(t, json.Unmarshal(outputJSON, &output))
+		assert.Equal(t, map[string]any{"approved_by": "alice"}, output.Data)
+		_, waiting := output.GetMeta(api.MetaWaitSignal)
+		assert.False(t, waiting)
+
+		var runStatus, expiredStatus string
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, runID).Scan(&runStatus))
+		assert.Equal(t, string(RunStatusRunning), runStatus)
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_steps WHERE id = $1`, expiredStepID).Scan(&expiredStatus))
+		assert.Equal(t, string(StepStatusSkipped), expiredStatus)
+		assert.Equal(t, []uuid.UUID{approvedStepID}, queuedSteps(runID))
+
+		// A signal is only delivered once
+		_, err = engine.SignalRun(ctx, runID, "approval", token, nil)
+		assert.ErrorIs(t, err, ErrSignalNotAwaited)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		runID, waitStepID, approvedStepID, expiredStepID, _ := startWaitingRun(-time.Second)
+
+		require.NoError(t, engine.TimeoutSignals(ctx))
+
+		var branch, approvedStatus string
+		require.NoError(t, db.QueryRow(`SELECT selected_branch FROM workflow_steps WHERE id = $1`, waitStepID).Scan(&branch))
+		assert.Equal(t, api.TimeoutBranch, branch)
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_steps WHERE id = $1`, approvedStepID).Scan(&approvedStatus))
+		assert.Equal(t, string(StepStatusSkipped), approvedStatus)
+		assert.Equal(t, []uuid.UUID{expiredStepID}, queuedSteps(runID))
+	})
+}
+
+func testReplayRun(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	definition := WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "fetch", Type: "http_request"},
+			{ID: "enrich", Type: "transform"},
+			{ID: "store", Type: "db_query"},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "fetch", Target: "enrich"},
+			{ID: "e2", Source: "enrich", Target: "store"},
+		},
+	}
+	definitionJSON, err := json.Marshal(definition)
+	require.NoError(t, err)
+
+	workflowID, versionID := uuid.New(), uuid.New()
+	_, err = db.Exec(`INSERT INTO workflows (id, user_id, name, definition) VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Replay', $2)`,
+		workflowID, definitionJSON)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO workflow_versions (id, workflow_id, version_number, name, definition, is_current) VALUES ($1, $2, 1, 'v1', $3, true)`,
+		versionID, workflowID, definitionJSON)
+	require.NoError(t, err)
+
+	// The original run fetched the order and failed to enrich it
+	runID := uuid.New()
+	run := &WorkflowRun{
+		ID:             runID,
+		WorkflowID:     &workflowID,
+		VersionID:      versionID,
+		Status:         RunStatusPending,
+		InputData:      map[string]any{"order_id": 42},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 3600,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+	require.NoError(t, engine.StartRun(ctx, run))
+
+	fetchOutput, _ := json.Marshal(&api.Envelope[any]{ID: "fetched", Data: map[string]any{"order": 42}})
+	enrichInput, _ := json.Marshal(&api.Envelope[any]{ID: "enrich-input", Data: map[string]any{"order": 42}})
+	fetchStepID, enrichStepID, storeStepID := uuid.New(), uuid.New(), uuid.New()
+	_, err = db.Exec(`
+		INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on, input_envelope)
+		VALUES ($1, $4, 'fetch', 'http_request', 1, 'completed', '{}', NULL),
+		       ($2, $4, 'enrich', 'transform', 2, 'failed', ARRAY[$1]::uuid[], $5),
+		       ($3, $4, 'store', 'db_query', 3, 'pending', ARRAY[$2]::uuid[], NULL)`,
+		fetchStepID, enrichStepID, storeStepID, runID, enrichInput)
+	require.NoError(t, err)
+	require.NoError(t, engine.createCheckpoint(ctx, runID, fetchStepID, "post_execution", json.RawMessage(fetchOutput)))
+	_, err = db.Exec(`UPDATE workflow_runs SET status = 'failed' WHERE id = $1`, runID)
+	require.NoError(t, err)
+
+	replay, err := engine.ReplayRun(ctx, runID, "enrich", nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, runID, replay.ID)
+
+	var replaySourceRunID uuid.UUID
+	require.NoError(t, db.QueryRow(`SELECT replay_source_run_id FROM workflow_runs WHERE id = $1`, replay.ID).Scan(&replaySourceRunID))
+	assert.Equal(t, runID, replaySourceRunID)
+
+	steps := make(map[string]*WorkflowStep)
+	rows, err := db.Query(`SELECT id FROM workflow_steps WHERE run_id = $1`, replay.ID)
+	require.NoError(t, err)
+	for rows.Next() {
+		var stepID uuid.UUID
+		require.NoError(t, rows.Scan(&stepID))
+		step, err := loadWorkflowStep(ctx, db, stepID)
+		require.NoError(t, err)
+		steps[step.NodeID] = step
+	}
+	rows.Close()
+	require.Len(t, steps, 3)
+
+	// Upstream outputs come from the checkpoints of the original run
+	assert.Equal(t, StepStatusCompleted, steps["fetch"].Status)
+	require.NotNil(t, steps["fetch"].OutputEnvelope)
+	assert.Equal(t, "fetched", steps["fetch"].OutputEnvelope.ID)
+
+	// The replayed node keeps its input and runs again with everything downstream
+	assert.Equal(t, StepStatusPending, steps["enrich"].Status)
+	require.NotNil(t, steps["enrich"].InputEnvelope)
+	assert.Equal(t, "enrich-input", steps["enrich"].InputEnvelope.ID)
+	assert.Equal(t, StepStatusPending, steps["store"].Status)
+
+	nextSteps, err := engine.InitializeRun(ctx, replay.ID, "integration-test-worker")
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{steps["enrich"].ID}, nextSteps)
+
+	_, err = engine.ReplayRun(ctx, runID, "missing", nil)
+	assert.ErrorIs(t, err, ErrNodeNotFound)
+}
+
+// pythonNode is a node that only runs on workers with a Python runtime
+type pythonNode struct{}
+
+func (pythonNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_python", Label: "Python", Category: "Test", RequiredCapabilities: []string{"has-python"}}
+}
+
+func (pythonNode) Initialize(mel api.Mel) error { return nil }
+
+func (pythonNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	return envelope, nil
+}
+
+func testCapabilityRouting(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+	engine.mel.RegisterNodeDefinition(pythonNode{})
+
+	run := &WorkflowRun{
+		ID:             uuid.New(),
+		VersionID:      uuid.New(),
+		Status:         RunStatusPending,
+		InputData:      map[string]any{},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 3600,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+	require.NoError(t, engine.StartRun(ctx, run))
+
+	fetch := &WorkflowStep{ID: uuid.New(), RunID: run.ID, NodeID: "fetch", NodeType: "http_request", StepNumber: 1, Status: StepStatusPending, MaxAttempts: 3}
+	script := &WorkflowStep{ID: uuid.New(), RunID: run.ID, NodeID: "script", NodeType: "test_python", StepNumber: 2, Status: StepStatusPending, MaxAttempts: 3}
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	for _, step := range []*WorkflowStep{fetch, script} {
+		require.NoError(t, engine.insertStepTx(ctx, tx, step))
+		require.NoError(t, engine.enqueueItemTx(ctx, tx, &QueueItem{
+			ID:          uuid.New(),
+			RunID:       run.ID,
+			StepID:      &step.ID,
+			QueueType:   QueueTypeExecuteStep,
+			Priority:    5,
+			AvailableAt: time.Now().Add(-time.Second),
+			MaxAttempts: 3,
+		}))
+	}
+	require.NoError(t, tx.Commit())
+
+	workers := []*WorkflowWorker{
+		{ID: "worker-any", Hostname: "host-any", Capabilities: []string{"*"}, Status: WorkerStatusIdle, MaxConcurrentSteps: 10},
+		{ID: "worker-fetch", Hostname: "host-fetch", Capabilities: []string{"http_request"}, Status: WorkerStatusIdle, MaxConcurrentSteps: 10},
+		{ID: "worker-python", Hostname: "host-python", Capabilities: []string{"test_python", "has-python"}, Status: WorkerStatusIdle, MaxConcurrentSteps: 10},
+	}
+	for _, worker := range workers {
+		require.NoError(t, engine.RegisterWorker(ctx, worker))
+	}
+
+	claimedSteps := func(workerID string) []uuid.UUID {
+		items, err := engine.ClaimWork(ctx, workerID, 100)
+		require.NoError(t, err)
+		var stepIDs []uuid.UUID
+		for _, item := range items {
+			if item.RunID == run.ID && item.StepID != nil {
+				stepIDs = append(stepIDs, *item.StepID)
+			}
+		}
+		return stepIDs
+	}
+
+	// A wildcard does not cover the label the Python node requires, and
+	// unregistered workers advertise nothing
+	assert.Empty(t, claimedSteps("worker-unregistered"))
+	assert.Equal(t, []uuid.UUID{fetch.ID}, claimedSteps("worker-any"))
+	assert.Empty(t, claimedSteps("worker-fetch"))
+	assert.Equal(t, []uuid.UUID{script.ID}, claimedSteps("worker-python"))
+}
+
+func testConcurrencyLimits(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+	require.NoError(t, engine.RegisterWorker(ctx, &WorkflowWorker{
+		ID: "worker-limits", Hostname: "host-limits", Capabilities: []string{"*"}, Status: WorkerStatusIdle, MaxConcurrentSteps: 100,
+	}))
+
+	limitedID, otherID := uuid.New(), uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO workflows (id, user_id, name, max_concurrent_runs, max_concurrent_steps)
+		VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Limited', 1, 1),
+		       ($2, '00000000-0000-0000-0000-000000000001', 'Other', NULL, NULL)`,
+		limitedID, otherID)
+	require.NoError(t, err)
+
+	startRun := func(workflowID uuid.UUID) *WorkflowRun {
+		run := &WorkflowRun{
+			ID:             uuid.New(),
+			WorkflowID:     &workflowID,
+			VersionID:      uuid.New(),
+			Status:         RunStatusPending,
+			InputData:      map[string]any{},
+			Variables:      map[string]any{},
+			TimeoutSeconds: 60,
+			RetryPolicy:    DefaultRetryPolicy(),
+		}
+		require.NoError(t, engine.StartRun(ctx, run))
+		return run
+	}
+	first, second, other := startRun(limitedID), startRun(limitedID), startRun(otherID)
+
+	claim := func() []*QueueItem {
+		items, err := engine.ClaimWork(ctx, "worker-limits", 100)
+		require.NoError(t, err)
+		var claimed []*QueueItem
+		for _, item := range items {
+			if item.RunID == first.ID || item.RunID == second.ID || item.RunID == other.ID {
+				claimed = append(claimed, item)
+			}
+		}
+		return claimed
+	}
+	runIDs := func(items []*QueueItem) []uuid.UUID {
+		var ids []uuid.UUID
+		for _, item := range items {
+			ids = append(ids, item.RunID)
+		}
+		return ids
+	}
+
+	// Only one run of the limited workflow starts, the other workflow is not held back
+	claimed := claim()
+	assert.ElementsMatch(t, []uuid.UUID{first.ID, other.ID}, runIDs(claimed))
+
+	// The held back run stays pending instead of timing out
+	_, err = db.Exec(`UPDATE workflow_runs SET created_at = NOW() - INTERVAL '1 hour' WHERE id = $1`, second.ID)
+	require.NoError(t, err)
+	timedOut, err := engine.TimeoutRuns(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, timedOut, second.ID)
+
+	// Only one step of the limited workflow executes at a time
+	_, err = db.Exec(`UPDATE workflow_runs SET status = 'running', started_at = NOW() WHERE id = $1`, first.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`DELETE FROM workflow_queue WHERE run_id = $1`, first.ID)
+	require.NoError(t, err)
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	for i, nodeID := range []string{"a", "b"} {
+		step := &WorkflowStep{ID: uuid.New(), RunID: first.ID, NodeID: nodeID, NodeType: "noop", StepNumber: i + 1, Status: StepStatusPending, MaxAttempts: 3}
+		require.NoError(t, engine.insertStepTx(ctx, tx, step))
+		require.NoError(t, engine.enqueueItemTx(ctx, tx, &QueueItem{
+			ID:          uuid.New(),
+			RunID:       first.ID,
+			StepID:      &step.ID,
+			QueueType:   QueueTypeExecuteStep,
+			Priority:    5,
+			AvailableAt: time.Now().Add(-time.Second),
+			MaxAttempts: 3,
+		}))
+	}
+	require.NoError(t, tx.Commit())
+
+	claimed = claim()
+	require.Len(t, claimed, 1)
+	assert.Equal(t, QueueTypeExecuteStep, claimed[0].QueueType)
+	assert.Empty(t, claim())
+
+	// Once the first run finishes, the second one starts
+	_, err = db.Exec(`UPDATE workflow_runs SET status = 'completed', completed_at = NOW() WHERE id = $1`, first.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`DELETE FROM workflow_queue WHERE run_id = $1`, first.ID)
+	require.NoError(t, err)
+
+	claimed = claim()
+	assert.Equal(t, []uuid.UUID{second.ID}, runIDs(claimed))
+
+	// A running run whose start is still claimed counts once against the limit
+	pairID := uuid.New()
+	_, err = db.Exec(`
+		INSERT INTO workflows (id, user_id, name, max_concurrent_runs)
+		VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Pair', 2)`,
+		pairID)
+	require.NoError(t, err)
+	third, fourth, fifth := startRun(pairID), startRun(pairID), startRun(pairID)
+	pairClaim := func() []uuid.UUID {
+		items, err := engine.ClaimWork(ctx, "worker-limits", 100)
+		require.NoError(t, err)
+		var ids []uuid.UUID
+		for _, item := range items {
+			if item.RunID == third.ID || item.RunID == fourth.ID || item.RunID == fifth.ID {
+				ids = append(ids, item.RunID)
+			}
+		}
+		return ids
+	}
+	assert.ElementsMatch(t, []uuid.UUID{third.ID, fourth.ID}, pairClaim())
+
+	_, err = db.Exec(`UPDATE workflow_runs SET status = 'running', started_at = NOW() WHERE id = $1`, third.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`UPDATE workflow_runs SET status = 'completed', completed_at = NOW() WHERE id = $1`, fourth.ID)
+	require.NoError(t, err)
+	_, err = db.Exec(`DELETE FROM workflow_queue WHERE run_id = $1`, fourth.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []uuid.UUID{fifth.ID}, pairClaim())
+}
+
+func testDeadLetters(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+	workerID := "worker-dead-letters"
+	require.NoError(t, engine.RegisterWorker(ctx, &WorkflowWorker{
+		ID: workerID, Hostname: "host-dead-letters", Capabilities: []string{"*"}, Status: WorkerStatusIdle, MaxConcurrentSteps: 1,
+	}))
+
+	run := &WorkflowRun{
+		ID:             uuid.New(),
+		AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		VersionID:      uuid.New(),
+		Status:         RunStatusPending,
+		InputData:      map[string]any{},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 60,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+	require.NoError(t, engine.StartRun(ctx, run))
+
+	// Hand the start of the run to the worker, so other items do not interfere
+	require.NoError(t, engine.queue.RemoveRun(ctx, nil, run.ID))
+	claimItem := func(claimedAgo time.Duration, attempts int) uuid.UUID {
+		itemID := uuid.New()
+		_, err := db.Exec(`
+			INSERT INTO workflow_queue (id, run_id, queue_type, priority, claimed_by, claimed_at, attempt_count, max_attempts)
+			VALUES ($1, $2, 'start_run', 5, $3, NOW() - $4 * INTERVAL '1 second', $5, 3)`,
+			itemID, run.ID, workerID, int(claimedAgo.Seconds()), attempts)
+		require.NoError(t, err)
+		return itemID
+	}
+	runStatus := func() WorkflowRunStatus {
+		var status WorkflowRunStatus
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, run.ID).Scan(&status))
+		return status
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		itemID := claimItem(0, 2)
+		require.NoError(t, engine.CompleteWork(ctx, workerID, itemID, 0, &WorkResult{Success: false, Error: stringPtr("boom")}))
+		assert.Equal(t, RunStatusFailed, runStatus())
+
+		letter, err := engine.GetDeadLetter(ctx, itemID)
+		require.NoError(t, err)
+		assert.Equal(t, run.ID, letter.RunID)
+		assert.Equal(t, QueueTypeStartRun, letter.QueueType)
+		assert.Equal(t, "boom", *letter.LastError)
+		assert.Equal(t, workerID, *letter.WorkerID)
+
+		letters, total, err := engine.ListDeadLetters(ctx, &run.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, letters, 1)
+		assert.Equal(t, itemID, letters[0].ID)
+
+		// Requeueing resumes the run and queues the item again
+		require.NoError(t, engine.RequeueDeadLetter(ctx, itemID))
+		assert.Equal(t, RunStatusPending, runStatus())
+
+		var claimedBy *string
+		require.NoError(t, db.QueryRow(`SELECT claimed_by FROM workflow_queue WHERE id = $1`, itemID).Scan(&claimedBy))
+		assert.Nil(t, claimedBy)
+		_, err = engine.GetDeadLetter(ctx, itemID)
+		assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+
+		require.NoError(t, engine.queue.RemoveRun(ctx, nil, run.ID))
+	})
+
+	t.Run("FailureWithAttemptsLeft", func(t *testing.T) {
+		// Failures that are not retried before the attempts are used up,
+		// like validation errors, fail the run without a dead letter
+		itemID := claimItem(0, 0)
+		require.NoError(t, engine.CompleteWork(ctx, workerID, itemID, 0, &WorkResult{Success: false, Error: stringPtr("invalid")}))
+		assert.Equal(t, RunStatusFailed, runStatus())
+
+		_, err := engine.GetDeadLetter(ctx, itemID)
+		assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+	})
+
+	t.Run("ExhaustedClaim", func(t *testing.T) {
+		itemID := claimItem(10*time.Minute, 3)
+		require.NoError(t, engine.RecoverOrphanedWork(ctx, 5*time.Minute))
+
+		var queued int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM workflow_queue WHERE id = $1`, itemID).Scan(&queued))
+		assert.Zero(t, queued, "exhausted items leave the queue")
+
+		letter, err := engine.GetDeadLetter(ctx, itemID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, letter.AttemptCount)
+		assert.Equal(t, workerID, *letter.WorkerID)
+		assert.NotNil(t, letter.LastError)
+
+		require.NoError(t, engine.DiscardDeadLetter(ctx, itemID))
+		assert.ErrorIs(t, engine.DiscardDeadLetter(ctx, itemID), ErrDeadLetterNotFound)
+	})
+}
+
+func testLeaseFencing(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	run := &WorkflowRun{
+		ID:             uuid.New(),
+		AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		VersionID:      uuid.New(),
+		Status:         RunStatusPending,
+		InputData:      map[string]any{},
+		Variables:      map[string]any{},
+		TimeoutSeconds: 3600,
+		RetryPolicy:    DefaultRetryPolicy(),
+	}
+	require.NoError(t, engine.StartRun(ctx, run))
+	require.NoError(t, engine.queue.RemoveRun(ctx, nil, run.ID))
+	_, err := db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, run.ID)
+	require.NoError(t, err)
+
+	stepID := uuid.New()
+	_, err = db.Exec(`
+		INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on)
+		VALUES ($1, $2, 'slow', 'log', 1, 'pending', '{}')`, stepID, run.ID)
+	require.NoError(t, err)
+
+	t.Run("Step", func(t *testing.T) {
+		first, err := engine.PrepareStep(ctx, stepID, "worker-lease-1")
+		require.NoError(t, err)
+
+		// The first worker stops responding and the step is handed to another
+		_, err = db.Exec(`UPDATE workflow_steps SET worker_heartbeat = NOW() - INTERVAL '10 minutes' WHERE id = $1`, stepID)
+		require.NoError(t, err)
+		require.NoError(t, engine.RecoverOrphanedWork(ctx, 5*time.Minute))
+		assert.ErrorIs(t, engine.RenewStepLease(ctx, "worker-lease-1", stepID, first.LeaseEpoch), ErrLeaseLost)
+
+		second, err := engine.PrepareStep(ctx, stepID, "worker-lease-2")
+		require.NoError(t, err)
+		assert.Greater(t, second.LeaseEpoch, first.LeaseEpoch)
+		require.NoError(t, engine.RenewStepLease(ctx, "worker-lease-2", stepID, second.LeaseEpoch))
+
+		// Only the output of the current execution lands
+		_, err = engine.RecordStepResult(ctx, stepID, first.LeaseEpoch, &api.Envelope[any]{ID: "first"}, nil)
+		assert.ErrorIs(t, err, ErrLeaseLost)
+		_, err = engine.RecordStepResult(ctx, stepID, first.LeaseEpoch, nil, errors.New("boom"))
+		assert.ErrorIs(t, err, ErrLeaseLost)
+
+		result, err := engine.RecordStepResult(ctx, stepID, second.LeaseEpoch, &api.Envelope[any]{ID: "second"}, nil)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+
+		var outputJSON []byte
+		require.NoError(t, db.QueryRow(`SELECT output_envelope FROM workflow_steps WHERE id = $1`, stepID).Scan(&outputJSON))
+		var output api.Envelope[any]
+		require.NoError(t, json.Unmarshal(outputJSON, &output))
+		assert.Equal(t, "second", output.ID)
+	})
+
+	t.Run("QueueItem", func(t *testing.T) {
+		itemID := uuid.New()
+		_, err := db.Exec(`
+			INSERT INTO workflow_queue (id, run_id, step_id, queue_type, priority, claimed_by, claimed_at, lease_epoch)
+			VALUES ($1, $2, $3, 'execute_step', 5, 'worker-lease-2', NOW(), 2)`,
+			itemID, run.ID, stepID)
+		require.NoError(t, err)
+
+		err = engine.CompleteWork(ctx, "worker-lease-1", itemID, 2, &WorkResult{Success: true})
+		assert.ErrorIs(t, err, ErrLeaseLost)
+		err = engine.CompleteWork(ctx, "worker-lease-2", itemID, 1, &WorkResult{Success: false, Error: stringPtr("boom")})
+		assert.ErrorIs(t, err, ErrLeaseLost)
+
+		var status WorkflowRunStatus
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, run.ID).Scan(&status))
+		assert.Equal(t, RunStatusRunning, status, "stale results leave the run alone")
+
+		require.NoError(t, engine.CompleteWork(ctx, "worker-lease-2", itemID, 2, &WorkResult{Success: true}))
+		var queued int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM workflow_queue WHERE id = $1`, itemID).Scan(&queued))
+		assert.Zero(t, queued)
+	})
+}
+
+func testWorkflowCall(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	// The called workflow is deployed
+	definitionJSON := []byte(`{"nodes":[],"edges":[]}`)
+	workflowID, versionID := uuid.New(), uuid.New()
+	_, err := db.Exec(`INSERT INTO workflows (id, user_id, name, definition) VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Callee', $2)`,
+		workflowID, definitionJSON)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO workflow_versions (id, workflow_id, version_number, name, definition, is_current) VALUES ($1, $2, 1, 'v1', $3, true)`,
+		versionID, workflowID, definitionJSON)
+	require.NoError(t, err)
+
+	// startRun starts a running run with a running step of the given type,
+	// followed by a pending step
+	startRun := func(nodeType string) (runID, stepID, nextStepID uuid.UUID) {
+		run := &WorkflowRun{
+			ID:             uuid.New(),
+			AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			VersionID:      uuid.New(),
+			Status:         RunStatusPending,
+			InputData:      map[string]any{},
+			Variables:      map[string]any{},
+			TimeoutSeconds: 3600,
+			RetryPolicy:    DefaultRetryPolicy(),
+		}
+		require.NoError(t, engine.StartRun(ctx, run))
+		_, err := db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, run.ID)
+		require.NoError(t, err)
+
+		stepID, nextStepID = uuid.New(), uuid.New()
+		_, err = db.Exec(`
+			INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on)
+			VALUES ($1, $3, 'first', $4, 1, 'running', '{}'), ($2, $3, 'next', 'log', 2, 'pending', ARRAY[$1]::uuid[])`,
+			stepID, nextStepID, run.ID, nodeType)
+		require.NoError(t, err)
+		return run.ID, stepID, nextStepID
+	}
+
+	callOutput := func(target string, sync bool) *api.Envelope[any] {
+		output := &api.Envelope[any]{ID: "call", DataType: "object", Data: map[string]any{"order": float64(42)}}
+		output.SetMeta(api.MetaCallWorkflow, target)
+		if sync {
+			output.SetMeta(api.MetaWaitSignal, api.WorkflowReturnSignal)
+			output.SetMeta(api.MetaWaitUntil, time.Now().Add(10*time.Minute).UTC().Format(time.RFC3339Nano))
+		}
+		return output
+	}
+
+	childRun := func(parentStepID uuid.UUID) (uuid.UUID, *WorkflowRun) {
+		var childID uuid.UUID
+		require.NoError(t, db.QueryRow(`SELECT id FROM workflow_runs WHERE parent_step_id = $1`, parentStepID).Scan(&childID))
+		child, err := engine.loadWorkflowRun(ctx, childID)
+		require.NoError(t, err)
+		return childID, child
+	}
+
+	stepOutput := func(stepID uuid.UUID) (StepStatus, api.Envelope[any]) {
+		var status StepStatus
+		var outputJSON []byte
+		require.NoError(t, db.QueryRow(`SELECT status, output_envelope FROM workflow_steps WHERE id = $1`, stepID).Scan(&status, &outputJSON))
+		var output api.Envelope[any]
+		require.NoError(t, json.Unmarshal(outputJSON, &output))
+		return status, output
+	}
+
+	t.Run("Sync", func(t *testing.T) {
+		runID, callStepID, nextStepID := startRun("workflow_call")
+
+		result, err := engine.RecordStepResult(ctx, callStepID, 0, callOutput(workflowID.String(), true), nil)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Empty(t, result.NextSteps)
+
+		// The call waits for the child run, which starts with the call data
+		status, parked := stepOutput(callStepID)
+		assert.Equal(t, StepStatusWaiting, status)
+		childID, child := childRun(callStepID)
+		assert.Equal(t, RunStatusPending, child.Status)
+		assert.Equal(t, versionID, child.VersionID)
+		assert.Equal(t, map[string]any{"order": float64(42)}, child.InputData)
+		assert.InDelta(t, 600, child.TimeoutSeconds, 5, "The child should run no longer than the call waits")
+		recorded, _ := parked.GetMeta(api.MetaChildRunID)
+		assert.Equal(t, childID.String(), recorded)
+
+		var runStatus WorkflowRunStatus
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, runID).Scan(&runStatus))
+		assert.Equal(t, RunStatusPaused, runStatus)
+
+		// The child returns from a step of its own
+		_, err = db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, childID)
+		require.NoError(t, err)
+		returnStepID := uuid.New()
+		_, err = db.Exec(`
+			INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, depends_on)
+			VALUES ($1, $2, 'return', 'workflow_return', 1, 'running', '{}')`, returnStepID, childID)
+		require.NoError(t, err)
+
+		returned := &api.Envelope[any]{ID: "return", DataType: "object", Data: map[string]any{"total": float64(99)}}
+		returned.SetMeta(api.MetaReturnStatus, "success")
+		_, err = engine.RecordStepResult(ctx, returnStepID, 0, returned, nil)
+		require.NoError(t, err)
+
+		// The parent continues with the returned data
+		status, output := stepOutput(callStepID)
+		assert.Equal(t, StepStatusCompleted, status)
+		assert.Equal(t, map[string]any{"total": float64(99)}, output.Data)
+		require.NoError(t, db.QueryRow(`SELECT status FROM workflow_runs WHERE id = $1`, runID).Scan(&runStatus))
+		assert.Equal(t, RunStatusRunning, runStatus)
+
+		var queued int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM workflow_queue WHERE step_id = $1`, nextStepID).Scan(&queued))
+		assert.Equal(t, 1, queued)
+	})
+
+	t.Run("Async", func(t *testing.T) {
+		_, callStepID, nextStepID := startRun("workflow_call")
+
+		result, err := engine.RecordStepResult(ctx, callStepID, 0, callOutput(workflowID.String(), false), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{nextStepID}, result.NextSteps)
+
+		// The call completes right away, without calling again downstream
+		status, output := stepOutput(callStepID)
+		assert.Equal(t, StepStatusCompleted, status)
+		childID, _ := childRun(callStepID)
+		recorded, _ := output.GetMeta(api.MetaChildRunID)
+		assert.Equal(t, childID.String(), recorded)
+		_, calling := output.GetMeta(api.MetaCallWorkflow)
+		assert.False(t, calling)
+	})
+
+	t.Run("UndeployedWorkflow", func(t *testing.T) {
+		_, callStepID, _ := startRun("workflow_call")
+
+		result, err := engine.RecordStepResult(ctx, callStepID, 0, callOutput(uuid.New().String(), true), nil)
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+
+		var children int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM workflow_runs WHERE parent_step_id = $1`, callStepID).Scan(&children))
+		assert.Zero(t, children)
+	})
+
+	// callChild starts a sync call from a step with the given error mode and
+	// returns the IDs of the run, the call step and the running child run
+	callChild := func(mode ErrorMode) (runID, callStepID, childID uuid.UUID) {
+		runID, callStepID, _ = startRun("workflow_call")
+		_, err := db.Exec(`UPDATE workflow_steps SET node_config = $2 WHERE id = $1`,
+			callStepID, fmt.Sprintf(`{"%s": "%s"}`, NodeConfigOnError, mode))
+		require.NoError(t, err)
+
+		_, err = engine.RecordStepResult(ctx, callStepID, 0, callOutput(workflowID.String(), true), nil)
+		require.NoError(t, err)
+		childID, _ = childRun(callStepID)
+		_, err = db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, childID)
+		require.NoError(t, err)
+		return runID, callStepID, childID
+	}
+
+	t.Run("FailingChild", func(t *testing.T) {
+		runID, callStepID, childID := callChild(ErrorModeStop)
+
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback()
+		message := "payment declined"
+		require.NoError(t, engine.failRunTx(ctx, tx, childID, nil, &message, api.ErrorCodeUpstream))
+		require.NoError(t, tx.Commit())
+
+		// The call fails with the error of the child, and so does its run
+		var stepStatus StepStatus
+		var errorJSON []byte
+		require.NoError(t, db.QueryRow(`SELECT status, error_details FROM workflow_steps WHERE id = $1`, callStepID).Scan(&stepStatus, &errorJSON))
+		assert.Equal(t, StepStatusFailed, stepStatus)
+		assert.Contains(t, string(errorJSON), "payment declined")
+
+		var runStatus WorkflowRunStatus
+		var runErrorJSON []byte
+		require.NoError(t, db.QueryRow(`SELECT status, error_data FROM workflow_runs WHERE id = $1`, runID).Scan(&runStatus, &runErrorJSON))
+		assert.Equal(t, RunStatusFailed, runStatus)
+		var runError map[string]any
+		require.NoError(t, json.Unmarshal(runErrorJSON, &runError))
+		assert.Contains(t, runError["error"], "payment declined")
+		assert.Equal(t, api.ErrorCodeUpstream, runError["code"])
+		assert.Equal(t, callStepID.String(), runError["step_id"])
+	})
+
+	t.Run("CancelledChild", func(t *testing.T) {
+		runID, callStepID, childID := callChild(ErrorModeErrorOutput)
+
+		require.NoError(t, engine.CancelRun(ctx, childID))
+
+		// The call continues on its error output
+		status, output := stepOutput(callStepID)
+		assert.Equal(t, StepStatusCompleted, status)
+		require.Len(t, output.Errors, 1)
+		assert.Contains(t, output.Errors[0].Message, "called workflow was cancelled")
+
+		var branch sql.NullString
+		require.NoError(t, db.QueryRow(`SELECT selected_branch FROM workflow_steps WHERE id = $1`, callStepID).Scan(&branch))
+		assert.Equal(t, api.ErrorBranch, branch.String)
+
+		run, err := engine.loadWorkflowRun(ctx, runID)
+		require.NoError(t, err)
+		assert.Equal(t, RunStatusRunning, run.Status)
+	})
+
+	t.Run("ChildWithoutReturn", func(t *testing.T) {
+		runID, callStepID, childID := callChild(ErrorModeStop)
+
+		require.NoError(t, engine.FinalizeRun(ctx, childID))
+
+		// The call continues with the output of the child
+		status, output := stepOutput(callStepID)
+		assert.Equal(t, StepStatusCompleted, status)
+		assert.Equal(t, map[string]any{}, output.Data)
+
+		run, err := engine.loadWorkflowRun(ctx, runID)
+		require.NoError(t, err)
+		assert.Equal(t, RunStatusRunning, run.Status)
+	})
+}
+
+func testIdempotentStart(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	workflowID := uuid.New()
+	_, err := db.Exec(`INSERT INTO workflows (id, user_id, name, definition) VALUES ($1, '00000000-0000-0000-0000-000000000001', 'Idempotent', '{}')`,
+		workflowID)
+	require.NoError(t, err)
+
+	start := func(key string) *WorkflowRun {
+		run := &WorkflowRun{
+			ID:             uuid.New(),
+			WorkflowID:     &workflowID,
+			VersionID:      uuid.New(),
+			Status:         RunStatusPending,
+			InputData:      map[string]any{"key": key},
+			Variables:      map[string]any{},
+			TimeoutSeconds: 3600,
+			RetryPolicy:    DefaultRetryPolicy(),
+			IdempotencyKey: &key,
+		}
+		assert.NoError(t, engine.StartRun(ctx, run))
+		return run
+	}
+
+	countRuns := func() int {
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM workflow_runs WHERE workflow_id = $1`, workflowID).Scan(&count))
+		return count
+	}
+
+	first := start("delivery-1")
+	repeated := start("delivery-1")
+	assert.Equal(t, first.ID, repeated.ID, "a repeated start returns the existing run")
+	assert.Equal(t, "delivery-1", *repeated.IdempotencyKey)
+	assert.Equal(t, 1, countRuns())
+
+	// Concurrent repeats start a single run
+	var wg sync.WaitGroup
+	ids := make([]uuid.UUID, 5)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i] = start("delivery-2").ID
+		}(i)
+	}
+	wg.Wait()
+	for _, id := range ids {
+		assert.Equal(t, ids[0], id)
+	}
+	assert.Equal(t, 2, countRuns())
+
+	// Empty keys count as no key
+	unkeyed := start("")
+	assert.Nil(t, unkeyed.IdempotencyKey)
+	assert.NotEqual(t, unkeyed.ID, start("").ID)
+	assert.Equal(t, 4, countRuns())
+
+	// Keys are no longer honored after the retention window
+	engine.SetIdempotencyRetention(0)
+	defer engine.SetIdempotencyRetention(DefaultIdempotencyRetention)
+	assert.NotEqual(t, first.ID, start("delivery-1").ID)
+	assert.Equal(t, 5, countRuns())
+}
+
+func testRunOutput(t *testing.T, engine *DurableExecutionEngine, db *sql.DB) {
+	ctx := context.Background()
+
+	// finishRun finishes a run whose steps of the given types completed in
+	// order, each with its type as data
+	finishRun := func(nodeTypes ...string) map[string]any {
+		run := &WorkflowRun{
+			ID:             uuid.New(),
+			AgentID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			VersionID:      uuid.New(),
+			Status:         RunStatusPending,
+			InputData:      map[string]any{},
+			Variables:      map[string]any{},
+			TimeoutSeconds: 3600,
+			RetryPolicy:    DefaultRetryPolicy(),
+		}
+		require.NoError(t, engine.StartRun(ctx, run))
+		_, err := db.Exec(`UPDATE workflow_runs SET status = 'running' WHERE id = $1`, run.ID)
+		require.NoError(t, err)
+
+		for i, nodeType := range nodeTypes {
+			output, _ := json.Marshal(api.Envelope[any]{ID: nodeType, DataType: "string", Data: nodeType})
+			_, err := db.Exec(`
+				INSERT INTO workflow_steps (id, run_id, node_id, node_type, step_number, status, output_envelope, completed_at)
+				VALUES ($1, $2, $3, $4, $5, 'completed', $6, NOW() + $5 * INTERVAL '1 second')`,
+				uuid.New(), run.ID, fmt.Sprintf("node-%d", i), nodeType, i+1, output)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, engine.FinalizeRun(ctx, run.ID))
+
+		var outputJSON []byte
+		require.NoError(t, db.QueryRow(`SELECT output_data FROM workflow_runs WHERE id = $1`, run.ID).Scan(&outputJSON))
+		if outputJSON == nil {
+			return nil
+		}
+		var output map[string]any
+		require.NoError(t, json.Unmarshal(outputJSON, &output))
+		return output
+	}
+
+	assert.Equal(t, map[string]any{"data": "log"}, finishRun("transform", "log"), "the last node returns the output")
+	assert.Equal(t, map[string]any{"data": "workflow_return"}, finishRun("workflow_return", "log"), "workflow_return takes precedence")
+	assert.Nil(t, finishRun(), "runs without outputs return none")
 }
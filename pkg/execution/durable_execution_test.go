@@ -1111,3 +1111,27 @@ func TestEndToEndWorkflowExecution(t *testing.T) {
 	t.Logf("   - Workflow state properly persisted throughout execution")
 	t.Logf("   - This proves the complete durable execution system works! ✨")
 }
+
+func TestRequiredCapabilities(t *testing.T) {
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(pythonNode{})
+	mel.RegisterNodeDefinition(echoNode{})
+	engine := NewDurableExecutionEngine(nil, mel, "test-worker")
+
+	assert.Equal(t, []string{"has-python"}, engine.requiredCapabilities("test_python"))
+	assert.Equal(t, []string{}, engine.requiredCapabilities("test_echo"))
+	assert.Equal(t, []string{}, engine.requiredCapabilities("unknown"))
+}
+
+// TestWaitForWorkWithoutListener tests that waiting for work falls back to polling
+func TestWaitForWorkWithoutListener(t *testing.T) {
+	engine := NewDurableExecutionEngine(nil, api.NewMel(), "test-worker")
+
+	started := time.Now()
+	require.NoError(t, engine.WaitForWork(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(started), waitForWorkInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, engine.WaitForWork(ctx), context.Canceled)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
@@ -18,35 +19,142 @@ type DurableExecutionEngine struct {
 	db       *sql.DB
 	mel      api.Mel
 	workerID string
+
+	// queue holds the work items until workers claim them
+	queue Queue
+
+	// idempotencyRetention is how long the idempotency key of a run
+	// deduplicates starts of its workflow
+	idempotencyRetention time.Duration
 }
 
+// DefaultIdempotencyRetention is how long idempotency keys of runs are
+// retained unless configured otherwise
+const DefaultIdempotencyRetention = 24 * time.Hour
+
 // NewDurableExecutionEngine creates a new durable execution engine
 func NewDurableExecutionEngine(db *sql.DB, mel api.Mel, workerID string) *DurableExecutionEngine {
 	return &DurableExecutionEngine{
 		db:       db,
 		mel:      mel,
 		workerID: workerID,
+		queue:    NewPostgresQueue(db),
+
+		idempotencyRetention: DefaultIdempotencyRetention,
 	}
 }
 
-// StartRun initiates a new workflow run
+// SetQueue replaces the queue work items are stored in, which defaults to
+// the workflow_queue table
+func (e *DurableExecutionEngine) SetQueue(queue Queue) {
+	e.queue = queue
+}
+
+// SetIdempotencyRetention sets how long the idempotency key of a run
+// deduplicates starts of its workflow
+func (e *DurableExecutionEngine) SetIdempotencyRetention(retention time.Duration) {
+	e.idempotencyRetention = retention
+}
+
+// StartRun initiates a new workflow run. When a run of the same workflow was
+// started with the idempotency key of the run within the retention window,
+// no run is started and run is replaced by the existing one. Empty keys count
+// as no key.
 func (e *DurableExecutionEngine) StartRun(ctx context.Context, run *WorkflowRun) error {
+	if run.IdempotencyKey != nil && *run.IdempotencyKey == "" {
+		run.IdempotencyKey = nil
+	}
+
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if run.IdempotencyKey != nil {
+		existingID, err := e.findIdempotentRunTx(ctx, tx, run)
+		if err != nil {
+			return err
+		}
+		if existingID != uuid.Nil {
+			existing, err := e.loadWorkflowRun(ctx, existingID)
+			if err != nil {
+				return fmt.Errorf("failed to load existing run: %w", err)
+			}
+			*run = *existing
+			return nil
+		}
+	}
+
+	if err := e.createRunTx(ctx, tx, run); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// findIdempotentRunTx returns the run of the workflow of run that was
+// started with the same idempotency key within the retention window, or
+// uuid.Nil. Starts with the same key are serialized until the transaction
+// ends, so that concurrent duplicates find the run the first one created.
+func (e *DurableExecutionEngine) findIdempotentRunTx(ctx context.Context, tx *sql.Tx, run *WorkflowRun) (uuid.UUID, error) {
+	// Runs are scoped to their workflow, or to their agent if they have none
+	scope := run.AgentID
+	if run.WorkflowID != nil {
+		scope = *run.WorkflowID
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`,
+		scope.String()+":"+*run.IdempotencyKey); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to lock idempotency key: %w", err)
+	}
+
+	query := `
+		SELECT id FROM workflow_runs
+		WHERE idempotency_key = $1
+		  AND COALESCE(workflow_id, agent_id) = $2
+		  AND created_at > $3
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	var existingID uuid.UUID
+	err := tx.QueryRowContext(ctx, query, *run.IdempotencyKey, scope,
+		time.Now().Add(-e.idempotencyRetention)).Scan(&existingID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to find run by idempotency key: %w", err)
+	}
+	return existingID, nil
+}
+
+// createRunTx inserts a workflow run and queues it for execution
+func (e *DurableExecutionEngine) createRunTx(ctx context.Context, tx *sql.Tx, run *WorkflowRun) error {
 	// Insert the workflow run
 	query := `
 		INSERT INTO workflow_runs (
-			id, agent_id, version_id, trigger_id, status, input_data, 
-			variables, timeout_seconds, retry_policy
+			id, agent_id, workflow_id, version_id, trigger_id, status, input_data, 
+			variables, timeout_seconds, retry_policy, error_source_run_id, replay_source_run_id,
+			parent_run_id, parent_step_id, idempotency_key
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 		)`
 
 	inputDataJSON, _ := json.Marshal(run.InputData)
 	variablesJSON, _ := json.Marshal(run.Variables)
 	retryPolicyJSON, _ := json.Marshal(run.RetryPolicy)
 
-	if _, err := e.db.ExecContext(ctx, query,
-		run.ID, run.AgentID, run.VersionID, run.TriggerID, run.Status,
-		inputDataJSON, variablesJSON, run.TimeoutSeconds, retryPolicyJSON); err != nil {
+	// Workflow runs are not owned by an agent
+	var agentID *uuid.UUID
+	if run.AgentID != uuid.Nil {
+		agentID = &run.AgentID
+	}
+
+	if _, err := tx.ExecContext(ctx, query,
+		run.ID, agentID, run.WorkflowID, run.VersionID, run.TriggerID, run.Status,
+		inputDataJSON, variablesJSON, run.TimeoutSeconds, retryPolicyJSON, run.ErrorSourceRunID,
+		run.ReplaySourceRunID, run.ParentRunID, run.ParentStepID, run.IdempotencyKey); err != nil {
 		return fmt.Errorf("failed to create workflow run: %w", err)
 	}
 
@@ -61,21 +169,11 @@ func (e *DurableExecutionEngine) StartRun(ctx context.Context, run *WorkflowRun)
 		Payload:     map[string]any{},
 	}
 
-	return e.enqueueItem(ctx, queueItem)
+	return e.enqueueItemTx(ctx, tx, queueItem)
 }
 
 // ExecuteStep executes a single workflow step
 func (e *DurableExecutionEngine) ExecuteStep(ctx context.Context, step *WorkflowStep) (*api.Envelope[any], error) {
-	// Update step status to running
-	if err := e.updateStepStatus(ctx, step.ID, StepStatusRunning, nil); err != nil {
-		return nil, fmt.Errorf("failed to update step status: %w", err)
-	}
-
-	// Create checkpoint before execution
-	if err := e.createCheckpoint(ctx, step.RunID, step.ID, "pre_execution", nil); err != nil {
-		log.Printf("Warning: failed to create pre-execution checkpoint: %v", err)
-	}
-
 	// Find the node definition
 	nodeDef := e.mel.FindDefinition(step.NodeType)
 	if nodeDef == nil {
@@ -96,132 +194,407 @@ func (e *DurableExecutionEngine) ExecuteStep(ctx context.Context, step *Workflow
 		Data: step.NodeConfig,
 	}
 
-	// Execute the node
-	outputEnvelope, err := nodeDef.ExecuteEnvelope(execCtx, node, step.InputEnvelope)
+	settings, err := ParseNodeSettings(step.NodeConfig)
 	if err != nil {
-		// Handle execution error
-		errorDetails := map[string]any{
-			"error":     err.Error(),
-			"attempt":   step.AttemptCount + 1,
-			"timestamp": time.Now(),
+		return nil, api.NewNodeErrorWithCause(step.NodeID, step.NodeType, err.Error(), api.ErrorCodeValidation, err)
+	}
+
+	// Execute the node within its timeout. The outcome is persisted by RecordStepResult.
+	return executeNode(ctx, nodeDef, execCtx, node, step.InputEnvelope, settings.Timeout())
+}
+
+// InitializeRun creates the steps of a run from its workflow definition and
+// returns the steps that are ready to be executed
+func (e *DurableExecutionEngine) InitializeRun(ctx context.Context, runID uuid.UUID, workerID string) ([]uuid.UUID, error) {
+	run, err := e.loadWorkflowRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.Status.IsTerminal() {
+		return nil, ErrRunNotActive
+	}
+
+	graph, err := e.loadWorkflowGraph(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow graph: %w", err)
+	}
+
+	steps, err := e.createWorkflowSteps(ctx, run, graph, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// For a fresh run the ready steps are the entry points; a recovered run
+	// continues where it left off.
+	byID := make(map[uuid.UUID]*WorkflowStep, len(steps))
+	for _, step := range steps {
+		byID[step.ID] = step
+	}
+
+	var nextSteps []uuid.UUID
+	for _, step := range steps {
+		if step.Status != StepStatusPending {
+			continue
 		}
 
-		if err := e.updateStepError(ctx, step.ID, errorDetails); err != nil {
-			log.Printf("Failed to update step error: %v", err)
+		live, resolved := dependencyState(step, byID)
+		if resolved && (len(step.DependsOn) == 0 || len(live) > 0) {
+			nextSteps = append(nextSteps, step.ID)
 		}
+	}
+
+	return nextSteps, nil
+}
 
-		return nil, fmt.Errorf("step execution failed: %w", err)
+// PrepareStep loads a step for execution, resolves its input envelope and
+// assigns it to the worker under a new lease epoch. It returns
+// ErrRunNotActive, ErrStepFinished or ErrStepNotReady when the step should not
+// be executed right now.
+func (e *DurableExecutionEngine) PrepareStep(ctx context.Context, stepID uuid.UUID, workerID string) (*WorkflowStep, error) {
+	step, err := loadWorkflowStep(ctx, e.db, stepID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update step with successful output
-	if err := e.updateStepOutput(ctx, step.ID, outputEnvelope); err != nil {
-		return nil, fmt.Errorf("failed to update step output: %w", err)
+	var runStatus WorkflowRunStatus
+	if err := e.db.QueryRowContext(ctx, `SELECT status FROM workflow_runs WHERE id = $1`, step.RunID).Scan(&runStatus); err != nil {
+		return nil, fmt.Errorf("failed to load run status: %w", err)
 	}
 
-	// Create checkpoint after execution
-	if err := e.createCheckpoint(ctx, step.RunID, step.ID, "post_execution", outputEnvelope); err != nil {
-		log.Printf("Warning: failed to create post-execution checkpoint: %v", err)
+	if runStatus != RunStatusRunning {
+		return nil, ErrRunNotActive
+	}
+	if step.Status == StepStatusCompleted || step.Status == StepStatusSkipped || step.Status == StepStatusWaiting {
+		return nil, ErrStepFinished
 	}
 
-	return outputEnvelope, nil
-}
+	live, resolved, err := e.liveDependencies(ctx, step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check dependencies: %w", err)
+	}
+	if !resolved {
+		return nil, ErrStepNotReady
+	}
+	if len(step.DependsOn) > 0 && len(live) == 0 {
+		// No dependency routed to the step, it is skipped
+		return nil, ErrStepFinished
+	}
 
-// ClaimWork claims available work items for a worker
-func (e *DurableExecutionEngine) ClaimWork(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error) {
-	tx, err := e.db.BeginTx(ctx, nil)
+	// Build the input from the outputs of the dependencies that routed to the step
+	if err := resolveStepInput(ctx, e.db, step, live); err != nil {
+		return nil, fmt.Errorf("failed to resolve step input: %w", err)
+	}
+
+	// Update step status to running under a new lease, which fences off
+	// any execution of the step that is still in flight elsewhere
+	lease, err := e.assignStep(ctx, step.ID, workerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to update step status: %w", err)
 	}
-	defer tx.Rollback()
+	step.Status = StepStatusRunning
+	step.LeaseEpoch = lease
 
-	// Find available work items
-	query := `
-		SELECT id, run_id, step_id, queue_type, priority, available_at, 
-		       created_at, attempt_count, max_attempts, payload
-		FROM workflow_queue 
-		WHERE claimed_by IS NULL 
-		  AND available_at <= NOW()
-		ORDER BY priority ASC, created_at ASC
-		LIMIT $1
-		FOR UPDATE SKIP LOCKED`
+	// Create checkpoint before execution
+	if err := e.createCheckpoint(ctx, step.RunID, step.ID, "pre_execution", nil); err != nil {
+		log.Printf("Warning: failed to create pre-execution checkpoint: %v", err)
+	}
 
-	rows, err := tx.QueryContext(ctx, query, maxItems)
+	return step, nil
+}
+
+// RecordStepResult persists the outcome of a step execution. On success the
+// returned result lists the steps that became ready; on failure it carries
+// the retry decision according to the retry policy of the run. Results for
+// runs that are no longer active are discarded with ErrRunNotActive, results
+// of executions whose lease is not the current one of the step with
+// ErrLeaseLost.
+func (e *DurableExecutionEngine) RecordStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *api.Envelope[any], stepErr error) (*WorkResult, error) {
+	step, err := loadWorkflowStep(ctx, e.db, stepID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find work items: %w", err)
+		return nil, err
+	}
+	if step.LeaseEpoch != lease {
+		return nil, ErrLeaseLost
 	}
-	defer rows.Close()
 
-	var items []*QueueItem
-	for rows.Next() {
-		var item QueueItem
-		var payloadJSON []byte
+	run, err := e.loadWorkflowRun(ctx, step.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run: %w", err)
+	}
+	if run.Status.IsTerminal() {
+		return nil, ErrRunNotActive
+	}
 
-		err := rows.Scan(
-			&item.ID, &item.RunID, &item.StepID, &item.QueueType, &item.Priority,
-			&item.AvailableAt, &item.CreatedAt, &item.AttemptCount, &item.MaxAttempts,
-			&payloadJSON,
-		)
-		if err != nil {
-			continue
+	if stepErr == nil {
+		stepErr = e.checkWorkflowCall(ctx, step, output)
+	}
+
+	if stepErr != nil {
+		attempt := step.AttemptCount + 1
+		errorDetails := map[string]any{
+			"error":     stepErr.Error(),
+			"attempt":   attempt,
+			"timestamp": time.Now(),
+		}
+		if code := api.ErrorCode(stepErr); code != "" {
+			errorDetails["code"] = code
+		}
+
+		if err := e.updateStepError(ctx, step.ID, lease, errorDetails); err != nil {
+			if err == ErrLeaseLost {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to update step error: %w", err)
+		}
+
+		// Retry until the step runs out of attempts, honouring the retry
+		// settings of the node
+		settings, _ := ParseNodeSettings(step.NodeConfig)
+		policy := run.RetryPolicy
+		if step.MaxAttempts > 0 {
+			policy.MaxAttempts = step.MaxAttempts
 		}
+		policy = settings.RetryPolicy(policy)
 
-		if len(payloadJSON) > 0 {
-			json.Unmarshal(payloadJSON, &item.Payload)
+		result := &WorkResult{
+			Success:     false,
+			Error:       stringPtr(fmt.Sprintf("step execution failed: %v", stepErr)),
+			ShouldRetry: policy.IsRetryable(stepErr, attempt),
+		}
+		if result.ShouldRetry {
+			result.RetryDelay = durationPtr(policy.CalculateRetryDelay(attempt - 1))
+			return result, nil
+		}
+
+		// Out of retries: the error mode of the node decides whether the
+		// run fails or the workflow handles the error
+		mode := settings.ErrorMode()
+		if mode == ErrorModeStop {
+			return result, nil
 		}
 
-		items = append(items, &item)
+		return e.completeStep(ctx, step, errorEnvelope(step, stepErr), e.failedBranch(step, mode))
 	}
 
-	if len(items) == 0 {
-		return items, nil
+	return e.completeStep(ctx, step, output, e.selectedBranch(step, output))
+}
+
+// completeStep stores the output of a step and returns the successors that
+// became ready
+func (e *DurableExecutionEngine) completeStep(ctx context.Context, step *WorkflowStep, output *api.Envelope[any], branch *string) (*WorkResult, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	// Claim the items
-	itemIDs := make([]uuid.UUID, len(items))
-	for i, item := range items {
-		itemIDs[i] = item.ID
-		item.ClaimedBy = &workerID
-		now := time.Now()
-		item.ClaimedAt = &now
+	// Serialize routing per run so that concurrently finishing dependencies
+	// see each other's results
+	var status WorkflowRunStatus
+	if err := tx.QueryRowContext(ctx,
+		`SELECT status FROM workflow_runs WHERE id = $1 FOR UPDATE`, step.RunID).Scan(&status); err != nil {
+		return nil, fmt.Errorf("failed to lock run: %w", err)
+	}
+	if status.IsTerminal() {
+		return nil, ErrRunNotActive
 	}
 
-	// Update claimed items
-	claimQuery := `
-		UPDATE workflow_queue 
-		SET claimed_by = $1, claimed_at = NOW()
-		WHERE id = ANY($2)`
+	// The step may have been handed to another worker since it was loaded
+	var lease int64
+	if err := tx.QueryRowContext(ctx,
+		`SELECT lease_epoch FROM workflow_steps WHERE id = $1 FOR UPDATE`, step.ID).Scan(&lease); err != nil {
+		return nil, fmt.Errorf("failed to lock step: %w", err)
+	}
+	if lease != step.LeaseEpoch {
+		return nil, ErrLeaseLost
+	}
 
-	if _, err := tx.ExecContext(ctx, claimQuery, workerID, pq.Array(itemIDs)); err != nil {
-		return nil, fmt.Errorf("failed to claim work items: %w", err)
+	// Nodes calling a workflow start it as a child run of the step, and
+	// nodes waiting for a signal park the step until it is sent
+	if output != nil {
+		if target, ok := output.GetMeta(api.MetaCallWorkflow); ok {
+			if err := e.startChildRunTx(ctx, tx, step, output, target); err != nil {
+				return nil, err
+			}
+		}
+		if signal, ok := output.GetMeta(api.MetaWaitSignal); ok {
+			if err := e.parkStepTx(ctx, tx, step, output, signal); err != nil {
+				return nil, err
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, fmt.Errorf("failed to commit step result: %w", err)
+			}
+			return &WorkResult{Success: true, OutputData: map[string]any{"envelope": output}}, nil
+		}
+	}
+
+	if err := e.updateStepOutputTx(ctx, tx, step.ID, output, branch); err != nil {
+		return nil, fmt.Errorf("failed to update step output: %w", err)
+	}
+
+	// Splitters fan their items out into per-item steps before routing
+	finished := []uuid.UUID{step.ID}
+	if step.NodeType == core.SplitterNodeType && step.Status != StepStatusCompleted {
+		skipped, err := e.expandSplitTx(ctx, tx, step, output)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand split: %w", err)
+		}
+		finished = append(finished, skipped...)
+	}
+
+	nextSteps, err := e.routeSuccessorsTx(ctx, tx, step.RunID, finished...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find next steps: %w", err)
+	}
+
+	// Nodes returning to the calling workflow resume the step that waits
+	// for the run
+	if output != nil {
+		if _, ok := output.GetMeta(api.MetaReturnStatus); ok {
+			if err := e.returnToParentTx(ctx, tx, step.RunID, output); err != nil {
+				return nil, fmt.Errorf("failed to return to parent run: %w", err)
+			}
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit claim transaction: %w", err)
+		return nil, fmt.Errorf("failed to commit step result: %w", err)
 	}
 
-	return items, nil
+	// Create checkpoint after execution
+	if err := e.createCheckpoint(ctx, step.RunID, step.ID, "post_execution", output); err != nil {
+		log.Printf("Warning: failed to create post-execution checkpoint: %v", err)
+	}
+
+	return &WorkResult{
+		Success:    true,
+		OutputData: map[string]any{"envelope": output},
+		NextSteps:  nextSteps,
+	}, nil
+}
+
+// errorEnvelope builds the output of a step that failed and continues the
+// workflow: its input with the error appended to the envelope errors
+func errorEnvelope(step *WorkflowStep, stepErr error) *api.Envelope[any] {
+	var output *api.Envelope[any]
+	if step.InputEnvelope != nil {
+		output = step.InputEnvelope.Clone()
+		output.ID = core.GenerateEnvelopeID()
+	} else {
+		output = core.NewGenericEnvelope(map[string]any{}, api.Trace{RunID: step.RunID.String()})
+	}
+	output.Trace = output.Trace.Next(step.NodeID)
+	output.AddError(step.NodeID, stepErr.Error(), stepErr)
+	return output
 }
 
-// CompleteWork marks a work item as completed
-func (e *DurableExecutionEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, result *WorkResult) error {
+// FinalizeRun marks a running workflow run as completed. A step still
+// waiting for the run in its parent run, because the run finished without
+// returning, continues with the output of the run.
+func (e *DurableExecutionEngine) FinalizeRun(ctx context.Context, runID uuid.UUID) error {
+	output, err := e.runOutput(ctx, runID)
+	if err != nil {
+		return err
+	}
+	var outputJSON []byte
+	if output != nil {
+		outputJSON, _ = json.Marshal(output)
+	}
+
 	tx, err := e.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	// Get the run_id before deleting the item
-	var originalRunID uuid.UUID
-	err = tx.QueryRowContext(ctx, "SELECT run_id FROM workflow_queue WHERE id = $1", itemID).Scan(&originalRunID)
+	query := `
+		UPDATE workflow_runs
+		SET status = 'completed', completed_at = NOW(), output_data = $2,
+		    completed_steps = (SELECT COUNT(*) FROM workflow_steps WHERE run_id = $1 AND status = 'completed')
+		WHERE id = $1 AND status = 'running'`
+	res, err := tx.ExecContext(ctx, query, runID, outputJSON)
+	if err != nil {
+		return fmt.Errorf("failed to complete run: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to complete run: %w", err)
+	}
+	if rows > 0 {
+		returned := &api.Envelope[any]{DataType: "object", Data: map[string]any{}}
+		if output != nil {
+			returned.Data = output
+		}
+		if err := e.returnToParentTx(ctx, tx, runID, returned); err != nil {
+			return fmt.Errorf("failed to return to parent run: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// runOutput returns the output of a finished run: the data returned by its
+// workflow_return node or, without one, the data of the step that completed
+// last
+func (e *DurableExecutionEngine) runOutput(ctx context.Context, runID uuid.UUID) (map[string]any, error) {
+	query := `
+		SELECT output_envelope FROM workflow_steps
+		WHERE run_id = $1 AND status = 'completed' AND output_envelope IS NOT NULL
+		ORDER BY node_type = 'workflow_return' DESC, completed_at DESC NULLS LAST, step_number DESC
+		LIMIT 1`
+
+	var outputJSON []byte
+	err := e.db.QueryRowContext(ctx, query, runID).Scan(&outputJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to get original run_id: %w", err)
+		return nil, fmt.Errorf("failed to load run output: %w", err)
+	}
+
+	var output api.Envelope[any]
+	if err := json.Unmarshal(outputJSON, &output); err != nil {
+		return nil, fmt.Errorf("failed to decode run output: %w", err)
 	}
+	return envelopeDataMap(&output), nil
+}
+
+// envelopeDataMap returns the data of an envelope as a map, wrapping data
+// of other types as {"data": value}
+func envelopeDataMap(envelope *api.Envelope[any]) map[string]any {
+	if data, ok := envelope.Data.(map[string]any); ok {
+		return data
+	}
+	return map[string]any{"data": envelope.Data}
+}
+
+// ClaimWork claims available work items for a worker
+func (e *DurableExecutionEngine) ClaimWork(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error) {
+	return e.queue.Claim(ctx, workerID, maxItems)
+}
+
+// WaitForWork blocks until work may have been enqueued or the context ends
+func (e *DurableExecutionEngine) WaitForWork(ctx context.Context) error {
+	return e.queue.Wait(ctx)
+}
+
+// CompleteWork marks a work item as completed. Workers that no longer hold
+// the lease of the item get ErrLeaseLost, and their result is discarded.
+func (e *DurableExecutionEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, lease int64, result *WorkResult) error {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
 	// Remove completed item from queue
-	deleteQuery := `DELETE FROM workflow_queue WHERE id = $1 AND claimed_by = $2`
-	if _, err := tx.ExecContext(ctx, deleteQuery, itemID, workerID); err != nil {
-		return fmt.Errorf("failed to remove completed item: %w", err)
+	item, err := e.queue.Complete(ctx, tx, workerID, itemID, lease)
+	if err != nil {
+		return err
 	}
+	originalRunID, originalStepID, queueType := item.RunID, item.StepID, item.QueueType
 
 	// If there was an error and should retry, requeue the item
 	if !result.Success && result.ShouldRetry {
@@ -235,6 +608,7 @@ func (e *DurableExecutionEngine) CompleteWork(ctx context.Context, workerID stri
 		retryItem := &QueueItem{
 			ID:          uuid.New(),
 			RunID:       originalRunID, // Use the original workflow run ID
+			StepID:      originalStepID,
 			QueueType:   QueueTypeRetryStep,
 			Priority:    8, // Higher priority for retries
 			AvailableAt: retryAt,
@@ -246,6 +620,24 @@ func (e *DurableExecutionEngine) CompleteWork(ctx context.Context, workerID stri
 		}
 	}
 
+	// A failure that won't be retried fails the whole run. Items that used
+	// up their attempts are kept as dead letters; failures retrying cannot
+	// fix, like validation errors, are not.
+	if !result.Success && !result.ShouldRetry {
+		if err := e.failRunTx(ctx, tx, originalRunID, originalStepID, result.Error, ""); err != nil {
+			return fmt.Errorf("failed to mark run as failed: %w", err)
+		}
+		exhausted, err := attemptsExhaustedTx(ctx, tx, item)
+		if err != nil {
+			return err
+		}
+		if exhausted {
+			if err := e.deadLetterTx(ctx, tx, item, result.Error); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Queue next steps if provided
 	for _, nextStepID := range result.NextSteps {
 		nextItem := &QueueItem{
@@ -263,6 +655,13 @@ func (e *DurableExecutionEngine) CompleteWork(ctx context.Context, workerID stri
 		}
 	}
 
+	// Once the last step has finished, queue the run for completion
+	if result.Success && len(result.NextSteps) == 0 && queueType != QueueTypeCompleteRun {
+		if err := e.queueRunCompletionTx(ctx, tx, originalRunID); err != nil {
+			return fmt.Errorf("failed to queue run completion: %w", err)
+		}
+	}
+
 	return tx.Commit()
 }
 
@@ -302,30 +701,53 @@ func (e *DurableExecutionEngine) UpdateWorkerHeartbeat(ctx context.Context, work
 	return err
 }
 
-// RecoverOrphanedWork recovers work from workers that have timed out
-func (e *DurableExecutionEngine) RecoverOrphanedWork(ctx context.Context, workerTimeoutDuration time.Duration) error {
-	// Find orphaned queue items
-	orphanedQuery := `
-		UPDATE workflow_queue 
-		SET claimed_by = NULL, claimed_at = NULL, attempt_count = attempt_count + 1
-		WHERE claimed_by IS NOT NULL 
-		  AND claimed_at < NOW() - INTERVAL '%d seconds'
-		  AND attempt_count < max_attempts`
+// RenewStepLease renews the lease a worker holds on an executing step and on
+// the claim of its work item, so that they are not recovered as orphaned. It
+// returns ErrLeaseLost when the worker no longer holds the lease.
+func (e *DurableExecutionEngine) RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-	_, err := e.db.ExecContext(ctx, fmt.Sprintf(orphanedQuery, int(workerTimeoutDuration.Seconds())))
+	query := `
+		UPDATE workflow_steps
+		SET worker_heartbeat = NOW()
+		WHERE id = $1 AND lease_epoch = $2 AND assigned_worker_id = $3 AND status = 'running'`
+
+	res, err := tx.ExecContext(ctx, query, stepID, lease, workerID)
 	if err != nil {
-		return fmt.Errorf("failed to recover orphaned queue items: %w", err)
+		return fmt.Errorf("failed to renew step lease: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrLeaseLost
 	}
 
-	// Find orphaned workflow steps
+	if err := e.queue.Renew(ctx, tx, workerID, stepID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RecoverOrphanedWork recovers work from workers that have timed out
+func (e *DurableExecutionEngine) RecoverOrphanedWork(ctx context.Context, workerTimeoutDuration time.Duration) error {
+	// Release orphaned queue items
+	if err := e.queue.Recover(ctx, workerTimeoutDuration); err != nil {
+		return err
+	}
+
+	// Find orphaned workflow steps. Their lease moves on, so results of the
+	// orphaned executions are rejected should they still arrive.
 	stepQuery := `
 		UPDATE workflow_steps 
-		SET assigned_worker_id = NULL, status = 'pending'
+		SET assigned_worker_id = NULL, status = 'pending', lease_epoch = lease_epoch + 1
 		WHERE assigned_worker_id IS NOT NULL 
 		  AND worker_heartbeat < NOW() - INTERVAL '%d seconds'
 		  AND status = 'running'`
 
-	_, err = e.db.ExecContext(ctx, fmt.Sprintf(stepQuery, int(workerTimeoutDuration.Seconds())))
+	_, err := e.db.ExecContext(ctx, fmt.Sprintf(stepQuery, int(workerTimeoutDuration.Seconds())))
 	if err != nil {
 		return fmt.Errorf("failed to recover orphaned steps: %w", err)
 	}
@@ -333,53 +755,501 @@ func (e *DurableExecutionEngine) RecoverOrphanedWork(ctx context.Context, worker
 	return nil
 }
 
+// DefaultRunTimeoutSeconds is the timeout of runs whose starter does not
+// choose one. TimeoutRuns fails runs that exceed their timeout.
+const DefaultRunTimeoutSeconds = 3600
+
+// TimeoutRuns fails the active runs that exceeded their timeout. Their
+// unclaimed queue items are removed, pending steps are skipped and running
+// steps are failed. It returns the IDs of the runs that timed out, so that
+// workers can stop their in-flight steps.
+func (e *DurableExecutionEngine) TimeoutRuns(ctx context.Context) ([]uuid.UUID, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Skip runs locked by workers recording results; they are picked up by
+	// the next check. Pending runs of workflows and triggers with a limit on
+	// concurrent runs may be held back by it and do not time out before they
+	// start.
+	query := `
+		SELECT r.id, r.timeout_seconds FROM workflow_runs r
+		LEFT JOIN workflows w ON w.id = r.workflow_id
+		LEFT JOIN triggers t ON t.id = r.trigger_id
+		WHERE r.status IN ('pending', 'running')
+		  AND r.timeout_seconds > 0
+		  AND COALESCE(r.started_at, r.created_at) + r.timeout_seconds * INTERVAL '1 second' < NOW()
+		  AND (r.status = 'running' OR (w.max_concurrent_runs IS NULL AND t.max_concurrent_runs IS NULL))
+		FOR UPDATE OF r SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find timed out runs: %w", err)
+	}
+
+	timeouts := make(map[uuid.UUID]int)
+	var runIDs []uuid.UUID
+	for rows.Next() {
+		var runID uuid.UUID
+		var timeoutSeconds int
+		if err := rows.Scan(&runID, &timeoutSeconds); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan timed out run: %w", err)
+		}
+		timeouts[runID] = timeoutSeconds
+		runIDs = append(runIDs, runID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read timed out runs: %w", err)
+	}
+
+	for _, runID := range runIDs {
+		message := fmt.Sprintf("workflow run exceeded timeout of %s", time.Duration(timeouts[runID])*time.Second)
+		errorJSON, _ := json.Marshal(map[string]any{
+			"error":     message,
+			"code":      api.ErrorCodeTimeout,
+			"timestamp": time.Now(),
+		})
+
+		stepsQuery := `
+			UPDATE workflow_steps
+			SET status = CASE WHEN status = 'running' THEN 'failed' ELSE 'skipped' END,
+			    error_details = CASE WHEN status = 'running' THEN $2::jsonb ELSE error_details END,
+			    completed_at = NOW()
+			WHERE run_id = $1 AND status IN ('pending', 'running', 'retrying', 'waiting')`
+		if _, err := tx.ExecContext(ctx, stepsQuery, runID, errorJSON); err != nil {
+			return nil, fmt.Errorf("failed to stop steps of timed out run: %w", err)
+		}
+
+		if err := e.queue.RemoveRun(ctx, tx, runID); err != nil {
+			return nil, err
+		}
+
+		if err := e.failRunTx(ctx, tx, runID, nil, &message, api.ErrorCodeTimeout); err != nil {
+			return nil, fmt.Errorf("failed to mark run as timed out: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit run timeouts: %w", err)
+	}
+
+	return runIDs, nil
+}
+
+// InactiveRuns returns the runs among the given ones that were cancelled or
+// have otherwise finished. Workers use it to stop the steps they execute for
+// these runs.
+func (e *DurableExecutionEngine) InactiveRuns(ctx context.Context, runIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(runIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT id FROM workflow_runs
+		WHERE id = ANY($1) AND status IN ('completed', 'failed', 'cancelled')`
+
+	rows, err := e.db.QueryContext(ctx, query, pq.Array(runIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run statuses: %w", err)
+	}
+	defer rows.Close()
+
+	var inactive []uuid.UUID
+	for rows.Next() {
+		var runID uuid.UUID
+		if err := rows.Scan(&runID); err != nil {
+			return nil, fmt.Errorf("failed to scan run: %w", err)
+		}
+		inactive = append(inactive, runID)
+	}
+
+	return inactive, rows.Err()
+}
+
 // Helper methods
 
-func (e *DurableExecutionEngine) enqueueItem(ctx context.Context, item *QueueItem) error {
+func (e *DurableExecutionEngine) loadWorkflowRun(ctx context.Context, runID uuid.UUID) (*WorkflowRun, error) {
 	query := `
-		INSERT INTO workflow_queue (
-			id, run_id, step_id, queue_type, priority, available_at,
-			max_attempts, payload
+		SELECT id, agent_id, workflow_id, version_id, status, input_data, variables,
+		       timeout_seconds, retry_policy, created_at, idempotency_key
+		FROM workflow_runs WHERE id = $1`
+	row := e.db.QueryRowContext(ctx, query, runID)
+
+	var run WorkflowRun
+	var agentID, workflowID, versionID uuid.NullUUID
+	var inputJSON, variablesJSON, retryPolicyJSON []byte
+	var timeoutSeconds sql.NullInt64
+	var idempotencyKey sql.NullString
+	if err := row.Scan(&run.ID, &agentID, &workflowID, &versionID, &run.Status,
+		&inputJSON, &variablesJSON, &timeoutSeconds, &retryPolicyJSON, &run.CreatedAt,
+		&idempotencyKey); err != nil {
+		return nil, err
+	}
+	if idempotencyKey.Valid {
+		run.IdempotencyKey = &idempotencyKey.String
+	}
+
+	run.AgentID = agentID.UUID
+	run.VersionID = versionID.UUID
+	if workflowID.Valid {
+		run.WorkflowID = &workflowID.UUID
+	}
+	run.TimeoutSeconds = int(timeoutSeconds.Int64)
+
+	if len(inputJSON) > 0 {
+		if err := json.Unmarshal(inputJSON, &run.InputData); err != nil {
+			return nil, fmt.Errorf("failed to decode run input: %w", err)
+		}
+	}
+	if len(variablesJSON) > 0 {
+		if err := json.Unmarshal(variablesJSON, &run.Variables); err != nil {
+			return nil, fmt.Errorf("failed to decode run variables: %w", err)
+		}
+	}
+
+	run.RetryPolicy = DefaultRetryPolicy()
+	if len(retryPolicyJSON) > 0 {
+		if err := json.Unmarshal(retryPolicyJSON, &run.RetryPolicy); err != nil {
+			return nil, fmt.Errorf("failed to decode run retry policy: %w", err)
+		}
+	}
+
+	return &run, nil
+}
+
+// loadWorkflowGraph loads the deployed workflow definition of a run. Runs
+// reference the version they were started with; runs without a known version
+// fall back to the currently deployed version of their workflow.
+func (e *DurableExecutionEngine) loadWorkflowGraph(ctx context.Context, run *WorkflowRun) (*WorkflowGraph, error) {
+	var definitionJSON []byte
+
+	err := e.db.QueryRowContext(ctx,
+		`SELECT definition FROM workflow_versions WHERE id = $1`, run.VersionID).Scan(&definitionJSON)
+	if err == sql.ErrNoRows && run.WorkflowID != nil {
+		err = e.db.QueryRowContext(ctx,
+			`SELECT definition FROM workflow_versions WHERE workflow_id = $1 AND is_current = true`,
+			*run.WorkflowID).Scan(&definitionJSON)
+	}
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no deployed workflow version found for run %s", run.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var definition WorkflowDefinition
+	if len(definitionJSON) > 0 {
+		if err := json.Unmarshal(definitionJSON, &definition); err != nil {
+			return nil, fmt.Errorf("failed to parse workflow definition: %w", err)
+		}
+	}
+
+	return BuildWorkflowGraph(run.ID, &definition)
+}
+
+// createWorkflowSteps persists the steps of the graph and marks the run as
+// running. If the run already has steps, e.g. because it is being recovered,
+// the existing steps are returned instead.
+func (e *DurableExecutionEngine) createWorkflowSteps(ctx context.Context, run *WorkflowRun, graph *WorkflowGraph, workerID string) ([]*WorkflowStep, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the run so concurrent start_run items don't create steps twice
+	var status WorkflowRunStatus
+	if err := tx.QueryRowContext(ctx,
+		`SELECT status FROM workflow_runs WHERE id = $1 FOR UPDATE`, run.ID).Scan(&status); err != nil {
+		return nil, fmt.Errorf("failed to lock run: %w", err)
+	}
+	if status.IsTerminal() {
+		return nil, ErrRunNotActive
+	}
+
+	steps, err := e.loadRunStepsTx(ctx, tx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		if err := e.insertWorkflowStepsTx(ctx, tx, run, graph); err != nil {
+			return nil, err
+		}
+		steps = graph.Steps
+	}
+
+	runQuery := `
+		UPDATE workflow_runs
+		SET status = 'running', started_at = COALESCE(started_at, NOW()), total_steps = $2,
+		    assigned_worker_id = $3, worker_heartbeat = NOW()
+		WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, runQuery, run.ID, len(steps), workerID); err != nil {
+		return nil, fmt.Errorf("failed to mark run as running: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit workflow steps: %w", err)
+	}
+
+	return steps, nil
+}
+
+func (e *DurableExecutionEngine) insertWorkflowStepsTx(ctx context.Context, tx *sql.Tx, run *WorkflowRun, graph *WorkflowGraph) error {
+	for _, step := range graph.Steps {
+		// Nodes with their own retry settings keep their attempt limit
+		settings, _ := ParseNodeSettings(step.NodeConfig)
+		if policy := settings.RetryPolicy(run.RetryPolicy); policy.MaxAttempts > 0 {
+			step.MaxAttempts = policy.MaxAttempts
+		}
+		if len(step.DependsOn) == 0 && step.InputEnvelope == nil {
+			step.InputEnvelope = newEntryEnvelope(run, step.NodeID)
+		}
+
+		if err := e.insertStepTx(ctx, tx, step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *DurableExecutionEngine) insertStepTx(ctx context.Context, tx *sql.Tx, step *WorkflowStep) error {
+	insertQuery := `
+		INSERT INTO workflow_steps (
+			id, run_id, node_id, node_type, step_number, status, max_attempts,
+			input_envelope, output_envelope, selected_branch, node_config, depends_on,
+			branch_conditions, split_path, required_capabilities
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 		)`
 
-	payloadJSON, _ := json.Marshal(item.Payload)
+	var inputJSON, outputJSON []byte
+	if step.InputEnvelope != nil {
+		var err error
+		if inputJSON, err = json.Marshal(step.InputEnvelope); err != nil {
+			return fmt.Errorf("failed to marshal input envelope: %w", err)
+		}
+	}
+	if step.OutputEnvelope != nil {
+		var err error
+		if outputJSON, err = json.Marshal(step.OutputEnvelope); err != nil {
+			return fmt.Errorf("failed to marshal output envelope: %w", err)
+		}
+	}
 
-	_, err := e.db.ExecContext(ctx, query,
-		item.ID, item.RunID, item.StepID, item.QueueType, item.Priority,
-		item.AvailableAt, item.MaxAttempts, payloadJSON)
-	return err
+	configJSON, err := json.Marshal(step.NodeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node config: %w", err)
+	}
+
+	branchJSON, err := marshalBranchConditions(step.BranchConditions)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, insertQuery,
+		step.ID, step.RunID, step.NodeID, step.NodeType, step.StepNumber, step.Status,
+		step.MaxAttempts, inputJSON, outputJSON, step.SelectedBranch, configJSON,
+		pq.Array(step.DependsOn), branchJSON, step.SplitPath,
+		pq.Array(e.requiredCapabilities(step.NodeType))); err != nil {
+		return fmt.Errorf("failed to create step for node %s: %w", step.NodeID, err)
+	}
+
+	return nil
+}
+
+// requiredCapabilities returns the capabilities a worker needs to execute
+// nodes of the given type, as declared by the node definition
+func (e *DurableExecutionEngine) requiredCapabilities(nodeType string) []string {
+	capabilities := []string{}
+	if e.mel == nil {
+		return capabilities
+	}
+	if def := e.mel.FindDefinition(nodeType); def != nil {
+		capabilities = append(capabilities, def.Meta().RequiredCapabilities...)
+	}
+	return capabilities
+}
+
+func marshalBranchConditions(conditions map[uuid.UUID][]string) ([]byte, error) {
+	if conditions == nil {
+		conditions = map[uuid.UUID][]string{}
+	}
+	branchJSON, err := json.Marshal(conditions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal branch conditions: %w", err)
+	}
+	return branchJSON, nil
+}
+
+func (e *DurableExecutionEngine) loadRunStepsTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID) ([]*WorkflowStep, error) {
+	query := `
+		SELECT id, run_id, node_id, node_type, step_number, status, max_attempts,
+		       node_config, depends_on, branch_conditions, selected_branch, split_path
+		FROM workflow_steps WHERE run_id = $1
+		ORDER BY step_number, split_path`
+
+	rows, err := tx.QueryContext(ctx, query, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load existing steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*WorkflowStep
+	for rows.Next() {
+		var step WorkflowStep
+		var configJSON, branchJSON []byte
+		if err := rows.Scan(&step.ID, &step.RunID, &step.NodeID, &step.NodeType,
+			&step.StepNumber, &step.Status, &step.MaxAttempts, &configJSON,
+			pq.Array(&step.DependsOn), &branchJSON, &step.SelectedBranch,
+			&step.SplitPath); err != nil {
+			return nil, fmt.Errorf("failed to scan step: %w", err)
+		}
+		if len(configJSON) > 0 {
+			if err := json.Unmarshal(configJSON, &step.NodeConfig); err != nil {
+				return nil, fmt.Errorf("failed to parse node config: %w", err)
+			}
+		}
+		if len(branchJSON) > 0 {
+			if err := json.Unmarshal(branchJSON, &step.BranchConditions); err != nil {
+				return nil, fmt.Errorf("failed to parse branch conditions: %w", err)
+			}
+		}
+		steps = append(steps, &step)
+	}
+
+	return steps, rows.Err()
+}
+
+func (e *DurableExecutionEngine) enqueueItem(ctx context.Context, item *QueueItem) error {
+	if err := delayForWaits(ctx, e.db, item); err != nil {
+		return err
+	}
+	return e.queue.Enqueue(ctx, nil, item)
 }
 
 func (e *DurableExecutionEngine) enqueueItemTx(ctx context.Context, tx *sql.Tx, item *QueueItem) error {
+	if err := delayForWaits(ctx, tx, item); err != nil {
+		return err
+	}
+	return e.queue.Enqueue(ctx, tx, item)
+}
+
+// delayForWaits makes the item of a step that follows a waiting step
+// available no earlier than the wait is over, whatever queue holds it
+func delayForWaits(ctx context.Context, db sqlExecutor, item *QueueItem) error {
+	if item.StepID == nil {
+		return nil
+	}
+
 	query := `
-		INSERT INTO workflow_queue (
-			id, run_id, step_id, queue_type, priority, available_at,
-			max_attempts, payload
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8
-		)`
+		SELECT MAX(d.wait_until) FROM workflow_steps s
+		JOIN workflow_steps d ON d.id = ANY(s.depends_on)
+		WHERE s.id = $1`
+	var waitUntil sql.NullTime
+	if err := db.QueryRowContext(ctx, query, *item.StepID).Scan(&waitUntil); err != nil {
+		return fmt.Errorf("failed to load waits of step: %w", err)
+	}
+	if waitUntil.Valid && waitUntil.Time.After(item.AvailableAt) {
+		item.AvailableAt = waitUntil.Time
+	}
+	return nil
+}
+
+// failRunTx marks an active run as failed and records the error that caused
+// it. A step waiting for the run in its parent run fails with the error.
+func (e *DurableExecutionEngine) failRunTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID, stepID *uuid.UUID, errMsg *string, code string) error {
+	errorData := map[string]any{}
+	if errMsg != nil {
+		errorData["error"] = *errMsg
+	}
+	if code != "" {
+		errorData["code"] = code
+	}
+	if stepID != nil {
+		errorData["step_id"] = stepID.String()
+	}
+	errorJSON, _ := json.Marshal(errorData)
 
-	payloadJSON, _ := json.Marshal(item.Payload)
+	query := `
+		UPDATE workflow_runs
+		SET status = 'failed', error_data = $2, completed_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'running', 'paused')`
 
-	_, err := tx.ExecContext(ctx, query,
-		item.ID, item.RunID, item.StepID, item.QueueType, item.Priority,
-		item.AvailableAt, item.MaxAttempts, payloadJSON)
-	return err
+	res, err := tx.ExecContext(ctx, query, runID, errorJSON)
+	if err != nil {
+		return err
+	}
+
+	// Only the transition into the failed state starts the error workflow
+	if rows, err := res.RowsAffected(); err != nil || rows == 0 {
+		return err
+	}
+
+	message := "called workflow failed"
+	if errMsg != nil {
+		message = fmt.Sprintf("called workflow failed: %s", *errMsg)
+	}
+	if err := e.failParentTx(ctx, tx, runID, message, code); err != nil {
+		return fmt.Errorf("failed to fail parent run: %w", err)
+	}
+
+	return e.startErrorWorkflowTx(ctx, tx, runID, stepID, errMsg)
 }
 
-func (e *DurableExecutionEngine) updateStepStatus(ctx context.Context, stepID uuid.UUID, status StepStatus, workerID *string) error {
+// queueRunCompletionTx queues a complete_run item once no step of the run is left to execute
+func (e *DurableExecutionEngine) queueRunCompletionTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID) error {
 	query := `
-		UPDATE workflow_steps 
-		SET status = $1, assigned_worker_id = $2, worker_heartbeat = NOW()
-		WHERE id = $3`
+		SELECT NOT EXISTS (
+			SELECT 1 FROM workflow_steps
+			WHERE run_id = $1 AND status NOT IN ('completed', 'skipped')
+		)
+		FROM workflow_runs WHERE id = $1 AND status = 'running'`
 
-	_, err := e.db.ExecContext(ctx, query, status, workerID, stepID)
-	return err
+	var finished bool
+	err := tx.QueryRowContext(ctx, query, runID).Scan(&finished)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !finished {
+		return nil
+	}
+
+	completeItem := &QueueItem{
+		ID:          uuid.New(),
+		RunID:       runID,
+		QueueType:   QueueTypeCompleteRun,
+		Priority:    5,
+		AvailableAt: time.Now(),
+		MaxAttempts: 3,
+	}
+
+	return e.enqueueItemTx(ctx, tx, completeItem)
+}
+
+// assignStep marks a step as running on the worker and returns the new lease
+// epoch of the step
+func (e *DurableExecutionEngine) assignStep(ctx context.Context, stepID uuid.UUID, workerID string) (int64, error) {
+	query := `
+		UPDATE workflow_steps 
+		SET status = 'running', assigned_worker_id = $1, worker_heartbeat = NOW(),
+		    lease_epoch = lease_epoch + 1
+		WHERE id = $2
+		RETURNING lease_epoch`
+
+	var lease int64
+	err := e.db.QueryRowContext(ctx, query, workerID, stepID).Scan(&lease)
+	return lease, err
 }
 
-func (e *DurableExecutionEngine) updateStepOutput(ctx context.Context, stepID uuid.UUID, output *api.Envelope[any]) error {
+func (e *DurableExecutionEngine) updateStepOutputTx(ctx context.Context, tx *sql.Tx, stepID uuid.UUID, output *api.Envelope[any], branch *string) error {
 	outputJSON, err := json.Marshal(output)
 	if err != nil {
 		return fmt.Errorf("failed to marshal output: %w", err)
@@ -387,14 +1257,17 @@ func (e *DurableExecutionEngine) updateStepOutput(ctx context.Context, stepID uu
 
 	query := `
 		UPDATE workflow_steps 
-		SET status = 'completed', output_envelope = $1, completed_at = NOW()
-		WHERE id = $2`
+		SET status = 'completed', output_envelope = $1, selected_branch = $2, wait_until = $3,
+		    completed_at = NOW()
+		WHERE id = $4`
 
-	_, err = e.db.ExecContext(ctx, query, outputJSON, stepID)
+	_, err = tx.ExecContext(ctx, query, outputJSON, branch, outputWaitUntil(output), stepID)
 	return err
 }
 
-func (e *DurableExecutionEngine) updateStepError(ctx context.Context, stepID uuid.UUID, errorDetails map[string]any) error {
+// updateStepError records the error of a failed execution. It returns
+// ErrLeaseLost when the execution no longer holds the lease of the step.
+func (e *DurableExecutionEngine) updateStepError(ctx context.Context, stepID uuid.UUID, lease int64, errorDetails map[string]any) error {
 	errorJSON, err := json.Marshal(errorDetails)
 	if err != nil {
 		return fmt.Errorf("failed to marshal error: %w", err)
@@ -403,10 +1276,16 @@ func (e *DurableExecutionEngine) updateStepError(ctx context.Context, stepID uui
 	query := `
 		UPDATE workflow_steps 
 		SET status = 'failed', error_details = $1, attempt_count = attempt_count + 1
-		WHERE id = $2`
+		WHERE id = $2 AND lease_epoch = $3`
 
-	_, err = e.db.ExecContext(ctx, query, errorJSON, stepID)
-	return err
+	res, err := e.db.ExecContext(ctx, query, errorJSON, stepID, lease)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrLeaseLost
+	}
+	return nil
 }
 
 func (e *DurableExecutionEngine) createCheckpoint(ctx context.Context, runID, stepID uuid.UUID, checkpointType string, data any) error {
@@ -490,22 +1369,36 @@ func (e *DurableExecutionEngine) CancelRun(ctx context.Context, runID uuid.UUID)
 	}
 	defer tx.Rollback()
 
-	// Cancel the run
-	runQuery := `UPDATE workflow_runs SET status = 'cancelled', completed_at = NOW() WHERE id = $1`
-	if _, err := tx.ExecContext(ctx, runQuery, runID); err != nil {
+	// Cancel the run. Workers stop its running steps once they learn about
+	// it through InactiveRuns, and their results are discarded.
+	runQuery := `
+		UPDATE workflow_runs SET status = 'cancelled', completed_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'running', 'paused')`
+	res, err := tx.ExecContext(ctx, runQuery, runID)
+	if err != nil {
 		return fmt.Errorf("failed to cancel run: %w", err)
 	}
 
-	// Cancel pending steps
-	stepsQuery := `UPDATE workflow_steps SET status = 'skipped' WHERE run_id = $1 AND status IN ('pending', 'retrying')`
+	// A step waiting for the run in its parent run fails
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to cancel run: %w", err)
+	}
+	if rows > 0 {
+		if err := e.failParentTx(ctx, tx, runID, "called workflow was cancelled", ""); err != nil {
+			return fmt.Errorf("failed to fail parent run: %w", err)
+		}
+	}
+
+	// Cancel unfinished steps
+	stepsQuery := `UPDATE workflow_steps SET status = 'skipped' WHERE run_id = $1 AND status IN ('pending', 'running', 'retrying', 'waiting')`
 	if _, err := tx.ExecContext(ctx, stepsQuery, runID); err != nil {
 		return fmt.Errorf("failed to cancel steps: %w", err)
 	}
 
 	// Remove queue items
-	queueQuery := `DELETE FROM workflow_queue WHERE run_id = $1 AND claimed_by IS NULL`
-	if _, err := tx.ExecContext(ctx, queueQuery, runID); err != nil {
-		return fmt.Errorf("failed to clear queue: %w", err)
+	if err := e.queue.RemoveRun(ctx, tx, runID); err != nil {
+		return err
 	}
 
 	return tx.Commit()
@@ -0,0 +1,152 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+)
+
+// failedStep describes the step whose failure ended a run
+type failedStep struct {
+	ID            uuid.UUID
+	NodeID        string
+	NodeType      string
+	InputEnvelope *api.Envelope[any]
+	ErrorDetails  map[string]any
+}
+
+// startErrorWorkflowTx starts the error workflow configured on the workflow of
+// a failed run. Runs that were themselves started by an error workflow never
+// start another one, so a failing error handler cannot trigger itself.
+func (e *DurableExecutionEngine) startErrorWorkflowTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID, stepID *uuid.UUID, errMsg *string) error {
+	var workflowID, errorWorkflowID, errorSourceRunID *uuid.UUID
+	query := `
+		SELECT r.workflow_id, w.error_workflow_id, r.error_source_run_id
+		FROM workflow_runs r
+		LEFT JOIN workflows w ON w.id = r.workflow_id
+		WHERE r.id = $1`
+	if err := tx.QueryRowContext(ctx, query, runID).Scan(&workflowID, &errorWorkflowID, &errorSourceRunID); err != nil {
+		return fmt.Errorf("failed to load error workflow: %w", err)
+	}
+	if errorWorkflowID == nil || errorSourceRunID != nil {
+		return nil
+	}
+
+	var versionID uuid.UUID
+	err := tx.QueryRowContext(ctx,
+		`SELECT id FROM workflow_versions WHERE workflow_id = $1 AND is_current = true`,
+		*errorWorkflowID).Scan(&versionID)
+	if err == sql.ErrNoRows {
+		log.Printf("Warning: error workflow %s of run %s has no deployed version", *errorWorkflowID, runID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load error workflow version: %w", err)
+	}
+
+	var step *failedStep
+	if stepID != nil {
+		if step, err = loadFailedStepTx(ctx, tx, *stepID); err != nil {
+			return err
+		}
+	}
+
+	errorRun := &WorkflowRun{
+		ID:               uuid.New(),
+		WorkflowID:       errorWorkflowID,
+		VersionID:        versionID,
+		Status:           RunStatusPending,
+		InputData:        errorWorkflowInput(runID, workflowID, step, errMsg),
+		Variables:        map[string]any{},
+		TimeoutSeconds:   DefaultRunTimeoutSeconds,
+		RetryPolicy:      DefaultRetryPolicy(),
+		ErrorSourceRunID: &runID,
+	}
+
+	if err := e.createRunTx(ctx, tx, errorRun); err != nil {
+		return fmt.Errorf("failed to start error workflow: %w", err)
+	}
+
+	return nil
+}
+
+// loadFailedStepTx loads the node, input and error of a failed step
+func loadFailedStepTx(ctx context.Context, tx *sql.Tx, stepID uuid.UUID) (*failedStep, error) {
+	query := `
+		SELECT id, node_id, node_type, input_envelope, error_details
+		FROM workflow_steps WHERE id = $1`
+
+	var step failedStep
+	var inputJSON, errorJSON []byte
+	err := tx.QueryRowContext(ctx, query, stepID).Scan(&step.ID, &step.NodeID, &step.NodeType, &inputJSON, &errorJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load failed step: %w", err)
+	}
+
+	if len(inputJSON) > 0 {
+		if err := json.Unmarshal(inputJSON, &step.InputEnvelope); err != nil {
+			return nil, fmt.Errorf("failed to parse input envelope: %w", err)
+		}
+	}
+	if len(errorJSON) > 0 {
+		if err := json.Unmarshal(errorJSON, &step.ErrorDetails); err != nil {
+			return nil, fmt.Errorf("failed to parse error details: %w", err)
+		}
+	}
+
+	return &step, nil
+}
+
+// errorWorkflowInput builds the input of an error workflow run. It carries the
+// failed run, the failing node, the errors collected along the way including
+// the final one, and the last input envelope of the failing node.
+func errorWorkflowInput(runID uuid.UUID, workflowID *uuid.UUID, step *failedStep, errMsg *string) map[string]any {
+	input := map[string]any{
+		"run_id": runID.String(),
+	}
+	if workflowID != nil {
+		input["workflow_id"] = workflowID.String()
+	}
+	if errMsg != nil {
+		input["error"] = *errMsg
+	}
+
+	errors := []api.ExecutionError{}
+	if step != nil {
+		input["step_id"] = step.ID.String()
+		input["node_id"] = step.NodeID
+		input["node_type"] = step.NodeType
+
+		if step.InputEnvelope != nil {
+			errors = append(errors, step.InputEnvelope.Errors...)
+			input["input_envelope"] = step.InputEnvelope
+		}
+
+		if step.ErrorDetails != nil {
+			executionError := api.ExecutionError{Time: time.Now(), NodeID: step.NodeID}
+			executionError.Message, _ = step.ErrorDetails["error"].(string)
+			executionError.Code, _ = step.ErrorDetails["code"].(string)
+			if timestamp, ok := step.ErrorDetails["timestamp"].(string); ok {
+				if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+					executionError.Time = t
+				}
+			}
+			if executionError.Message == "" && errMsg != nil {
+				executionError.Message = *errMsg
+			}
+			errors = append(errors, executionError)
+		}
+	}
+	input["errors"] = errors
+
+	return input
+}
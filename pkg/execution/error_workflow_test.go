@@ -0,0 +1,64 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWorkflowInput(t *testing.T) {
+	runID, workflowID := uuid.New(), uuid.New()
+	errMsg := "upstream returned 502"
+
+	envelope := core.NewGenericEnvelope(map[string]any{"order": 42}, api.Trace{RunID: runID.String(), NodeID: "charge"})
+	envelope.Errors = []api.ExecutionError{{NodeID: "lookup", Message: "cache miss", Code: api.ErrorCodeNotFound}}
+
+	failedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	step := &failedStep{
+		ID:            uuid.New(),
+		NodeID:        "charge",
+		NodeType:      "http_request",
+		InputEnvelope: envelope,
+		ErrorDetails: map[string]any{
+			"error":     errMsg,
+			"code":      api.ErrorCodeUpstream,
+			"attempt":   float64(3),
+			"timestamp": failedAt.Format(time.RFC3339Nano),
+		},
+	}
+
+	input := errorWorkflowInput(runID, &workflowID, step, &errMsg)
+
+	assert.Equal(t, runID.String(), input["run_id"])
+	assert.Equal(t, workflowID.String(), input["workflow_id"])
+	assert.Equal(t, errMsg, input["error"])
+	assert.Equal(t, step.ID.String(), input["step_id"])
+	assert.Equal(t, "charge", input["node_id"])
+	assert.Equal(t, "http_request", input["node_type"])
+	assert.Same(t, envelope, input["input_envelope"])
+
+	// Errors collected along the way come first, the failure of the run last
+	assert.Equal(t, []api.ExecutionError{
+		{NodeID: "lookup", Message: "cache miss", Code: api.ErrorCodeNotFound},
+		{Time: failedAt, NodeID: "charge", Message: errMsg, Code: api.ErrorCodeUpstream},
+	}, input["errors"])
+}
+
+func TestErrorWorkflowInputWithoutStep(t *testing.T) {
+	runID := uuid.New()
+	errMsg := "run timed out"
+
+	input := errorWorkflowInput(runID, nil, nil, &errMsg)
+
+	assert.Equal(t, runID.String(), input["run_id"])
+	assert.Equal(t, errMsg, input["error"])
+	assert.NotContains(t, input, "workflow_id")
+	assert.NotContains(t, input, "node_id")
+	require.Contains(t, input, "errors")
+	assert.Empty(t, input["errors"])
+}
@@ -0,0 +1,173 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// WorkflowDefinition mirrors the stored JSON definition of a workflow version
+type WorkflowDefinition struct {
+	Nodes []WorkflowNode `json:"nodes"`
+	Edges []WorkflowEdge `json:"edges"`
+}
+
+// WorkflowNode is a single node of a stored workflow definition
+type WorkflowNode struct {
+	ID     string         `json:"id"`
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	Config map[string]any `json:"config"`
+}
+
+// WorkflowEdge connects two nodes of a stored workflow definition
+type WorkflowEdge struct {
+	ID           string  `json:"id"`
+	Source       string  `json:"source"`
+	Target       string  `json:"target"`
+	SourceOutput *string `json:"sourceOutput,omitempty"`
+	TargetInput  *string `json:"targetInput,omitempty"`
+}
+
+// BuildWorkflowGraph converts a workflow definition into the steps of a run.
+// Steps are numbered in topological order and depend on the steps of all
+// nodes with an edge into them. Definitions containing cycles or invalid node
+// settings are rejected.
+func BuildWorkflowGraph(runID uuid.UUID, def *WorkflowDefinition) (*WorkflowGraph, error) {
+	graph := &WorkflowGraph{}
+	if def == nil {
+		return graph, nil
+	}
+
+	nodesByID := make(map[string]*WorkflowNode, len(def.Nodes))
+	stepIDs := make(map[string]uuid.UUID, len(def.Nodes))
+	for i := range def.Nodes {
+		node := &def.Nodes[i]
+		if node.ID == "" {
+			return nil, fmt.Errorf("node at index %d has no id", i)
+		}
+		if _, exists := nodesByID[node.ID]; exists {
+			return nil, fmt.Errorf("duplicate node id: %s", node.ID)
+		}
+		nodesByID[node.ID] = node
+		stepIDs[node.ID] = uuid.New()
+	}
+
+	// Collect incoming and outgoing connections, ignoring duplicate edges.
+	// Branch labels are kept per connection; a connection with an unlabelled
+	// edge is followed whatever branch its source takes.
+	parents := make(map[string][]string)
+	children := make(map[string][]string)
+	seen := make(map[[2]string]bool)
+	branches := make(map[[2]string][]string)
+	unconditional := make(map[[2]string]bool)
+	for _, edge := range def.Edges {
+		if _, ok := nodesByID[edge.Source]; !ok {
+			return nil, fmt.Errorf("edge %s references unknown source node: %s", edge.ID, edge.Source)
+		}
+		if _, ok := nodesByID[edge.Target]; !ok {
+			return nil, fmt.Errorf("edge %s references unknown target node: %s", edge.ID, edge.Target)
+		}
+
+		condition := ""
+		if edge.SourceOutput != nil {
+			condition = *edge.SourceOutput
+		}
+		graph.Dependencies = append(graph.Dependencies, StepDependency{
+			StepID:    stepIDs[edge.Target],
+			DependsOn: stepIDs[edge.Source],
+			Condition: condition,
+		})
+
+		key := [2]string{edge.Source, edge.Target}
+		if condition == "" {
+			unconditional[key] = true
+		} else {
+			branches[key] = append(branches[key], condition)
+		}
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		parents[edge.Target] = append(parents[edge.Target], edge.Source)
+		children[edge.Source] = append(children[edge.Source], edge.Target)
+	}
+
+	// Order the nodes topologically, keeping definition order for ties
+	inDegree := make(map[string]int, len(def.Nodes))
+	var ready []string
+	for _, node := range def.Nodes {
+		inDegree[node.ID] = len(parents[node.ID])
+		if inDegree[node.ID] == 0 {
+			ready = append(ready, node.ID)
+		}
+	}
+
+	var ordered []string
+	for len(ready) > 0 {
+		nodeID := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, nodeID)
+
+		for _, child := range children[nodeID] {
+			inDegree[child]--
+			if inDegree[child] == 0 {
+				ready = append(ready, child)
+			}
+		}
+	}
+
+	if len(ordered) != len(def.Nodes) {
+		return nil, fmt.Errorf("workflow definition contains a cycle")
+	}
+
+	for i, nodeID := range ordered {
+		node := nodesByID[nodeID]
+
+		dependsOn := make([]uuid.UUID, 0, len(parents[nodeID]))
+		var branchConditions map[uuid.UUID][]string
+		for _, parent := range parents[nodeID] {
+			dependsOn = append(dependsOn, stepIDs[parent])
+
+			key := [2]string{parent, nodeID}
+			if !unconditional[key] && len(branches[key]) > 0 {
+				if branchConditions == nil {
+					branchConditions = make(map[uuid.UUID][]string)
+				}
+				branchConditions[stepIDs[parent]] = branches[key]
+			}
+		}
+
+		config := node.Config
+		if config == nil {
+			config = map[string]any{}
+		}
+
+		settings, err := ParseNodeSettings(config)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", node.ID, err)
+		}
+		maxAttempts := settings.RetryPolicy(DefaultRetryPolicy()).MaxAttempts
+
+		step := &WorkflowStep{
+			ID:               stepIDs[nodeID],
+			RunID:            runID,
+			NodeID:           node.ID,
+			NodeType:         node.Type,
+			StepNumber:       i + 1,
+			Status:           StepStatusPending,
+			MaxAttempts:      maxAttempts,
+			NodeConfig:       config,
+			DependsOn:        dependsOn,
+			BranchConditions: branchConditions,
+		}
+		graph.Steps = append(graph.Steps, step)
+
+		if len(dependsOn) == 0 {
+			graph.EntryPoints = append(graph.EntryPoints, step.ID)
+		}
+	}
+
+	return graph, nil
+}
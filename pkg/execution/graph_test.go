@@ -0,0 +1,167 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildWorkflowGraph(t *testing.T) {
+	runID := uuid.New()
+	trueBranch := "true"
+
+	def := &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "merge", Type: "merge"},
+			{ID: "left", Type: "transform", Config: map[string]any{"expression": "x"}},
+			{ID: "start", Type: "webhook"},
+			{ID: "right", Type: "transform"},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "start", Target: "left", SourceOutput: &trueBranch},
+			{ID: "e2", Source: "start", Target: "right"},
+			{ID: "e3", Source: "left", Target: "merge"},
+			{ID: "e4", Source: "right", Target: "merge"},
+		},
+	}
+
+	graph, err := BuildWorkflowGraph(runID, def)
+	require.NoError(t, err)
+	require.Len(t, graph.Steps, 4)
+
+	byNode := make(map[string]*WorkflowStep)
+	for _, step := range graph.Steps {
+		assert.Equal(t, runID, step.RunID)
+		assert.Equal(t, StepStatusPending, step.Status)
+		assert.NotNil(t, step.NodeConfig)
+		byNode[step.NodeID] = step
+	}
+
+	// Steps are numbered in topological order
+	assert.Equal(t, 1, byNode["start"].StepNumber)
+	assert.Equal(t, 2, byNode["left"].StepNumber)
+	assert.Equal(t, 3, byNode["right"].StepNumber)
+	assert.Equal(t, 4, byNode["merge"].StepNumber)
+
+	assert.Empty(t, byNode["start"].DependsOn)
+	assert.Equal(t, []uuid.UUID{byNode["start"].ID}, byNode["left"].DependsOn)
+	assert.ElementsMatch(t, []uuid.UUID{byNode["left"].ID, byNode["right"].ID}, byNode["merge"].DependsOn)
+	assert.Equal(t, "x", byNode["left"].NodeConfig["expression"])
+
+	// Only edges with a branch label restrict routing
+	assert.Equal(t, map[uuid.UUID][]string{byNode["start"].ID: {"true"}}, byNode["left"].BranchConditions)
+	assert.Empty(t, byNode["right"].BranchConditions)
+	assert.Empty(t, byNode["merge"].BranchConditions)
+
+	assert.Equal(t, []uuid.UUID{byNode["start"].ID}, graph.EntryPoints)
+
+	require.Len(t, graph.Dependencies, 4)
+	assert.Equal(t, "true", graph.Dependencies[0].Condition)
+	assert.Equal(t, byNode["left"].ID, graph.Dependencies[0].StepID)
+	assert.Equal(t, byNode["start"].ID, graph.Dependencies[0].DependsOn)
+}
+
+func TestBuildWorkflowGraphBranchConditions(t *testing.T) {
+	yes, no := "yes", "no"
+
+	def := &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "check", Type: "switch"},
+			{ID: "both", Type: "log"},
+			{ID: "always", Type: "log"},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "check", Target: "both", SourceOutput: &yes},
+			{ID: "e2", Source: "check", Target: "both", SourceOutput: &no},
+			{ID: "e3", Source: "check", Target: "always", SourceOutput: &yes},
+			{ID: "e4", Source: "check", Target: "always"},
+		},
+	}
+
+	graph, err := BuildWorkflowGraph(uuid.New(), def)
+	require.NoError(t, err)
+
+	byNode := make(map[string]*WorkflowStep)
+	for _, step := range graph.Steps {
+		byNode[step.NodeID] = step
+	}
+
+	checkID := byNode["check"].ID
+	assert.Equal(t, []uuid.UUID{checkID}, byNode["both"].DependsOn)
+	assert.Equal(t, map[uuid.UUID][]string{checkID: {"yes", "no"}}, byNode["both"].BranchConditions)
+
+	// An unlabelled edge makes the connection unconditional
+	assert.Empty(t, byNode["always"].BranchConditions)
+}
+
+func TestBuildWorkflowGraphNodeRetrySettings(t *testing.T) {
+	def := &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "llm", Type: "llm", Config: map[string]any{"retry": map[string]any{"maxAttempts": float64(5)}}},
+			{ID: "payment", Type: "http_request", Config: map[string]any{"retry": map[string]any{"maxAttempts": float64(1)}}},
+			{ID: "log", Type: "log"},
+		},
+	}
+
+	graph, err := BuildWorkflowGraph(uuid.New(), def)
+	require.NoError(t, err)
+
+	attempts := make(map[string]int)
+	for _, step := range graph.Steps {
+		attempts[step.NodeID] = step.MaxAttempts
+	}
+	assert.Equal(t, map[string]int{"llm": 5, "payment": 1, "log": DefaultRetryPolicy().MaxAttempts}, attempts)
+}
+
+func TestBuildWorkflowGraphRejectsInvalidDefinitions(t *testing.T) {
+	tests := []struct {
+		name string
+		def  *WorkflowDefinition
+	}{
+		{
+			name: "cycle",
+			def: &WorkflowDefinition{
+				Nodes: []WorkflowNode{{ID: "a"}, {ID: "b"}},
+				Edges: []WorkflowEdge{
+					{ID: "e1", Source: "a", Target: "b"},
+					{ID: "e2", Source: "b", Target: "a"},
+				},
+			},
+		},
+		{
+			name: "unknown target",
+			def: &WorkflowDefinition{
+				Nodes: []WorkflowNode{{ID: "a"}},
+				Edges: []WorkflowEdge{{ID: "e1", Source: "a", Target: "missing"}},
+			},
+		},
+		{
+			name: "invalid node settings",
+			def: &WorkflowDefinition{
+				Nodes: []WorkflowNode{{ID: "a", Config: map[string]any{"timeoutSeconds": float64(-5)}}},
+			},
+		},
+		{
+			name: "duplicate node",
+			def: &WorkflowDefinition{
+				Nodes: []WorkflowNode{{ID: "a"}, {ID: "a"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildWorkflowGraph(uuid.New(), tt.def)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBuildWorkflowGraphEmptyDefinition(t *testing.T) {
+	graph, err := BuildWorkflowGraph(uuid.New(), &WorkflowDefinition{})
+	require.NoError(t, err)
+	assert.Empty(t, graph.Steps)
+	assert.Empty(t, graph.EntryPoints)
+}
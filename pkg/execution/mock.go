@@ -27,14 +27,42 @@ func (m *MockExecutionEngine) CancelRun(ctx context.Context, runID uuid.UUID) er
 	return nil
 }
 
+func (m *MockExecutionEngine) SignalRun(ctx context.Context, runID uuid.UUID, signal, token string, payload map[string]any) (uuid.UUID, error) {
+	return uuid.New(), nil
+}
+
+func (m *MockExecutionEngine) ReplayRun(ctx context.Context, runID uuid.UUID, nodeID string, input *apiPkg.Envelope[any]) (*WorkflowRun, error) {
+	return &WorkflowRun{ID: uuid.New(), Status: RunStatusPending, ReplaySourceRunID: &runID}, nil
+}
+
+func (m *MockExecutionEngine) InitializeRun(ctx context.Context, runID uuid.UUID, workerID string) ([]uuid.UUID, error) {
+	return []uuid.UUID{}, nil
+}
+
+func (m *MockExecutionEngine) FinalizeRun(ctx context.Context, runID uuid.UUID) error {
+	return nil
+}
+
+func (m *MockExecutionEngine) PrepareStep(ctx context.Context, stepID uuid.UUID, workerID string) (*WorkflowStep, error) {
+	return &WorkflowStep{ID: stepID}, nil
+}
+
 func (m *MockExecutionEngine) ExecuteStep(ctx context.Context, step *WorkflowStep) (*apiPkg.Envelope[any], error) {
 	return &apiPkg.Envelope[any]{}, nil
 }
 
+func (m *MockExecutionEngine) RecordStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *apiPkg.Envelope[any], stepErr error) (*WorkResult, error) {
+	return &WorkResult{Success: stepErr == nil}, nil
+}
+
 func (m *MockExecutionEngine) RetryStep(ctx context.Context, stepID uuid.UUID) error {
 	return nil
 }
 
+func (m *MockExecutionEngine) RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error {
+	return nil
+}
+
 func (m *MockExecutionEngine) RegisterWorker(ctx context.Context, worker *WorkflowWorker) error {
 	return nil
 }
@@ -51,7 +79,12 @@ func (m *MockExecutionEngine) ClaimWork(ctx context.Context, workerID string, ma
 	return []*QueueItem{}, nil
 }
 
-func (m *MockExecutionEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, result *WorkResult) error {
+func (m *MockExecutionEngine) WaitForWork(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (m *MockExecutionEngine) CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, lease int64, result *WorkResult) error {
 	return nil
 }
 
@@ -63,6 +96,34 @@ func (m *MockExecutionEngine) RecoverFailedRuns(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockExecutionEngine) TimeoutRuns(ctx context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (m *MockExecutionEngine) InactiveRuns(ctx context.Context, runIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (m *MockExecutionEngine) TimeoutSignals(ctx context.Context) error {
+	return nil
+}
+
+func (m *MockExecutionEngine) ListDeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error) {
+	return []*DeadLetter{}, 0, nil
+}
+
+func (m *MockExecutionEngine) GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	return nil, ErrDeadLetterNotFound
+}
+
+func (m *MockExecutionEngine) RequeueDeadLetter(ctx context.Context, id uuid.UUID) error {
+	return ErrDeadLetterNotFound
+}
+
+func (m *MockExecutionEngine) DiscardDeadLetter(ctx context.Context, id uuid.UUID) error {
+	return ErrDeadLetterNotFound
+}
+
 // NewMockExecutionEngine creates a new mock execution engine for testing
 func NewMockExecutionEngine() ExecutionEngine {
 	return &MockExecutionEngine{}
@@ -0,0 +1,79 @@
+package execution
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// QueueChannel is the Postgres notification channel signalled whenever a work
+// item is enqueued
+const QueueChannel = "workflow_queue"
+
+// waitForWorkInterval is how long WaitForWork waits without a queue listener
+const waitForWorkInterval = time.Second
+
+// QueueListener listens for work being enqueued and wakes up everyone waiting
+// for it. Notifications may be lost while the connection is re-established,
+// so waiters are also woken up after reconnecting and should keep polling as
+// a fallback.
+type QueueListener struct {
+	listener *pq.Listener
+
+	mu       sync.Mutex
+	notified chan struct{}
+}
+
+// NewQueueListener starts listening for enqueued work on the database with
+// the given connection string
+func NewQueueListener(databaseURL string) (*QueueListener, error) {
+	l := &QueueListener{notified: make(chan struct{})}
+	l.listener = pq.NewListener(databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Queue listener: %v", err)
+		}
+	})
+	if err := l.listener.Listen(QueueChannel); err != nil {
+		l.listener.Close()
+		return nil, err
+	}
+
+	go l.run()
+	return l, nil
+}
+
+// Notified returns a channel that is closed the next time work is enqueued
+func (l *QueueListener) Notified() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.notified
+}
+
+// Wait blocks until work is enqueued or the context ends
+func (l *QueueListener) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.Notified():
+		return nil
+	}
+}
+
+// Close stops listening
+func (l *QueueListener) Close() error {
+	return l.listener.Close()
+}
+
+// run wakes up the waiters on every notification. The listener sends nil
+// after reconnecting, when notifications may have been missed.
+func (l *QueueListener) run() {
+	for range l.listener.Notify {
+		l.mu.Lock()
+		close(l.notified)
+		l.notified = make(chan struct{})
+		l.mu.Unlock()
+	}
+}
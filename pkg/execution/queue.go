@@ -0,0 +1,553 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Queue holds the work items of workflow runs until a worker claims and
+// completes them.
+//
+// Methods taking a transaction apply their change as part of it, so that work
+// is queued and removed atomically with the state of runs and steps. Queues
+// that are not stored in the engine's database apply the change right away
+// and ignore the transaction. A nil transaction applies the change on its own.
+type Queue interface {
+	// Enqueue adds a work item
+	Enqueue(ctx context.Context, tx *sql.Tx, item *QueueItem) error
+	// Claim claims up to maxItems available work items for a worker. Every
+	// claim takes a new lease epoch.
+	Claim(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error)
+	// Complete removes and returns a work item the worker holds the lease
+	// of, or returns ErrLeaseLost
+	Complete(ctx context.Context, tx *sql.Tx, workerID string, itemID uuid.UUID, lease int64) (*QueueItem, error)
+	// Renew renews the claims of the worker on the work items of a step
+	Renew(ctx context.Context, tx *sql.Tx, workerID string, stepID uuid.UUID) error
+	// QueuedSteps returns the steps of a run with a queued work item
+	QueuedSteps(ctx context.Context, tx *sql.Tx, runID uuid.UUID) (map[uuid.UUID]bool, error)
+	// RemoveRun removes the unclaimed work items of a run
+	RemoveRun(ctx context.Context, tx *sql.Tx, runID uuid.UUID) error
+	// Recover releases the items claimed longer ago than the timeout, so
+	// other workers can claim them. Items that used up their attempts are
+	// dead-lettered instead.
+	Recover(ctx context.Context, timeout time.Duration) error
+	// Wait blocks until work may have been enqueued or the context ends
+	Wait(ctx context.Context) error
+
+	// DeadLetter stores a work item that ran out of attempts
+	DeadLetter(ctx context.Context, tx *sql.Tx, letter *DeadLetter) error
+	// DeadLetters lists dead letters, newest first, optionally only those of
+	// a run. It also returns the total number of matching dead letters.
+	DeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error)
+	// GetDeadLetter returns a dead letter, or ErrDeadLetterNotFound
+	GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error)
+	// RemoveDeadLetter removes and returns a dead letter, or returns
+	// ErrDeadLetterNotFound
+	RemoveDeadLetter(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*DeadLetter, error)
+}
+
+// PostgresQueue is a Queue stored in the workflow_queue table
+type PostgresQueue struct {
+	db *sql.DB
+
+	// listener wakes up Wait when work is enqueued
+	listener *QueueListener
+}
+
+// NewPostgresQueue creates a queue stored in the given database
+func NewPostgresQueue(db *sql.DB) *PostgresQueue {
+	return &PostgresQueue{db: db}
+}
+
+// SetListener makes Wait return as soon as work is enqueued instead of
+// polling
+func (q *PostgresQueue) SetListener(listener *QueueListener) {
+	q.listener = listener
+}
+
+// sqlExecutor runs statements on the database or in a transaction
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func (q *PostgresQueue) executor(tx *sql.Tx) sqlExecutor {
+	if tx != nil {
+		return tx
+	}
+	return q.db
+}
+
+// enqueueQuery inserts a queue item and notifies the workers listening on
+// QueueChannel once the transaction commits
+const enqueueQuery = `
+	WITH item AS (
+		INSERT INTO workflow_queue (
+			id, run_id, step_id, queue_type, priority, available_at,
+			max_attempts, payload
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+		RETURNING queue_type
+	)
+	SELECT pg_notify('` + QueueChannel + `', queue_type) FROM item`
+
+// Enqueue adds a work item
+func (q *PostgresQueue) Enqueue(ctx context.Context, tx *sql.Tx, item *QueueItem) error {
+	payloadJSON, _ := json.Marshal(item.Payload)
+
+	_, err := q.executor(tx).ExecContext(ctx, enqueueQuery,
+		item.ID, item.RunID, item.StepID, item.QueueType, item.Priority,
+		item.AvailableAt, item.MaxAttempts, payloadJSON)
+	return err
+}
+
+// claimWorkLockKey is the advisory lock serializing claims
+const claimWorkLockKey int64 = 0x6d656c5f636c61
+
+// claimWorkQuery finds the work items a worker claims next.
+//
+// Step items are only handed to workers that advertise the node type of the
+// step, or "*", and every capability the node requires.
+//
+// Runs start while fewer than max_concurrent_runs runs of their workflow, and
+// of their trigger, are running. Steps start while fewer than
+// max_concurrent_steps steps of their workflow are executing. Items over a
+// limit stay queued.
+//
+// Within a priority, items of the workflows with the least work in flight
+// come first, so a flooded workflow does not starve the others.
+//
+// At most $1 items of a workflow and queue type can be claimed, so only the
+// first $1 of them are ranked, read from the index of unclaimed items by
+// workflow. The claim takes time in the number of queued workflows rather
+// than in the length of the queue. Runs held back by the limit of their
+// trigger can hold back runs of other triggers of the same workflow until
+// they start.
+const claimWorkQuery = `
+	WITH RECURSIVE worker AS (
+		SELECT COALESCE((SELECT capabilities FROM workflow_workers WHERE id = $2), '{}') AS capabilities
+	),
+	queued_workflows AS (
+		(SELECT workflow_key FROM workflow_queue
+		 WHERE claimed_by IS NULL AND workflow_key IS NOT NULL
+		 ORDER BY workflow_key LIMIT 1)
+		UNION ALL
+		SELECT (SELECT q.workflow_key FROM workflow_queue q
+		        WHERE q.claimed_by IS NULL AND q.workflow_key > w.workflow_key
+		        ORDER BY q.workflow_key LIMIT 1)
+		FROM queued_workflows w
+		WHERE w.workflow_key IS NOT NULL
+	),
+	running_runs AS (
+		SELECT r.id, COALESCE(r.workflow_id, r.agent_id) AS workflow_key, r.trigger_id FROM workflow_runs r
+		WHERE r.status = 'running'
+		UNION
+		SELECT r.id, COALESCE(r.workflow_id, r.agent_id) AS workflow_key, r.trigger_id FROM workflow_queue q
+		JOIN workflow_runs r ON r.id = q.run_id
+		WHERE q.claimed_by IS NOT NULL AND q.queue_type = 'start_run'
+	),
+	workflow_runs_in_flight AS (
+		SELECT workflow_key, COUNT(*) AS runs FROM running_runs GROUP BY workflow_key
+	),
+	trigger_runs_in_flight AS (
+		SELECT trigger_id, COUNT(*) AS runs FROM running_runs
+		WHERE trigger_id IS NOT NULL
+		GROUP BY trigger_id
+	),
+	steps_in_flight AS (
+		SELECT workflow_key, COUNT(*) AS steps FROM workflow_queue
+		WHERE claimed_by IS NOT NULL AND queue_type IN ('execute_step', 'retry_step')
+		GROUP BY workflow_key
+	),
+	candidates AS (
+		SELECT q.id, q.priority, q.created_at, q.workflow_key, r.trigger_id,
+		       CASE q.queue_type
+		           WHEN 'start_run' THEN 'run'
+		           WHEN 'execute_step' THEN 'step'
+		           WHEN 'retry_step' THEN 'step'
+		           ELSE 'other'
+		       END AS kind,
+		       wf.max_concurrent_runs, wf.max_concurrent_steps,
+		       tr.max_concurrent_runs AS trigger_max_concurrent_runs
+		FROM queued_workflows w
+		CROSS JOIN (VALUES ('start_run'), ('execute_step'), ('retry_step'), ('complete_run')) AS t(queue_type)
+		CROSS JOIN worker wk
+		CROSS JOIN LATERAL (
+			SELECT q.* FROM workflow_queue q
+			LEFT JOIN workflow_steps s ON s.id = q.step_id
+			WHERE q.workflow_key = w.workflow_key
+			  AND q.queue_type = t.queue_type
+			  AND q.claimed_by IS NULL
+			  AND q.available_at <= NOW()
+			  AND (s.id IS NULL OR (
+			      ('*' = ANY(wk.capabilities) OR s.node_type = ANY(wk.capabilities))
+			      AND s.required_capabilities <@ wk.capabilities
+			  ))
+			ORDER BY q.priority, q.created_at
+			LIMIT $1
+		) q
+		JOIN workflow_runs r ON r.id = q.run_id
+		LEFT JOIN workflows wf ON wf.id = r.workflow_id
+		LEFT JOIN triggers tr ON tr.id = r.trigger_id
+		WHERE w.workflow_key IS NOT NULL
+	),
+	ranked AS (
+		SELECT c.*,
+		       CASE c.kind
+		           WHEN 'run' THEN COALESCE(wr.runs, 0)
+		           WHEN 'step' THEN COALESCE(ws.steps, 0)
+		           ELSE 0
+		       END + ROW_NUMBER() OVER (PARTITION BY c.workflow_key, c.kind ORDER BY c.priority, c.created_at) AS workflow_load,
+		       CASE
+		           WHEN c.kind = 'run' THEN COALESCE(tf.runs, 0)
+		           ELSE 0
+		       END + ROW_NUMBER() OVER (PARTITION BY c.trigger_id, c.kind ORDER BY c.priority, c.created_at) AS trigger_load
+		FROM candidates c
+		LEFT JOIN workflow_runs_in_flight wr ON wr.workflow_key = c.workflow_key
+		LEFT JOIN steps_in_flight ws ON ws.workflow_key = c.workflow_key
+		LEFT JOIN trigger_runs_in_flight tf ON tf.trigger_id = c.trigger_id
+	),
+	picked AS (
+		SELECT id, ROW_NUMBER() OVER (ORDER BY priority, workflow_load, created_at) AS position
+		FROM ranked
+		WHERE (kind <> 'run' OR (
+		          (max_concurrent_runs IS NULL OR workflow_load <= max_concurrent_runs)
+		          AND (trigger_max_concurrent_runs IS NULL OR trigger_load <= trigger_max_concurrent_runs)
+		      ))
+		  AND (kind <> 'step' OR max_concurrent_steps IS NULL OR workflow_load <= max_concurrent_steps)
+		ORDER BY position
+		LIMIT $1
+	)
+	SELECT q.id, q.run_id, q.step_id, q.queue_type, q.priority, q.available_at,
+	       q.created_at, q.attempt_count, q.max_attempts, q.payload, q.lease_epoch
+	FROM workflow_queue q
+	JOIN picked p ON p.id = q.id
+	WHERE q.claimed_by IS NULL
+	ORDER BY p.position
+	FOR UPDATE OF q SKIP LOCKED`
+
+// Claim claims available work items for a worker
+func (q *PostgresQueue) Claim(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error) {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Claims are serialized so concurrency limits hold across workers
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, claimWorkLockKey); err != nil {
+		return nil, fmt.Errorf("failed to lock work queue: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, claimWorkQuery, maxItems, workerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find work items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*QueueItem
+	for rows.Next() {
+		var item QueueItem
+		var payloadJSON []byte
+
+		err := rows.Scan(
+			&item.ID, &item.RunID, &item.StepID, &item.QueueType, &item.Priority,
+			&item.AvailableAt, &item.CreatedAt, &item.AttemptCount, &item.MaxAttempts,
+			&payloadJSON, &item.LeaseEpoch,
+		)
+		if err != nil {
+			continue
+		}
+
+		if len(payloadJSON) > 0 {
+			json.Unmarshal(payloadJSON, &item.Payload)
+		}
+
+		items = append(items, &item)
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	// Claim the items
+	itemIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		itemIDs[i] = item.ID
+		item.ClaimedBy = &workerID
+		now := time.Now()
+		item.ClaimedAt = &now
+		item.LeaseEpoch++
+	}
+
+	// Update claimed items; the rows are locked, so the epochs are known
+	claimQuery := `
+		UPDATE workflow_queue
+		SET claimed_by = $1, claimed_at = NOW(), lease_epoch = lease_epoch + 1
+		WHERE id = ANY($2)`
+
+	if _, err := tx.ExecContext(ctx, claimQuery, workerID, pq.Array(itemIDs)); err != nil {
+		return nil, fmt.Errorf("failed to claim work items: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit claim transaction: %w", err)
+	}
+
+	return items, nil
+}
+
+// Complete removes and returns a work item the worker holds the lease of
+func (q *PostgresQueue) Complete(ctx context.Context, tx *sql.Tx, workerID string, itemID uuid.UUID, lease int64) (*QueueItem, error) {
+	query := `
+		DELETE FROM workflow_queue
+		WHERE id = $1 AND claimed_by = $2 AND lease_epoch = $3
+		RETURNING id, run_id, step_id, queue_type, priority, available_at, created_at,
+		          claimed_at, claimed_by, attempt_count, max_attempts, payload, lease_epoch`
+
+	var item QueueItem
+	var payloadJSON []byte
+	err := q.executor(tx).QueryRowContext(ctx, query, itemID, workerID, lease).Scan(
+		&item.ID, &item.RunID, &item.StepID, &item.QueueType, &item.Priority,
+		&item.AvailableAt, &item.CreatedAt, &item.ClaimedAt, &item.ClaimedBy,
+		&item.AttemptCount, &item.MaxAttempts, &payloadJSON, &item.LeaseEpoch)
+	if err == sql.ErrNoRows {
+		return nil, ErrLeaseLost
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove completed item: %w", err)
+	}
+	if len(payloadJSON) > 0 {
+		json.Unmarshal(payloadJSON, &item.Payload)
+	}
+
+	return &item, nil
+}
+
+// Renew renews the claims of the worker on the work items of a step
+func (q *PostgresQueue) Renew(ctx context.Context, tx *sql.Tx, workerID string, stepID uuid.UUID) error {
+	query := `UPDATE workflow_queue SET claimed_at = NOW() WHERE step_id = $1 AND claimed_by = $2`
+	if _, err := q.executor(tx).ExecContext(ctx, query, stepID, workerID); err != nil {
+		return fmt.Errorf("failed to renew claim: %w", err)
+	}
+	return nil
+}
+
+// QueuedSteps returns the steps of a run with a queued work item
+func (q *PostgresQueue) QueuedSteps(ctx context.Context, tx *sql.Tx, runID uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := q.executor(tx).QueryContext(ctx, `SELECT step_id FROM workflow_queue WHERE run_id = $1 AND step_id IS NOT NULL`, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load queued steps: %w", err)
+	}
+	defer rows.Close()
+
+	queued := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var stepID uuid.UUID
+		if err := rows.Scan(&stepID); err != nil {
+			return nil, fmt.Errorf("failed to scan queued step: %w", err)
+		}
+		queued[stepID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read queued steps: %w", err)
+	}
+
+	return queued, nil
+}
+
+// RemoveRun removes the unclaimed work items of a run
+func (q *PostgresQueue) RemoveRun(ctx context.Context, tx *sql.Tx, runID uuid.UUID) error {
+	query := `DELETE FROM workflow_queue WHERE run_id = $1 AND claimed_by IS NULL`
+	if _, err := q.executor(tx).ExecContext(ctx, query, runID); err != nil {
+		return fmt.Errorf("failed to clear queue: %w", err)
+	}
+	return nil
+}
+
+// deadLetterExpiredQuery moves the items whose claim expired after their last
+// attempt into the dead letters
+const deadLetterExpiredQuery = `
+	WITH expired AS (
+		DELETE FROM workflow_queue
+		WHERE claimed_by IS NOT NULL
+		  AND claimed_at < NOW() - INTERVAL '%d seconds'
+		  AND attempt_count >= max_attempts
+		RETURNING *
+	)
+	INSERT INTO workflow_dead_letters (
+		id, run_id, step_id, queue_type, priority, attempt_count, max_attempts,
+		payload, last_error, worker_id, envelope, created_at
+	)
+	SELECT x.id, x.run_id, x.step_id, x.queue_type, x.priority, x.attempt_count, x.max_attempts,
+	       x.payload, 'worker stopped responding while processing the item', x.claimed_by,
+	       s.input_envelope, x.created_at
+	FROM expired x
+	LEFT JOIN workflow_steps s ON s.id = x.step_id`
+
+// Recover releases the items claimed longer ago than the timeout. Items that
+// used up their attempts are dead-lettered.
+func (q *PostgresQueue) Recover(ctx context.Context, timeout time.Duration) error {
+	seconds := int(timeout.Seconds())
+
+	if _, err := q.db.ExecContext(ctx, fmt.Sprintf(deadLetterExpiredQuery, seconds)); err != nil {
+		return fmt.Errorf("failed to dead-letter exhausted queue items: %w", err)
+	}
+
+	query := `
+		UPDATE workflow_queue
+		SET claimed_by = NULL, claimed_at = NULL, attempt_count = attempt_count + 1
+		WHERE claimed_by IS NOT NULL
+		  AND claimed_at < NOW() - INTERVAL '%d seconds'
+		  AND attempt_count < max_attempts`
+
+	if _, err := q.db.ExecContext(ctx, fmt.Sprintf(query, seconds)); err != nil {
+		return fmt.Errorf("failed to recover orphaned queue items: %w", err)
+	}
+	return nil
+}
+
+// Wait blocks until work may have been enqueued or the context ends. Without
+// a listener it returns after a short interval.
+func (q *PostgresQueue) Wait(ctx context.Context) error {
+	if q.listener != nil {
+		return q.listener.Wait(ctx)
+	}
+
+	timer := time.NewTimer(waitForWorkInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// DeadLetter stores a work item that ran out of attempts
+func (q *PostgresQueue) DeadLetter(ctx context.Context, tx *sql.Tx, letter *DeadLetter) error {
+	payloadJSON, _ := json.Marshal(letter.Payload)
+	var envelopeJSON []byte
+	if letter.Envelope != nil {
+		envelopeJSON, _ = json.Marshal(letter.Envelope)
+	}
+
+	query := `
+		INSERT INTO workflow_dead_letters (
+			id, run_id, step_id, queue_type, priority, attempt_count, max_attempts,
+			payload, last_error, worker_id, envelope, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)`
+
+	if _, err := q.executor(tx).ExecContext(ctx, query,
+		letter.ID, letter.RunID, letter.StepID, letter.QueueType, letter.Priority,
+		letter.AttemptCount, letter.MaxAttempts, payloadJSON, letter.LastError,
+		letter.WorkerID, envelopeJSON, letter.CreatedAt); err != nil {
+		return fmt.Errorf("failed to dead-letter work item: %w", err)
+	}
+	return nil
+}
+
+const deadLetterColumns = `
+	id, run_id, step_id, queue_type, priority, attempt_count, max_attempts,
+	payload, last_error, worker_id, envelope, created_at, dead_lettered_at`
+
+// scanDeadLetter scans a row of deadLetterColumns
+func scanDeadLetter(scan func(dest ...any) error) (*DeadLetter, error) {
+	var letter DeadLetter
+	var payloadJSON, envelopeJSON []byte
+	if err := scan(
+		&letter.ID, &letter.RunID, &letter.StepID, &letter.QueueType, &letter.Priority,
+		&letter.AttemptCount, &letter.MaxAttempts, &payloadJSON, &letter.LastError,
+		&letter.WorkerID, &envelopeJSON, &letter.CreatedAt, &letter.DeadLetteredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if len(payloadJSON) > 0 {
+		if err := json.Unmarshal(payloadJSON, &letter.Payload); err != nil {
+			return nil, fmt.Errorf("failed to parse payload: %w", err)
+		}
+	}
+	if len(envelopeJSON) > 0 {
+		if err := json.Unmarshal(envelopeJSON, &letter.Envelope); err != nil {
+			return nil, fmt.Errorf("failed to parse envelope: %w", err)
+		}
+	}
+	return &letter, nil
+}
+
+// DeadLetters lists dead letters, newest first, optionally only those of a run
+func (q *PostgresQueue) DeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM workflow_dead_letters WHERE $1::uuid IS NULL OR run_id = $1`
+	if err := q.db.QueryRowContext(ctx, countQuery, runID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+
+	query := `SELECT ` + deadLetterColumns + `
+		FROM workflow_dead_letters
+		WHERE $1::uuid IS NULL OR run_id = $1
+		ORDER BY dead_lettered_at DESC, id
+		LIMIT $2 OFFSET $3`
+
+	rows, err := q.db.QueryContext(ctx, query, runID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	letters := []*DeadLetter{}
+	for rows.Next() {
+		letter, err := scanDeadLetter(rows.Scan)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan dead letter: %w", err)
+		}
+		letters = append(letters, letter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read dead letters: %w", err)
+	}
+
+	return letters, total, nil
+}
+
+// GetDeadLetter returns a dead letter
+func (q *PostgresQueue) GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	query := `SELECT ` + deadLetterColumns + ` FROM workflow_dead_letters WHERE id = $1`
+
+	letter, err := scanDeadLetter(q.db.QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dead letter: %w", err)
+	}
+	return letter, nil
+}
+
+// RemoveDeadLetter removes and returns a dead letter
+func (q *PostgresQueue) RemoveDeadLetter(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*DeadLetter, error) {
+	query := `DELETE FROM workflow_dead_letters WHERE id = $1 RETURNING ` + deadLetterColumns
+
+	letter, err := scanDeadLetter(q.executor(tx).QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove dead letter: %w", err)
+	}
+	return letter, nil
+}
+
+// assert that PostgresQueue implements the interface
+var _ Queue = (*PostgresQueue)(nil)
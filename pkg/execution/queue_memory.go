@@ -0,0 +1,288 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryQueue is a Queue held in memory, for tests of the engine and the
+// API. It can only be created in test binaries.
+//
+// It does not take part in the transactions of the engine: its changes take
+// effect right away and stay when the transaction rolls back, so a failed
+// completion loses the item. It orders items by priority and age like the
+// Postgres queue, but does not know about workers, workflows or steps: it
+// neither routes steps by the capabilities of workers nor enforces
+// concurrency limits. The engine delays items of steps that follow waiting
+// steps before handing them to any queue.
+type MemoryQueue struct {
+	mu          sync.Mutex
+	items       map[uuid.UUID]*QueueItem
+	deadLetters map[uuid.UUID]*DeadLetter
+	notified    chan struct{}
+}
+
+// NewMemoryQueue creates an empty in-memory queue. It panics outside of
+// tests, where the queue would lose work.
+func NewMemoryQueue() *MemoryQueue {
+	if !testing.Testing() {
+		panic("execution: MemoryQueue is only available in tests")
+	}
+	return &MemoryQueue{
+		items:       make(map[uuid.UUID]*QueueItem),
+		deadLetters: make(map[uuid.UUID]*DeadLetter),
+		notified:    make(chan struct{}),
+	}
+}
+
+// Enqueue adds a work item right away
+func (q *MemoryQueue) Enqueue(ctx context.Context, tx *sql.Tx, item *QueueItem) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.items[item.ID]; ok {
+		return fmt.Errorf("work item %s is already queued", item.ID)
+	}
+
+	queued := *item
+	if queued.CreatedAt.IsZero() {
+		queued.CreatedAt = time.Now()
+	}
+	if queued.AvailableAt.IsZero() {
+		queued.AvailableAt = queued.CreatedAt
+	}
+	q.items[queued.ID] = &queued
+
+	// Wake up everyone waiting for work
+	close(q.notified)
+	q.notified = make(chan struct{})
+	return nil
+}
+
+// Claim claims available work items for a worker
+func (q *MemoryQueue) Claim(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	var available []*QueueItem
+	for _, item := range q.items {
+		if item.ClaimedBy == nil && !item.AvailableAt.After(now) {
+			available = append(available, item)
+		}
+	}
+	sort.Slice(available, func(i, j int) bool {
+		if available[i].Priority != available[j].Priority {
+			return available[i].Priority < available[j].Priority
+		}
+		return available[i].CreatedAt.Before(available[j].CreatedAt)
+	})
+	if len(available) > maxItems {
+		available = available[:maxItems]
+	}
+
+	items := make([]*QueueItem, 0, len(available))
+	for _, item := range available {
+		claimedBy, claimedAt := workerID, now
+		item.ClaimedBy = &claimedBy
+		item.ClaimedAt = &claimedAt
+		item.LeaseEpoch++
+
+		claimed := *item
+		items = append(items, &claimed)
+	}
+
+	return items, nil
+}
+
+// Complete removes and returns a work item the worker holds the lease of
+func (q *MemoryQueue) Complete(ctx context.Context, tx *sql.Tx, workerID string, itemID uuid.UUID, lease int64) (*QueueItem, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, ok := q.items[itemID]
+	if !ok || item.ClaimedBy == nil || *item.ClaimedBy != workerID || item.LeaseEpoch != lease {
+		return nil, ErrLeaseLost
+	}
+	delete(q.items, itemID)
+
+	completed := *item
+	return &completed, nil
+}
+
+// Renew renews the claims of the worker on the work items of a step
+func (q *MemoryQueue) Renew(ctx context.Context, tx *sql.Tx, workerID string, stepID uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	for _, item := range q.items {
+		if item.StepID != nil && *item.StepID == stepID && item.ClaimedBy != nil && *item.ClaimedBy == workerID {
+			claimedAt := now
+			item.ClaimedAt = &claimedAt
+		}
+	}
+	return nil
+}
+
+// QueuedSteps returns the steps of a run with a queued work item
+func (q *MemoryQueue) QueuedSteps(ctx context.Context, tx *sql.Tx, runID uuid.UUID) (map[uuid.UUID]bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	queued := make(map[uuid.UUID]bool)
+	for _, item := range q.items {
+		if item.RunID == runID && item.StepID != nil {
+			queued[*item.StepID] = true
+		}
+	}
+	return queued, nil
+}
+
+// RemoveRun removes the unclaimed work items of a run
+func (q *MemoryQueue) RemoveRun(ctx context.Context, tx *sql.Tx, runID uuid.UUID) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, item := range q.items {
+		if item.RunID == runID && item.ClaimedBy == nil {
+			delete(q.items, id)
+		}
+	}
+	return nil
+}
+
+// Recover releases the items claimed longer ago than the timeout. Items that
+// used up their attempts are dead-lettered.
+func (q *MemoryQueue) Recover(ctx context.Context, timeout time.Duration) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-timeout)
+	for id, item := range q.items {
+		if item.ClaimedAt == nil || !item.ClaimedAt.Before(cutoff) {
+			continue
+		}
+		if item.AttemptCount >= item.MaxAttempts {
+			delete(q.items, id)
+			q.deadLetters[id] = &DeadLetter{
+				ID:             item.ID,
+				RunID:          item.RunID,
+				StepID:         item.StepID,
+				QueueType:      item.QueueType,
+				Priority:       item.Priority,
+				AttemptCount:   item.AttemptCount,
+				MaxAttempts:    item.MaxAttempts,
+				Payload:        item.Payload,
+				LastError:      stringPtr("worker stopped responding while processing the item"),
+				WorkerID:       item.ClaimedBy,
+				CreatedAt:      item.CreatedAt,
+				DeadLetteredAt: now,
+			}
+			continue
+		}
+		item.ClaimedBy = nil
+		item.ClaimedAt = nil
+		item.AttemptCount++
+	}
+	return nil
+}
+
+// Wait blocks until work is enqueued or the context ends. It also returns
+// after a short interval, when delayed items may have become available.
+func (q *MemoryQueue) Wait(ctx context.Context) error {
+	q.mu.Lock()
+	notified := q.notified
+	q.mu.Unlock()
+
+	timer := time.NewTimer(waitForWorkInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-notified:
+		return nil
+	case <-timer.C:
+		return nil
+	}
+}
+
+// DeadLetter stores a work item that ran out of attempts
+func (q *MemoryQueue) DeadLetter(ctx context.Context, tx *sql.Tx, letter *DeadLetter) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.deadLetters[letter.ID]; ok {
+		return fmt.Errorf("work item %s is already dead-lettered", letter.ID)
+	}
+
+	stored := *letter
+	if stored.DeadLetteredAt.IsZero() {
+		stored.DeadLetteredAt = time.Now()
+	}
+	q.deadLetters[stored.ID] = &stored
+	return nil
+}
+
+// DeadLetters lists dead letters, newest first, optionally only those of a run
+func (q *MemoryQueue) DeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var matching []*DeadLetter
+	for _, letter := range q.deadLetters {
+		if runID == nil || letter.RunID == *runID {
+			matching = append(matching, letter)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		if !matching[i].DeadLetteredAt.Equal(matching[j].DeadLetteredAt) {
+			return matching[i].DeadLetteredAt.After(matching[j].DeadLetteredAt)
+		}
+		return matching[i].ID.String() < matching[j].ID.String()
+	})
+
+	letters := []*DeadLetter{}
+	for i := offset; i < len(matching) && len(letters) < limit; i++ {
+		letter := *matching[i]
+		letters = append(letters, &letter)
+	}
+	return letters, len(matching), nil
+}
+
+// GetDeadLetter returns a dead letter
+func (q *MemoryQueue) GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	letter, ok := q.deadLetters[id]
+	if !ok {
+		return nil, ErrDeadLetterNotFound
+	}
+	found := *letter
+	return &found, nil
+}
+
+// RemoveDeadLetter removes and returns a dead letter
+func (q *MemoryQueue) RemoveDeadLetter(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*DeadLetter, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	letter, ok := q.deadLetters[id]
+	if !ok {
+		return nil, ErrDeadLetterNotFound
+	}
+	delete(q.deadLetters, id)
+	return letter, nil
+}
+
+// assert that MemoryQueue implements the interface
+var _ Queue = (*MemoryQueue)(nil)
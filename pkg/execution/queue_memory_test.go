@@ -0,0 +1,239 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func memoryQueueItem(runID uuid.UUID, priority int) *QueueItem {
+	return &QueueItem{
+		ID:          uuid.New(),
+		RunID:       runID,
+		QueueType:   QueueTypeStartRun,
+		Priority:    priority,
+		AvailableAt: time.Now(),
+		MaxAttempts: 3,
+	}
+}
+
+func TestMemoryQueueClaim(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	low := memoryQueueItem(uuid.New(), 5)
+	high := memoryQueueItem(uuid.New(), 1)
+	delayed := memoryQueueItem(uuid.New(), 1)
+	delayed.AvailableAt = time.Now().Add(time.Hour)
+	for _, item := range []*QueueItem{low, high, delayed} {
+		require.NoError(t, queue.Enqueue(ctx, nil, item))
+	}
+	assert.Error(t, queue.Enqueue(ctx, nil, low), "items are only queued once")
+
+	items, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, high.ID, items[0].ID, "higher priority items are claimed first")
+	require.NotNil(t, items[0].ClaimedBy)
+	assert.Equal(t, "worker-1", *items[0].ClaimedBy)
+
+	items, err = queue.Claim(ctx, "worker-2", 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1, "claimed and delayed items are not handed out")
+	assert.Equal(t, low.ID, items[0].ID)
+}
+
+func TestMemoryQueueComplete(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	stepID := uuid.New()
+	item := memoryQueueItem(uuid.New(), 5)
+	item.QueueType = QueueTypeExecuteStep
+	item.StepID = &stepID
+	require.NoError(t, queue.Enqueue(ctx, nil, item))
+
+	queued, err := queue.QueuedSteps(ctx, nil, item.RunID)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]bool{stepID: true}, queued)
+
+	claimed, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	lease := claimed[0].LeaseEpoch
+
+	// Only the worker holding the lease of the item removes it
+	_, err = queue.Complete(ctx, nil, "worker-2", item.ID, lease)
+	assert.ErrorIs(t, err, ErrLeaseLost)
+	_, err = queue.Complete(ctx, nil, "worker-1", item.ID, lease-1)
+	assert.ErrorIs(t, err, ErrLeaseLost)
+	queued, err = queue.QueuedSteps(ctx, nil, item.RunID)
+	require.NoError(t, err)
+	assert.Len(t, queued, 1)
+
+	completed, err := queue.Complete(ctx, nil, "worker-1", item.ID, lease)
+	require.NoError(t, err)
+	assert.Equal(t, QueueTypeExecuteStep, completed.QueueType)
+	assert.Equal(t, &stepID, completed.StepID)
+	queued, err = queue.QueuedSteps(ctx, nil, item.RunID)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+
+	_, err = queue.Complete(ctx, nil, "worker-1", item.ID, lease)
+	assert.ErrorIs(t, err, ErrLeaseLost)
+}
+
+func TestMemoryQueueLeases(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	stepID := uuid.New()
+	item := memoryQueueItem(uuid.New(), 5)
+	item.QueueType = QueueTypeExecuteStep
+	item.StepID = &stepID
+	require.NoError(t, queue.Enqueue(ctx, nil, item))
+
+	first, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+
+	// Renewed claims are not recovered
+	require.NoError(t, queue.Renew(ctx, nil, "worker-1", stepID))
+	require.NoError(t, queue.Recover(ctx, time.Hour))
+	items, err := queue.Claim(ctx, "worker-2", 1)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// Once recovered, the item is claimed under a new lease and the result
+	// of the first worker is rejected
+	require.NoError(t, queue.Recover(ctx, -time.Second))
+	second, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+	assert.Greater(t, second[0].LeaseEpoch, first[0].LeaseEpoch)
+
+	_, err = queue.Complete(ctx, nil, "worker-1", item.ID, first[0].LeaseEpoch)
+	assert.ErrorIs(t, err, ErrLeaseLost)
+	_, err = queue.Complete(ctx, nil, "worker-1", item.ID, second[0].LeaseEpoch)
+	assert.NoError(t, err)
+}
+
+func TestMemoryQueueRemoveRun(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	runID := uuid.New()
+	claimed := memoryQueueItem(runID, 1)
+	unclaimed := memoryQueueItem(runID, 5)
+	other := memoryQueueItem(uuid.New(), 5)
+	for _, item := range []*QueueItem{claimed, unclaimed, other} {
+		require.NoError(t, queue.Enqueue(ctx, nil, item))
+	}
+	claimedItems, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+	require.Len(t, claimedItems, 1)
+
+	require.NoError(t, queue.RemoveRun(ctx, nil, runID))
+
+	items, err := queue.Claim(ctx, "worker-1", 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, other.ID, items[0].ID)
+
+	_, err = queue.Complete(ctx, nil, "worker-1", claimed.ID, claimedItems[0].LeaseEpoch)
+	assert.NoError(t, err, "claimed items of the run stay queued")
+}
+
+func TestMemoryQueueRecover(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	item := memoryQueueItem(uuid.New(), 5)
+	item.MaxAttempts = 1
+	require.NoError(t, queue.Enqueue(ctx, nil, item))
+	_, err := queue.Claim(ctx, "worker-1", 1)
+	require.NoError(t, err)
+
+	require.NoError(t, queue.Recover(ctx, time.Hour))
+	items, err := queue.Claim(ctx, "worker-2", 1)
+	require.NoError(t, err)
+	assert.Empty(t, items, "recently claimed items are not recovered")
+
+	require.NoError(t, queue.Recover(ctx, -time.Second))
+	items, err = queue.Claim(ctx, "worker-2", 1)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, 1, items[0].AttemptCount)
+
+	require.NoError(t, queue.Recover(ctx, -time.Second))
+	items, err = queue.Claim(ctx, "worker-3", 1)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	letter, err := queue.GetDeadLetter(ctx, item.ID)
+	require.NoError(t, err, "items out of attempts are dead-lettered")
+	assert.Equal(t, "worker-2", *letter.WorkerID)
+	assert.Equal(t, 1, letter.AttemptCount)
+	assert.NotNil(t, letter.LastError)
+}
+
+func TestMemoryQueueDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	queue := NewMemoryQueue()
+
+	runID := uuid.New()
+	older := &DeadLetter{ID: uuid.New(), RunID: runID, QueueType: QueueTypeStartRun, DeadLetteredAt: time.Now().Add(-time.Minute)}
+	newer := &DeadLetter{ID: uuid.New(), RunID: runID, QueueType: QueueTypeExecuteStep}
+	other := &DeadLetter{ID: uuid.New(), RunID: uuid.New(), QueueType: QueueTypeStartRun}
+	for _, letter := range []*DeadLetter{older, newer, other} {
+		require.NoError(t, queue.DeadLetter(ctx, nil, letter))
+	}
+	assert.Error(t, queue.DeadLetter(ctx, nil, older))
+
+	letters, total, err := queue.DeadLetters(ctx, &runID, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, letters, 1)
+	assert.Equal(t, newer.ID, letters[0].ID, "newest dead letters come first")
+
+	letters, total, err = queue.DeadLetters(ctx, nil, 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, letters, 2)
+
+	removed, err := queue.RemoveDeadLetter(ctx, nil, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, older.ID, removed.ID)
+	_, err = queue.GetDeadLetter(ctx, older.ID)
+	assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+	_, err = queue.RemoveDeadLetter(ctx, nil, older.ID)
+	assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+}
+
+func TestMemoryQueueWait(t *testing.T) {
+	queue := NewMemoryQueue()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- queue.Wait(context.Background())
+	}()
+
+	// Enqueue until the waiter has been woken up, in case it started
+	// waiting after the first item
+	for {
+		require.NoError(t, queue.Enqueue(context.Background(), nil, memoryQueueItem(uuid.New(), 5)))
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			assert.ErrorIs(t, queue.Wait(ctx), context.Canceled)
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/client"
+)
+
+// remoteDataStore is a DataStore kept by the API server, so that nodes on
+// remote workers share their data with every instance of the deployment
+type remoteDataStore struct {
+	worker *RemoteWorker
+}
+
+// DataStore returns a data store kept by the API server the worker connects
+// to. Set it on the Mel of the worker to share data across instances.
+func (rw *RemoteWorker) DataStore() api.DataStore {
+	return &remoteDataStore{worker: rw}
+}
+
+// Store keeps data under a key until it expires
+func (s *remoteDataStore) Store(ctx context.Context, key string, data interface{}, expiresAt time.Time) error {
+	reqBody := client.StoreWorkerDataJSONRequestBody{
+		Data:       data,
+		TtlSeconds: time.Until(expiresAt).Seconds(),
+	}
+	resp, err := s.worker.apiClient.StoreWorkerDataWithResponse(ctx, s.worker.workerID, key, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to store data: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to store data: status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// Retrieve returns the data stored under a key
+func (s *remoteDataStore) Retrieve(ctx context.Context, key string) (interface{}, error) {
+	resp, err := s.worker.apiClient.GetWorkerDataWithResponse(ctx, s.worker.workerID, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to retrieve data: empty response")
+		}
+		return resp.JSON200.Data, nil
+	case http.StatusNotFound:
+		return nil, api.ErrDataNotFound
+	case http.StatusGone:
+		return nil, api.ErrDataExpired
+	default:
+		return nil, fmt.Errorf("failed to retrieve data: status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+}
+
+// Delete removes the data stored under a key
+func (s *remoteDataStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.worker.apiClient.DeleteWorkerDataWithResponse(ctx, s.worker.workerID, key)
+	if err != nil {
+		return fmt.Errorf("failed to delete data: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete data: status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// Sweep does nothing, the API server sweeps the expired data
+func (s *remoteDataStore) Sweep(ctx context.Context) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,68 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteDataStore(t *testing.T) {
+	// The API server keeps the data in its own store
+	serverStore := api.NewMemoryDataStore()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Path[strings.LastIndex(r.URL.Path, "/data/")+len("/data/"):]
+		switch r.Method {
+		case http.MethodPut:
+			var req struct {
+				Data       any     `json:"data"`
+				TtlSeconds float64 `json:"ttl_seconds"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			serverStore.Store(r.Context(), key, req.Data, time.Now().Add(time.Duration(req.TtlSeconds*float64(time.Second))))
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			data, err := serverStore.Retrieve(r.Context(), key)
+			w.Header().Set("Content-Type", "application/json")
+			switch err {
+			case nil:
+				json.NewEncoder(w).Encode(map[string]any{"data": data})
+			case api.ErrDataExpired:
+				w.WriteHeader(http.StatusGone)
+				json.NewEncoder(w).Encode(map[string]any{"error": "gone"})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]any{"error": "not found"})
+			}
+		case http.MethodDelete:
+			serverStore.Delete(r.Context(), key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	worker, err := NewRemoteWorker(server.URL, "test-token", "worker-1", api.NewMel(), 1)
+	require.NoError(t, err)
+	store := worker.DataStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Store(ctx, "shared", map[string]any{"count": 3}, time.Now().Add(time.Hour)))
+	data, err := store.Retrieve(ctx, "shared")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"count": float64(3)}, data)
+
+	require.NoError(t, store.Store(ctx, "stale", "value", time.Now().Add(-time.Second)))
+	_, err = store.Retrieve(ctx, "stale")
+	assert.ErrorIs(t, err, api.ErrDataExpired)
+
+	require.NoError(t, store.Delete(ctx, "shared"))
+	_, err = store.Retrieve(ctx, "shared")
+	assert.ErrorIs(t, err, api.ErrDataNotFound)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
@@ -15,6 +17,14 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// remoteClaimWaitSeconds is how long a claim waits for work on the server
+	remoteClaimWaitSeconds = 30
+	// remoteClaimBackoff is how long to wait before claiming again when the
+	// server did not wait for work
+	remoteClaimBackoff = 5 * time.Second
+)
+
 // RemoteWorker represents a worker that connects to a remote API server
 type RemoteWorker struct {
 	serverURL   string
@@ -22,8 +32,26 @@ type RemoteWorker struct {
 	workerID    string
 	mel         api.Mel
 	concurrency int
-	apiClient   client.ClientWithResponsesInterface
-	workerInfo  *WorkflowWorker
+	// capabilities are the node types and labels the worker executes
+	capabilities []string
+	apiClient    client.ClientWithResponsesInterface
+	workerInfo   *WorkflowWorker
+
+	// slots holds a token for each work item in flight, at most concurrency
+	slots    chan struct{}
+	inFlight sync.WaitGroup
+
+	// Steps executing on this worker, so they can be stopped when their run
+	// becomes inactive
+	mu           sync.Mutex
+	runningSteps map[uuid.UUID]runningStep
+}
+
+// runningStep is a step executing on a remote worker
+type runningStep struct {
+	runID  uuid.UUID
+	lease  int64
+	cancel context.CancelFunc
 }
 
 // NewRemoteWorker creates a new remote worker instance
@@ -35,15 +63,24 @@ func NewRemoteWorker(serverURL, token, workerID string, mel api.Mel, concurrency
 	}
 
 	return &RemoteWorker{
-		serverURL:   serverURL,
-		token:       token,
-		workerID:    workerID,
-		mel:         mel,
-		concurrency: concurrency,
-		apiClient:   apiClient,
+		serverURL:    serverURL,
+		token:        token,
+		workerID:     workerID,
+		mel:          mel,
+		concurrency:  concurrency,
+		capabilities: []string{"*"},
+		apiClient:    apiClient,
+		slots:        make(chan struct{}, concurrency),
+		runningSteps: make(map[uuid.UUID]runningStep),
 	}, nil
 }
 
+// SetCapabilities sets the node types and labels the worker advertises.
+// Workers advertising "*" execute every node type that requires no labels.
+func (rw *RemoteWorker) SetCapabilities(capabilities []string) {
+	rw.capabilities = capabilities
+}
+
 // Start begins the remote worker execution loop
 func (rw *RemoteWorker) Start(ctx context.Context) error {
 	// Generate worker ID if not provided
@@ -60,7 +97,7 @@ func (rw *RemoteWorker) Start(ctx context.Context) error {
 		Hostname:             hostname,
 		ProcessID:            &processID,
 		Version:              getVersion(),
-		Capabilities:         []string{"workflow_execution", "node_execution"},
+		Capabilities:         rw.capabilities,
 		Status:               WorkerStatusIdle,
 		LastHeartbeat:        time.Now(),
 		StartedAt:            time.Now(),
@@ -80,6 +117,9 @@ func (rw *RemoteWorker) Start(ctx context.Context) error {
 	// Start heartbeat goroutine
 	go rw.heartbeatLoop(ctx)
 
+	// Stop steps of runs that become inactive while they execute
+	go rw.cancellationLoop(ctx)
+
 	// Start main work loop
 	return rw.workLoop(ctx)
 }
@@ -88,9 +128,10 @@ func (rw *RemoteWorker) Start(ctx context.Context) error {
 func (rw *RemoteWorker) registerWorker(ctx context.Context) error {
 	// Convert internal WorkflowWorker to client RegisterWorkerJSONRequestBody
 	req := client.RegisterWorkerJSONRequestBody{
-		Id:          rw.workerID,
-		Name:        &rw.workerInfo.Hostname,
-		Concurrency: &rw.concurrency,
+		Id:           rw.workerID,
+		Name:         &rw.workerInfo.Hostname,
+		Concurrency:  &rw.concurrency,
+		Capabilities: &rw.capabilities,
 	}
 
 	resp, err := rw.apiClient.RegisterWorkerWithResponse(ctx, req)
@@ -113,7 +154,8 @@ func (rw *RemoteWorker) registerWorker(ctx context.Context) error {
 	return nil
 }
 
-// heartbeatLoop sends periodic heartbeats to the API server
+// heartbeatLoop sends periodic heartbeats to the API server, for the worker
+// and for each step it executes
 func (rw *RemoteWorker) heartbeatLoop(ctx context.Context) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -128,6 +170,37 @@ func (rw *RemoteWorker) heartbeatLoop(ctx context.Context) {
 			if err := rw.sendHeartbeat(ctx); err != nil {
 				log.Printf("Failed to send heartbeat: %v", err)
 			}
+			rw.sendStepHeartbeats(ctx)
+		}
+	}
+}
+
+// sendStepHeartbeats renews the leases of the executing steps, so that steps
+// running longer than the worker timeout are not recovered as orphaned.
+// Steps whose lease was lost are stopped, another execution replaced them.
+func (rw *RemoteWorker) sendStepHeartbeats(ctx context.Context) {
+	rw.mu.Lock()
+	steps := make(map[uuid.UUID]runningStep, len(rw.runningSteps))
+	for stepID, step := range rw.runningSteps {
+		steps[stepID] = step
+	}
+	rw.mu.Unlock()
+
+	for stepID, step := range steps {
+		reqBody := client.ReportStepHeartbeatJSONRequestBody{LeaseEpoch: step.lease}
+		resp, err := rw.apiClient.ReportStepHeartbeatWithResponse(ctx, rw.workerID, stepID, reqBody)
+		if err != nil {
+			log.Printf("Failed to send heartbeat of step %s: %v", stepID, err)
+			continue
+		}
+
+		switch resp.StatusCode() {
+		case http.StatusNoContent, http.StatusOK:
+		case http.StatusConflict:
+			log.Printf("Stopping step %s, its lease was lost", stepID)
+			step.cancel()
+		default:
+			log.Printf("Heartbeat of step %s failed with status %d: %s", stepID, resp.StatusCode(), string(resp.Body))
 		}
 	}
 }
@@ -177,63 +250,91 @@ func (rw *RemoteWorker) unregisterWorker(ctx context.Context) error {
 	return nil
 }
 
-// workLoop is the main work processing loop
+// workLoop is the main work processing loop. Claims long-poll the API server,
+// so work is picked up as soon as it is enqueued.
 func (rw *RemoteWorker) workLoop(ctx context.Context) error {
-	// Process work immediately when starting
-	if err := rw.processWork(ctx); err != nil {
-		log.Printf("Error processing initial work: %v", err)
-	}
-
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	// Let the work items in flight report their results before stopping
+	defer rw.inFlight.Wait()
 
 	for {
+		started := time.Now()
+		claimed, err := rw.processWork(ctx)
+		if err != nil {
+			log.Printf("Error processing work: %v", err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// Back off when the claim failed, or returned without work before the
+		// wait was over
+		if err == nil && (claimed > 0 || time.Since(started) >= remoteClaimBackoff) {
+			continue
+		}
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-ticker.C:
-			if err := rw.processWork(ctx); err != nil {
-				log.Printf("Error processing work: %v", err)
-			}
+		case <-time.After(remoteClaimBackoff):
 		}
 	}
 }
 
-// processWork claims and processes work from the API server
-func (rw *RemoteWorker) processWork(ctx context.Context) error {
-	// Claim work from the API server
-	workItems, err := rw.claimWork(ctx)
+// processWork waits for a free slot, then claims as many work items as there
+// are free slots and starts processing them. It does not wait for the items to
+// finish, so every slot that frees up is filled by the next claim while the
+// other items still execute.
+func (rw *RemoteWorker) processWork(ctx context.Context) (int, error) {
+	select {
+	case rw.slots <- struct{}{}:
+	case <-ctx.Done():
+		return 0, nil
+	}
+
+	// Only the work loop takes slots, so the free slots stay free until the
+	// claimed items take them. Claims take no more items than there are free
+	// slots, so all of them start right away. Items left waiting would keep
+	// the time of their claim and be recovered by other workers.
+	free := cap(rw.slots) - len(rw.slots) + 1
+	workItems, err := rw.claimWork(ctx, free)
 	if err != nil {
-		return fmt.Errorf("failed to claim work: %w", err)
+		<-rw.slots
+		return 0, fmt.Errorf("failed to claim work: %w", err)
 	}
 
 	if len(workItems) == 0 {
-		return nil // No work available
+		<-rw.slots
+		return 0, nil // No work available
 	}
 
 	log.Printf("Claimed %d work items", len(workItems))
 
-	// Process each work item
-	for _, item := range workItems {
-		select {
-		case <-ctx.Done():
-			return nil
-		default:
+	for i, item := range workItems {
+		if i > 0 {
+			rw.slots <- struct{}{}
+		}
+
+		rw.inFlight.Add(1)
+		go func() {
+			defer func() {
+				<-rw.slots
+				rw.inFlight.Done()
+			}()
 			if err := rw.processWorkItem(ctx, item); err != nil {
 				log.Printf("Failed to process work item %s: %v", item.ID, err)
 			}
-		}
+		}()
 	}
 
-	return nil
+	return len(workItems), nil
 }
 
-// claimWork claims work items from the API server
-func (rw *RemoteWorker) claimWork(ctx context.Context) ([]*QueueItem, error) {
+// claimWork claims up to maxItems work items from the API server
+func (rw *RemoteWorker) claimWork(ctx context.Context, maxItems int) ([]*QueueItem, error) {
 	// Create the claim work request body
-	maxItems := rw.concurrency
+	waitSeconds := remoteClaimWaitSeconds
 	reqBody := client.ClaimWorkRequest{
-		MaxItems: &maxItems,
+		MaxItems:    &maxItems,
+		WaitSeconds: &waitSeconds,
 	}
 
 	resp, err := rw.apiClient.ClaimWorkWithResponse(ctx, rw.workerID, client.ClaimWorkJSONRequestBody(reqBody))
@@ -284,67 +385,323 @@ func (rw *RemoteWorker) processWorkItem(ctx context.Context, item *QueueItem) er
 	}
 
 	// Report the result back to the API server
-	return rw.completeWork(ctx, item.ID, result)
+	return rw.completeWork(ctx, item.ID, item.LeaseEpoch, result)
 }
 
 // processStartRun handles starting a workflow run
 func (rw *RemoteWorker) processStartRun(ctx context.Context, item *QueueItem) *WorkResult {
-	_ = ctx
-	// Implementation would depend on how the payload is structured
-	// For now, return success
-	log.Printf("Started run for item %s", item.ID)
+	resp, err := rw.apiClient.InitializeWorkerRunWithResponse(ctx, rw.workerID, item.RunID)
+	if err != nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("failed to initialize run: %v", err)),
+		}
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusGone:
+		// The run is no longer active, nothing left to do
+		log.Printf("Skipping start of inactive run %s", item.RunID)
+		return &WorkResult{Success: true}
+	default:
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("initialize run failed with status %d: %s", resp.StatusCode(), string(resp.Body))),
+		}
+	}
+
+	var nextSteps []uuid.UUID
+	if resp.JSON200 != nil {
+		nextSteps = resp.JSON200.NextSteps
+	}
+
+	log.Printf("Started run %s with %d ready steps", item.RunID, len(nextSteps))
 	return &WorkResult{
-		Success:    true,
-		OutputData: map[string]any{"action": "start_run", "item_id": item.ID.String()},
+		Success:   true,
+		NextSteps: nextSteps,
 	}
 }
 
 // processExecuteStep handles executing a workflow step
 func (rw *RemoteWorker) processExecuteStep(ctx context.Context, item *QueueItem) *WorkResult {
-	_ = ctx
-	// This would load the step details and execute the node
-	// For now, return success
-	log.Printf("Executed step for item %s", item.ID)
-	return &WorkResult{
-		Success:    true,
-		OutputData: map[string]any{"action": "execute_step", "item_id": item.ID.String()},
+	if item.StepID == nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer("step ID is required for execute_step"),
+		}
+	}
+
+	// Start the step to lease it and fetch its node configuration and input envelope
+	resp, err := rw.apiClient.StartWorkerStepWithResponse(ctx, rw.workerID, *item.StepID)
+	if err != nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("failed to start step: %v", err)),
+		}
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusConflict:
+		// Dependencies not ready, requeue for later
+		return &WorkResult{
+			Success:     false,
+			ShouldRetry: true,
+			RetryDelay:  durationPtr(30 * time.Second),
+		}
+	case http.StatusGone:
+		// The step already finished or its run is no longer active
+		return &WorkResult{Success: true}
+	default:
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("start step failed with status %d: %s", resp.StatusCode(), string(resp.Body))),
+		}
+	}
+
+	if resp.JSON200 == nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer("load step returned an empty response"),
+		}
 	}
+
+	stepCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rw.mu.Lock()
+	rw.runningSteps[resp.JSON200.Id] = runningStep{runID: resp.JSON200.RunId, lease: resp.JSON200.LeaseEpoch, cancel: cancel}
+	rw.mu.Unlock()
+
+	defer func() {
+		rw.mu.Lock()
+		delete(rw.runningSteps, resp.JSON200.Id)
+		rw.mu.Unlock()
+	}()
+
+	output, execErr := rw.executeStep(stepCtx, resp.JSON200)
+	log.Printf("Executed step %s (%s) for item %s", resp.JSON200.Id, resp.JSON200.NodeType, item.ID)
+
+	// Report the outcome so the server can persist it and find the next steps
+	return rw.reportStepResult(ctx, *item.StepID, resp.JSON200.LeaseEpoch, output, execErr)
+}
+
+// executeStep runs the node of a step fetched from the API server
+func (rw *RemoteWorker) executeStep(ctx context.Context, step *client.WorkerStep) (*api.Envelope[any], error) {
+	nodeDef := rw.mel.FindDefinition(step.NodeType)
+	if nodeDef == nil {
+		return nil, fmt.Errorf("node definition not found for type: %s", step.NodeType)
+	}
+
+	var input *api.Envelope[any]
+	if step.InputEnvelope != nil {
+		if err := convertEnvelope(*step.InputEnvelope, &input); err != nil {
+			return nil, fmt.Errorf("invalid input envelope: %w", err)
+		}
+	}
+
+	config := map[string]any{}
+	if step.NodeConfig != nil {
+		config = *step.NodeConfig
+	}
+
+	execCtx := api.ExecutionContext{
+		AgentID: step.RunId.String(), // Use run ID as agent context
+		RunID:   step.RunId.String(),
+		Mel:     rw.mel,
+	}
+
+	node := api.Node{
+		ID:   step.NodeId,
+		Type: step.NodeType,
+		Data: config,
+	}
+
+	settings, err := ParseNodeSettings(config)
+	if err != nil {
+		return nil, api.NewNodeErrorWithCause(step.NodeId, step.NodeType, err.Error(), api.ErrorCodeValidation, err)
+	}
+
+	return executeNode(ctx, nodeDef, execCtx, node, input, settings.Timeout())
+}
+
+// reportStepResult sends the outcome of a step execution to the API server
+func (rw *RemoteWorker) reportStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *api.Envelope[any], execErr error) *WorkResult {
+	reqBody := client.StepResultRequest{LeaseEpoch: lease}
+	if execErr != nil {
+		reqBody.Error = stringPointer(execErr.Error())
+		if code := api.ErrorCode(execErr); code != "" {
+			reqBody.ErrorCode = stringPointer(code)
+		}
+	} else if output != nil {
+		var envelope client.Envelope
+		if err := convertEnvelope(output, &envelope); err != nil {
+			return &WorkResult{
+				Success: false,
+				Error:   stringPointer(fmt.Sprintf("failed to encode output envelope: %v", err)),
+			}
+		}
+		reqBody.OutputEnvelope = &envelope
+	}
+
+	resp, err := rw.apiClient.ReportStepResultWithResponse(ctx, rw.workerID, stepID, client.ReportStepResultJSONRequestBody(reqBody))
+	if err != nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("failed to report step result: %v", err)),
+		}
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusGone:
+		// The run was cancelled or failed while the step executed
+		log.Printf("Discarded result of step %s of an inactive run", stepID)
+		return &WorkResult{Success: true}
+	case http.StatusConflict:
+		// The step was recovered and handed to another execution
+		log.Printf("Discarded result of step %s, its lease was lost", stepID)
+		return &WorkResult{Success: true}
+	}
+
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("report step result failed with status %d: %s", resp.StatusCode(), string(resp.Body))),
+		}
+	}
+
+	result := &WorkResult{
+		Success: resp.JSON200.Success,
+		Error:   resp.JSON200.Error,
+	}
+	if resp.JSON200.NextSteps != nil {
+		result.NextSteps = *resp.JSON200.NextSteps
+	}
+	if resp.JSON200.ShouldRetry != nil {
+		result.ShouldRetry = *resp.JSON200.ShouldRetry
+	}
+	if resp.JSON200.RetryDelayMs != nil {
+		result.RetryDelay = durationPtr(time.Duration(*resp.JSON200.RetryDelayMs) * time.Millisecond)
+	}
+
+	return result
+}
+
+// cancellationLoop periodically stops the steps of runs that were cancelled
+// or have otherwise finished while the steps execute
+func (rw *RemoteWorker) cancellationLoop(ctx context.Context) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := rw.cancelInactiveRuns(ctx); err != nil {
+				log.Printf("Failed to check for inactive runs: %v", err)
+			}
+		}
+	}
+}
+
+// cancelInactiveRuns asks the API server which runs of the executing steps
+// are no longer active and cancels the context of their steps
+func (rw *RemoteWorker) cancelInactiveRuns(ctx context.Context) error {
+	rw.mu.Lock()
+	seen := make(map[uuid.UUID]bool)
+	runIDs := make([]uuid.UUID, 0)
+	for _, step := range rw.runningSteps {
+		if !seen[step.runID] {
+			seen[step.runID] = true
+			runIDs = append(runIDs, step.runID)
+		}
+	}
+	rw.mu.Unlock()
+
+	if len(runIDs) == 0 {
+		return nil
+	}
+
+	resp, err := rw.apiClient.ListInactiveWorkerRunsWithResponse(ctx, rw.workerID, client.ListInactiveWorkerRunsJSONRequestBody{RunIds: runIDs})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return fmt.Errorf("check for inactive runs failed with status %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	inactive := make(map[uuid.UUID]bool, len(resp.JSON200.RunIds))
+	for _, runID := range resp.JSON200.RunIds {
+		inactive[runID] = true
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	for stepID, step := range rw.runningSteps {
+		if inactive[step.runID] {
+			log.Printf("Stopping step %s of inactive run %s", stepID, step.runID)
+			step.cancel()
+		}
+	}
+
+	return nil
 }
 
 // processRetryStep handles retrying a failed workflow step
 func (rw *RemoteWorker) processRetryStep(ctx context.Context, item *QueueItem) *WorkResult {
-	_ = ctx
-	// Implementation would retry the step
-	log.Printf("Retried step for item %s", item.ID)
-	return &WorkResult{
-		Success:    true,
-		OutputData: map[string]any{"action": "retry_step", "item_id": item.ID.String()},
-	}
+	// Retries execute the step again with its stored input
+	return rw.processExecuteStep(ctx, item)
 }
 
 // processCompleteRun handles completing a workflow run
 func (rw *RemoteWorker) processCompleteRun(ctx context.Context, item *QueueItem) *WorkResult {
-	_ = ctx
-	// Implementation would finalize the run
-	log.Printf("Completed run for item %s", item.ID)
-	return &WorkResult{
-		Success:    true,
-		OutputData: map[string]any{"action": "complete_run", "item_id": item.ID.String()},
+	resp, err := rw.apiClient.FinalizeWorkerRunWithResponse(ctx, rw.workerID, item.RunID)
+	if err != nil {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("failed to finalize run: %v", err)),
+		}
+	}
+
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return &WorkResult{
+			Success: false,
+			Error:   stringPointer(fmt.Sprintf("finalize run failed with status %d: %s", resp.StatusCode(), string(resp.Body))),
+		}
 	}
+
+	log.Printf("Completed run %s", item.RunID)
+	return &WorkResult{Success: true}
 }
 
 // completeWork reports the work result back to the API server
-func (rw *RemoteWorker) completeWork(ctx context.Context, itemID uuid.UUID, result *WorkResult) error {
+func (rw *RemoteWorker) completeWork(ctx context.Context, itemID uuid.UUID, lease int64, result *WorkResult) error {
 	// Convert internal WorkResult to client CompleteWorkJSONBody
-	reqBody := client.CompleteWorkRequest{}
+	reqBody := client.CompleteWorkRequest{LeaseEpoch: lease}
 
 	if result.Success {
 		if result.OutputData != nil {
 			reqBody.Result = &result.OutputData
 		}
+		if len(result.NextSteps) > 0 {
+			reqBody.NextSteps = &result.NextSteps
+		}
 	} else {
+		// A failure without a message still has to be reported as failure
+		errMsg := "work item failed"
 		if result.Error != nil {
-			reqBody.Error = result.Error
+			errMsg = *result.Error
+		}
+		reqBody.Error = &errMsg
+
+		if result.ShouldRetry {
+			reqBody.ShouldRetry = &result.ShouldRetry
+			if result.RetryDelay != nil {
+				delayMs := result.RetryDelay.Milliseconds()
+				reqBody.RetryDelayMs = &delayMs
+			}
 		}
 	}
 
@@ -353,6 +710,12 @@ func (rw *RemoteWorker) completeWork(ctx context.Context, itemID uuid.UUID, resu
 		return fmt.Errorf("failed to complete work: %w", err)
 	}
 
+	if resp.StatusCode() == http.StatusConflict {
+		// The claim was recovered and the item handed to another worker
+		log.Printf("Discarded result of work item %s, its lease was lost", itemID)
+		return nil
+	}
+
 	if resp.StatusCode() != http.StatusOK {
 		return fmt.Errorf("complete work failed with status %d: %s", resp.StatusCode(), string(resp.Body))
 	}
@@ -361,6 +724,16 @@ func (rw *RemoteWorker) completeWork(ctx context.Context, itemID uuid.UUID, resu
 	return nil
 }
 
+// convertEnvelope converts between the envelope representations of the API
+// client and the node runtime by round-tripping through JSON
+func convertEnvelope(from any, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
 // getVersion returns the application version (placeholder)
 func getVersion() *string {
 	version := "1.0.0"
@@ -432,5 +805,9 @@ func convertWorkItemToQueueItem(item *client.WorkItem) (*QueueItem, error) {
 		queueItem.CreatedAt = *item.CreatedAt
 	}
 
+	if item.LeaseEpoch != nil {
+		queueItem.LeaseEpoch = *item.LeaseEpoch
+	}
+
 	return queueItem, nil
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -23,6 +24,16 @@ type MockAPIServer struct {
 	completedWork     []*WorkResult
 	heartbeats        map[string]time.Time
 	validToken        string
+	steps             map[uuid.UUID]*WorkflowStep
+	stepResults       map[uuid.UUID]map[string]any
+	blockedSteps      map[uuid.UUID]bool
+	inactiveRuns      map[uuid.UUID]bool
+	initializedRuns   []uuid.UUID
+	finalizedRuns     []uuid.UUID
+	claimLimits       []int
+
+	// Workers send requests concurrently
+	mu sync.Mutex
 }
 
 func NewMockAPIServer() *MockAPIServer {
@@ -32,6 +43,10 @@ func NewMockAPIServer() *MockAPIServer {
 		completedWork:     make([]*WorkResult, 0),
 		heartbeats:        make(map[string]time.Time),
 		validToken:        "test-token", // Set a valid token for testing
+		steps:             make(map[uuid.UUID]*WorkflowStep),
+		stepResults:       make(map[uuid.UUID]map[string]any),
+		blockedSteps:      make(map[uuid.UUID]bool),
+		inactiveRuns:      make(map[uuid.UUID]bool),
 	}
 
 	// Helper function to validate token
@@ -61,9 +76,10 @@ func NewMockAPIServer() *MockAPIServer {
 
 		// Parse the RegisterWorkerRequest (new format)
 		var registerRequest struct {
-			ID          string  `json:"id"`
-			Name        *string `json:"name,omitempty"`
-			Concurrency *int    `json:"concurrency,omitempty"`
+			ID           string   `json:"id"`
+			Name         *string  `json:"name,omitempty"`
+			Concurrency  *int     `json:"concurrency,omitempty"`
+			Capabilities []string `json:"capabilities,omitempty"`
 		}
 		if err := json.NewDecoder(r.Body).Decode(&registerRequest); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
@@ -85,7 +101,7 @@ func NewMockAPIServer() *MockAPIServer {
 			Hostname:             hostname,
 			ProcessID:            nil,
 			Version:              nil,
-			Capabilities:         []string{"workflow_execution", "node_execution"},
+			Capabilities:         registerRequest.Capabilities,
 			Status:               WorkerStatusIdle,
 			LastHeartbeat:        time.Now(),
 			StartedAt:            time.Now(),
@@ -107,7 +123,7 @@ func NewMockAPIServer() *MockAPIServer {
 	mux.HandleFunc("/api/workers/", func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimPrefix(r.URL.Path, "/api/workers/")
 
-		if strings.HasSuffix(path, "/heartbeat") && r.Method == http.MethodPut {
+		if strings.HasSuffix(path, "/heartbeat") && !strings.Contains(path, "/steps/") && r.Method == http.MethodPut {
 			workerID := strings.TrimSuffix(path, "/heartbeat")
 
 			// Check authorization
@@ -145,6 +161,16 @@ func NewMockAPIServer() *MockAPIServer {
 				return
 			}
 
+			var claimRequest struct {
+				MaxItems *int `json:"maxItems,omitempty"`
+			}
+			json.NewDecoder(r.Body).Decode(&claimRequest)
+			maxItems := len(mock.workQueue)
+			if claimRequest.MaxItems != nil {
+				maxItems = *claimRequest.MaxItems
+				mock.claimLimits = append(mock.claimLimits, maxItems)
+			}
+
 			// Return available work items in WorkItem format (OpenAPI spec)
 			type WorkItem struct {
 				ID        *string                 `json:"id,omitempty"`
@@ -155,7 +181,7 @@ func NewMockAPIServer() *MockAPIServer {
 
 			availableWork := make([]WorkItem, 0)
 			for _, item := range mock.workQueue {
-				if item.ClaimedBy == nil {
+				if item.ClaimedBy == nil && len(availableWork) < maxItems {
 					item.ClaimedBy = &workerID
 					item.ClaimedAt = &time.Time{}
 					*item.ClaimedAt = time.Now()
@@ -187,6 +213,178 @@ func NewMockAPIServer() *MockAPIServer {
 			return
 		}
 
+		if strings.HasSuffix(path, "/inactive-runs") && r.Method == http.MethodPost {
+			// Check authorization
+			if !validateToken(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			var request struct {
+				RunIDs []uuid.UUID `json:"run_ids"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			inactive := make([]uuid.UUID, 0)
+			for _, runID := range request.RunIDs {
+				if mock.inactiveRuns[runID] {
+					inactive = append(inactive, runID)
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]any{"run_ids": inactive})
+			return
+		}
+
+		if strings.Contains(path, "/runs/") && r.Method == http.MethodPost {
+			parts := strings.Split(path, "/")
+			if len(parts) < 4 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			// Check authorization
+			if !validateToken(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			runID, err := uuid.Parse(parts[2])
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			switch parts[3] {
+			case "initialize":
+				mock.initializedRuns = append(mock.initializedRuns, runID)
+				nextSteps := make([]uuid.UUID, 0)
+				for _, step := range mock.steps {
+					if step.RunID == runID && len(step.DependsOn) == 0 {
+						nextSteps = append(nextSteps, step.ID)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]any{"next_steps": nextSteps})
+			case "finalize":
+				mock.finalizedRuns = append(mock.finalizedRuns, runID)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+			return
+		}
+
+		if strings.Contains(path, "/steps/") {
+			parts := strings.Split(path, "/")
+			if len(parts) < 3 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			// Check authorization
+			if !validateToken(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			stepID, err := uuid.Parse(parts[2])
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			step, exists := mock.steps[stepID]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			if len(parts) == 4 && parts[3] == "start" && r.Method == http.MethodPost {
+				if mock.blockedSteps[stepID] {
+					w.WriteHeader(http.StatusConflict)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]any{
+					"id":             step.ID,
+					"run_id":         step.RunID,
+					"node_id":        step.NodeID,
+					"node_type":      step.NodeType,
+					"node_config":    step.NodeConfig,
+					"input_envelope": step.InputEnvelope,
+					"attempt_count":  step.AttemptCount,
+					"max_attempts":   step.MaxAttempts,
+					"lease_epoch":    step.LeaseEpoch,
+				})
+				return
+			}
+
+			if len(parts) == 4 && parts[3] == "heartbeat" && r.Method == http.MethodPut {
+				var heartbeatRequest struct {
+					LeaseEpoch int64 `json:"lease_epoch"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&heartbeatRequest); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if heartbeatRequest.LeaseEpoch != step.LeaseEpoch {
+					w.WriteHeader(http.StatusConflict)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if len(parts) == 4 && parts[3] == "result" && r.Method == http.MethodPost {
+				if mock.inactiveRuns[step.RunID] {
+					w.WriteHeader(http.StatusGone)
+					return
+				}
+
+				var resultRequest map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&resultRequest); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				mock.stepResults[stepID] = resultRequest
+
+				response := map[string]any{"success": resultRequest["error"] == nil}
+				if errMsg, ok := resultRequest["error"].(string); ok {
+					response["error"] = errMsg
+					response["should_retry"] = step.AttemptCount+1 < step.MaxAttempts
+					response["retry_delay_ms"] = 1000
+				} else {
+					// Steps that depend on the reported step become ready
+					nextSteps := make([]uuid.UUID, 0)
+					for _, candidate := range mock.steps {
+						for _, dependencyID := range candidate.DependsOn {
+							if dependencyID == stepID {
+								nextSteps = append(nextSteps, candidate.ID)
+							}
+						}
+					}
+					response["next_steps"] = nextSteps
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(response)
+				return
+			}
+
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
 		if strings.Contains(path, "/complete-work/") && r.Method == http.MethodPost {
 			parts := strings.Split(path, "/")
 			if len(parts) < 3 {
@@ -215,8 +413,11 @@ func NewMockAPIServer() *MockAPIServer {
 
 			// Parse CompleteWorkJSONBody format (OpenAPI spec)
 			var completeRequest struct {
-				Error  *string                 `json:"error,omitempty"`
-				Result *map[string]interface{} `json:"result,omitempty"`
+				Error        *string                 `json:"error,omitempty"`
+				Result       *map[string]interface{} `json:"result,omitempty"`
+				NextSteps    []uuid.UUID             `json:"next_steps,omitempty"`
+				ShouldRetry  *bool                   `json:"should_retry,omitempty"`
+				RetryDelayMs *int64                  `json:"retry_delay_ms,omitempty"`
 			}
 			if err := json.NewDecoder(r.Body).Decode(&completeRequest); err != nil {
 				w.WriteHeader(http.StatusBadRequest)
@@ -233,6 +434,13 @@ func NewMockAPIServer() *MockAPIServer {
 			if completeRequest.Result != nil {
 				result.OutputData = *completeRequest.Result
 			}
+			result.NextSteps = completeRequest.NextSteps
+			if completeRequest.ShouldRetry != nil {
+				result.ShouldRetry = *completeRequest.ShouldRetry
+			}
+			if completeRequest.RetryDelayMs != nil {
+				result.RetryDelay = durationPtr(time.Duration(*completeRequest.RetryDelayMs) * time.Millisecond)
+			}
 
 			// Find and remove the work item
 			found := false
@@ -277,7 +485,11 @@ func NewMockAPIServer() *MockAPIServer {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	mock.server = httptest.NewServer(mux)
+	mock.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
 	return mock
 }
 
@@ -290,21 +502,103 @@ func (m *MockAPIServer) URL() string {
 }
 
 func (m *MockAPIServer) AddWorkItem(item *QueueItem) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.workQueue = append(m.workQueue, item)
 }
 
+func (m *MockAPIServer) AddStep(step *WorkflowStep) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.steps[step.ID] = step
+}
+
+func (m *MockAPIServer) BlockStep(stepID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockedSteps[stepID] = true
+}
+
+func (m *MockAPIServer) MarkRunInactive(runID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inactiveRuns[runID] = true
+}
+
+func (m *MockAPIServer) GetStepResult(stepID uuid.UUID) map[string]any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stepResults[stepID]
+}
+
 func (m *MockAPIServer) GetRegisteredWorkers() map[string]*WorkflowWorker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	return m.registeredWorkers
 }
 
 func (m *MockAPIServer) GetCompletedWork() []*WorkResult {
-	return m.completedWork
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*WorkResult(nil), m.completedWork...)
+}
+
+func (m *MockAPIServer) GetClaimLimits() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]int(nil), m.claimLimits...)
 }
 
 func (m *MockAPIServer) GetLastHeartbeat(workerID string) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	return m.heartbeats[workerID]
 }
 
+// echoNode is a node definition that echoes its input with the configured greeting
+type echoNode struct{}
+
+func (echoNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_echo", Label: "Echo", Category: "Test"}
+}
+
+func (echoNode) Initialize(mel api.Mel) error { return nil }
+
+func (echoNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	if node.Data["fail"] == true {
+		code, _ := node.Data["code"].(string)
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, "echo failed", code)
+	}
+
+	result := envelope.Clone()
+	result.Data = map[string]any{
+		"greeting": node.Data["greeting"],
+		"input":    envelope.Data,
+	}
+	return result, nil
+}
+
+// newTestStep creates a step of the echo node with an input envelope
+func newTestStep(runID uuid.UUID, config map[string]any) *WorkflowStep {
+	return &WorkflowStep{
+		ID:          uuid.New(),
+		RunID:       runID,
+		NodeID:      "echo",
+		NodeType:    "test_echo",
+		Status:      StepStatusPending,
+		MaxAttempts: 3,
+		NodeConfig:  config,
+		InputEnvelope: &api.Envelope[any]{
+			ID:       "input-1",
+			IssuedAt: time.Now(),
+			Version:  1,
+			DataType: "object",
+			Data:     map[string]any{"name": "mel"},
+			Trace:    api.Trace{RunID: runID.String(), NodeID: "echo"},
+		},
+	}
+}
+
 // Test remote worker registration
 func TestRemoteWorkerRegistration(t *testing.T) {
 	mockServer := NewMockAPIServer()
@@ -337,8 +631,7 @@ func TestRemoteWorkerRegistration(t *testing.T) {
 	assert.Equal(t, "test-worker-1", registeredWorker.ID)
 	assert.Equal(t, WorkerStatusIdle, registeredWorker.Status)
 	assert.Equal(t, 5, registeredWorker.MaxConcurrentSteps)
-	assert.Contains(t, registeredWorker.Capabilities, "workflow_execution")
-	assert.Contains(t, registeredWorker.Capabilities, "node_execution")
+	assert.Equal(t, []string{"*"}, registeredWorker.Capabilities)
 
 	// Cancel context to stop worker
 	cancel()
@@ -402,10 +695,17 @@ func TestRemoteWorkerWorkProcessing(t *testing.T) {
 	mockServer := NewMockAPIServer()
 	defer mockServer.Close()
 
+	runID := uuid.New()
+	step := newTestStep(runID, map[string]any{"greeting": "hello"})
+	nextStep := &WorkflowStep{ID: uuid.New(), RunID: runID, NodeID: "next", NodeType: "test_echo", DependsOn: []uuid.UUID{step.ID}}
+	mockServer.AddStep(step)
+	mockServer.AddStep(nextStep)
+
 	// Add some work items to the server
 	workItem1 := &QueueItem{
 		ID:        uuid.New(),
-		RunID:     uuid.New(),
+		RunID:     runID,
+		StepID:    &step.ID,
 		QueueType: QueueTypeExecuteStep,
 		Priority:  5,
 		CreatedAt: time.Now(),
@@ -413,7 +713,7 @@ func TestRemoteWorkerWorkProcessing(t *testing.T) {
 	}
 	workItem2 := &QueueItem{
 		ID:        uuid.New(),
-		RunID:     uuid.New(),
+		RunID:     runID,
 		QueueType: QueueTypeStartRun,
 		Priority:  10,
 		CreatedAt: time.Now(),
@@ -423,6 +723,7 @@ func TestRemoteWorkerWorkProcessing(t *testing.T) {
 	mockServer.AddWorkItem(workItem2)
 
 	mel := api.NewMel()
+	mel.RegisterNodeDefinition(echoNode{})
 	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-process", mel, 2)
 	require.NoError(t, err)
 
@@ -461,9 +762,19 @@ func TestRemoteWorkerWorkProcessing(t *testing.T) {
 	assert.Len(t, completedWork, 2, "Both work items should be processed")
 	for _, result := range completedWork {
 		assert.True(t, result.Success, "Work should be completed successfully")
-		assert.NotNil(t, result.OutputData, "Work result should contain output data")
+		assert.NotEmpty(t, result.NextSteps, "Work result should contain the next steps")
 	}
 
+	// The step was executed locally and its output envelope reported
+	stepResult := mockServer.GetStepResult(step.ID)
+	require.NotNil(t, stepResult, "Step result should be reported")
+	output, ok := stepResult["output_envelope"].(map[string]any)
+	require.True(t, ok, "Step result should contain the output envelope")
+	data, ok := output["data"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "hello", data["greeting"])
+	assert.Equal(t, map[string]any{"name": "mel"}, data["input"])
+
 	t.Logf("✅ Remote worker work processing test passed - Processed %d work items", len(completedWork))
 }
 
@@ -647,9 +958,14 @@ func TestRemoteWorkerWorkResults(t *testing.T) {
 	defer mockServer.Close()
 
 	// Add work items that would produce different results
+	runID := uuid.New()
+	step := newTestStep(runID, map[string]any{"greeting": "hi"})
+	mockServer.AddStep(step)
+
 	workItem := &QueueItem{
 		ID:        uuid.New(),
-		RunID:     uuid.New(),
+		RunID:     runID,
+		StepID:    &step.ID,
 		QueueType: QueueTypeExecuteStep,
 		Priority:  5,
 		CreatedAt: time.Now(),
@@ -658,6 +974,7 @@ func TestRemoteWorkerWorkResults(t *testing.T) {
 	mockServer.AddWorkItem(workItem)
 
 	mel := api.NewMel()
+	mel.RegisterNodeDefinition(echoNode{})
 	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-results", mel, 1)
 	require.NoError(t, err)
 
@@ -688,8 +1005,336 @@ func TestRemoteWorkerWorkResults(t *testing.T) {
 
 	result := completedWork[0]
 	assert.True(t, result.Success, "Work should be successful")
-	// Note: The actual result content depends on the implementation in remote_worker.go
-	// The current implementation returns success for all work types
 
 	t.Logf("✅ Remote worker work results test passed - Work completed with success: %v", result.Success)
 }
+
+// runRemoteWorker runs a remote worker against the mock server until the given work was completed
+func runRemoteWorker(t *testing.T, mockServer *MockAPIServer, mel api.Mel, workerID string, expected int) []*WorkResult {
+	t.Helper()
+
+	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", workerID, mel, 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	workerErr := make(chan error, 1)
+	go func() {
+		workerErr <- worker.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-workerErr:
+	case <-time.After(1 * time.Second):
+		t.Fatal("Worker did not stop within timeout")
+	}
+
+	completedWork := mockServer.GetCompletedWork()
+	require.Len(t, completedWork, expected)
+	return completedWork
+}
+
+// Test that node failures are reported and retried as decided by the server
+func TestRemoteWorkerStepFailure(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	runID := uuid.New()
+	step := newTestStep(runID, map[string]any{"fail": true, "code": api.ErrorCodeTimeout})
+	mockServer.AddStep(step)
+	mockServer.AddWorkItem(&QueueItem{
+		ID:        uuid.New(),
+		RunID:     runID,
+		StepID:    &step.ID,
+		QueueType: QueueTypeExecuteStep,
+		CreatedAt: time.Now(),
+	})
+
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(echoNode{})
+
+	completedWork := runRemoteWorker(t, mockServer, mel, "test-worker-failure", 1)
+	result := completedWork[0]
+	assert.False(t, result.Success)
+	require.NotNil(t, result.Error)
+	assert.Contains(t, *result.Error, "echo failed")
+	assert.True(t, result.ShouldRetry)
+	require.NotNil(t, result.RetryDelay)
+	assert.Equal(t, time.Second, *result.RetryDelay)
+
+	stepResult := mockServer.GetStepResult(step.ID)
+	require.NotNil(t, stepResult)
+	assert.Equal(t, "echo failed", stepResult["error"])
+	assert.Equal(t, api.ErrorCodeTimeout, stepResult["error_code"])
+}
+
+// Test that steps with pending dependencies are requeued without execution
+func TestRemoteWorkerStepNotReady(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	runID := uuid.New()
+	step := newTestStep(runID, nil)
+	mockServer.AddStep(step)
+	mockServer.BlockStep(step.ID)
+	mockServer.AddWorkItem(&QueueItem{
+		ID:        uuid.New(),
+		RunID:     runID,
+		StepID:    &step.ID,
+		QueueType: QueueTypeExecuteStep,
+		CreatedAt: time.Now(),
+	})
+
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(echoNode{})
+
+	completedWork := runRemoteWorker(t, mockServer, mel, "test-worker-blocked", 1)
+	result := completedWork[0]
+	assert.False(t, result.Success)
+	assert.True(t, result.ShouldRetry)
+	require.NotNil(t, result.RetryDelay)
+	assert.Equal(t, 30*time.Second, *result.RetryDelay)
+	assert.Nil(t, mockServer.GetStepResult(step.ID), "Blocked step should not be executed")
+}
+
+// Test that results of steps whose run became inactive are discarded
+func TestRemoteWorkerDiscardsResultOfInactiveRun(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	runID := uuid.New()
+	step := newTestStep(runID, nil)
+	mockServer.AddStep(step)
+	mockServer.MarkRunInactive(runID)
+	mockServer.AddWorkItem(&QueueItem{
+		ID:        uuid.New(),
+		RunID:     runID,
+		StepID:    &step.ID,
+		QueueType: QueueTypeExecuteStep,
+		CreatedAt: time.Now(),
+	})
+
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(echoNode{})
+
+	completedWork := runRemoteWorker(t, mockServer, mel, "test-worker-inactive", 1)
+	result := completedWork[0]
+	assert.True(t, result.Success, "Work of inactive runs should be dropped")
+	assert.Empty(t, result.NextSteps)
+	assert.Nil(t, mockServer.GetStepResult(step.ID), "Result should not be recorded")
+}
+
+// Test that steps of inactive runs are cancelled
+func TestRemoteWorkerCancelInactiveRuns(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-cancel", api.NewMel(), 2)
+	require.NoError(t, err)
+
+	cancelledRun, activeRun := uuid.New(), uuid.New()
+	mockServer.MarkRunInactive(cancelledRun)
+
+	contexts := make(map[uuid.UUID]context.Context)
+	for _, runID := range []uuid.UUID{cancelledRun, activeRun} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		worker.runningSteps[uuid.New()] = runningStep{runID: runID, cancel: cancel}
+		contexts[runID] = ctx
+	}
+
+	require.NoError(t, worker.cancelInactiveRuns(context.Background()))
+
+	assert.ErrorIs(t, contexts[cancelledRun].Err(), context.Canceled)
+	assert.NoError(t, contexts[activeRun].Err())
+}
+
+func TestRemoteWorkerStepHeartbeats(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-step-heartbeats", api.NewMel(), 2)
+	require.NoError(t, err)
+
+	current := newTestStep(uuid.New(), nil)
+	current.LeaseEpoch = 2
+	lost := newTestStep(uuid.New(), nil)
+	lost.LeaseEpoch = 2
+	mockServer.AddStep(current)
+	mockServer.AddStep(lost)
+
+	contexts := make(map[uuid.UUID]context.Context)
+	for _, step := range []*WorkflowStep{current, lost} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		lease := step.LeaseEpoch
+		if step == lost {
+			// Another execution took the step over since
+			lease = 1
+		}
+		worker.runningSteps[step.ID] = runningStep{runID: step.RunID, lease: lease, cancel: cancel}
+		contexts[step.ID] = ctx
+	}
+
+	worker.sendStepHeartbeats(context.Background())
+
+	assert.NoError(t, contexts[current.ID].Err())
+	assert.ErrorIs(t, contexts[lost.ID].Err(), context.Canceled, "steps that lost their lease are stopped")
+}
+
+// barrierNode is a node definition whose executions wait for each other, so
+// they only succeed when they run concurrently
+type barrierNode struct {
+	arrived *sync.WaitGroup
+}
+
+func (barrierNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_barrier", Label: "Barrier", Category: "Test"}
+}
+
+func (barrierNode) Initialize(mel api.Mel) error { return nil }
+
+func (n barrierNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	n.arrived.Done()
+
+	done := make(chan struct{})
+	go func() {
+		n.arrived.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return envelope.Clone(), nil
+	case <-time.After(time.Second):
+		return nil, api.NewNodeError(node.ID, node.Type, "executions did not run concurrently")
+	}
+}
+
+// Test that the claimed work items run concurrently, so that none of them
+// waits with an expiring claim
+func TestRemoteWorkerProcessesClaimedItemsConcurrently(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	var arrived sync.WaitGroup
+	arrived.Add(2)
+
+	runID := uuid.New()
+	for i := 0; i < 2; i++ {
+		step := newTestStep(runID, nil)
+		step.NodeType = "test_barrier"
+		mockServer.AddStep(step)
+		mockServer.AddWorkItem(&QueueItem{
+			ID:        uuid.New(),
+			RunID:     runID,
+			StepID:    &step.ID,
+			QueueType: QueueTypeExecuteStep,
+			CreatedAt: time.Now(),
+		})
+	}
+
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(barrierNode{arrived: &arrived})
+
+	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-concurrent", mel, 2)
+	require.NoError(t, err)
+	worker.workerInfo = &WorkflowWorker{ID: "test-worker-concurrent"}
+	require.NoError(t, worker.registerWorker(context.Background()))
+
+	claimed, err := worker.processWork(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, claimed)
+	worker.inFlight.Wait()
+
+	completedWork := mockServer.GetCompletedWork()
+	require.Len(t, completedWork, 2)
+	for _, result := range completedWork {
+		assert.True(t, result.Success, "Claimed items should execute concurrently")
+	}
+}
+
+// gateNode is a node definition whose executions wait until they are released
+type gateNode struct {
+	release chan struct{}
+}
+
+func (gateNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_gate", Label: "Gate", Category: "Test"}
+}
+
+func (gateNode) Initialize(mel api.Mel) error { return nil }
+
+func (n gateNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	<-n.release
+	return envelope.Clone(), nil
+}
+
+// Test that the worker claims work as soon as a slot frees up, without waiting
+// for the rest of the claimed items to finish
+func TestRemoteWorkerClaimsFreeSlots(t *testing.T) {
+	mockServer := NewMockAPIServer()
+	defer mockServer.Close()
+
+	runID := uuid.New()
+	for i := 0; i < 3; i++ {
+		step := newTestStep(runID, nil)
+		step.NodeType = "test_gate"
+		mockServer.AddStep(step)
+		mockServer.AddWorkItem(&QueueItem{
+			ID:        uuid.New(),
+			RunID:     runID,
+			StepID:    &step.ID,
+			QueueType: QueueTypeExecuteStep,
+			CreatedAt: time.Now(),
+		})
+	}
+
+	release := make(chan struct{})
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(gateNode{release: release})
+
+	worker, err := NewRemoteWorker(mockServer.URL(), "test-token", "test-worker-slots", mel, 2)
+	require.NoError(t, err)
+	worker.workerInfo = &WorkflowWorker{ID: "test-worker-slots"}
+	require.NoError(t, worker.registerWorker(context.Background()))
+
+	claimed, err := worker.processWork(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, claimed)
+
+	// With every slot taken, the next claim waits for a slot
+	next := make(chan int, 1)
+	go func() {
+		claimed, err := worker.processWork(context.Background())
+		assert.NoError(t, err)
+		next <- claimed
+	}()
+
+	select {
+	case <-next:
+		t.Fatal("Worker claimed work without a free slot")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Equal(t, []int{2}, mockServer.GetClaimLimits())
+
+	// Finishing one item frees the slot for the last item
+	release <- struct{}{}
+	select {
+	case claimed := <-next:
+		assert.Equal(t, 1, claimed)
+	case <-time.After(time.Second):
+		t.Fatal("Worker did not claim work when a slot freed up")
+	}
+	assert.Equal(t, []int{2, 1}, mockServer.GetClaimLimits())
+
+	close(release)
+	worker.inFlight.Wait()
+	assert.Len(t, mockServer.GetCompletedWork(), 3)
+}
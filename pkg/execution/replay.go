@@ -0,0 +1,223 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+)
+
+// replaySource is the state a node reached in the run being replayed
+type replaySource struct {
+	Status         StepStatus
+	SelectedBranch *string
+	InputEnvelope  *api.Envelope[any]
+	OutputEnvelope *api.Envelope[any]
+	// PerItem is set for nodes that ran once per split item. Their status
+	// is completed once every item finished.
+	PerItem bool
+}
+
+// ReplayRun starts a new run of the workflow of a run that executes the given
+// node and everything downstream of it again. The other nodes keep the
+// outputs checkpointed by the original run and are not executed again. The
+// node receives the given input envelope, or the input it received in the
+// original run.
+func (e *DurableExecutionEngine) ReplayRun(ctx context.Context, runID uuid.UUID, nodeID string, input *api.Envelope[any]) (*WorkflowRun, error) {
+	original, err := e.loadWorkflowRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &WorkflowRun{
+		ID:                uuid.New(),
+		AgentID:           original.AgentID,
+		WorkflowID:        original.WorkflowID,
+		VersionID:         original.VersionID,
+		Status:            RunStatusPending,
+		InputData:         original.InputData,
+		Variables:         original.Variables,
+		TimeoutSeconds:    original.TimeoutSeconds,
+		RetryPolicy:       original.RetryPolicy,
+		ReplaySourceRunID: &runID,
+	}
+
+	graph, err := e.loadWorkflowGraph(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow graph: %w", err)
+	}
+
+	sources, err := e.loadReplaySources(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		input.Trace.RunID = run.ID.String()
+	}
+	if err := planReplay(graph, nodeID, sources, input); err != nil {
+		return nil, err
+	}
+
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The steps exist before the run starts, so initializing the run picks
+	// up at the replayed node
+	if err := e.createRunTx(ctx, tx, run); err != nil {
+		return nil, err
+	}
+	if err := e.insertWorkflowStepsTx(ctx, tx, run, graph); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit replay: %w", err)
+	}
+
+	return run, nil
+}
+
+// loadReplaySources loads the state of each node of a run. Outputs come from
+// the post-execution checkpoints, falling back to the output stored on the
+// step.
+func (e *DurableExecutionEngine) loadReplaySources(ctx context.Context, runID uuid.UUID) (map[string]*replaySource, error) {
+	query := `
+		SELECT s.node_id, s.split_path, s.status, s.selected_branch, s.input_envelope,
+		       COALESCE(c.execution_context, s.output_envelope)
+		FROM workflow_steps s
+		LEFT JOIN LATERAL (
+			SELECT execution_context FROM workflow_checkpoints
+			WHERE step_id = s.id AND checkpoint_type = 'post_execution'
+			ORDER BY created_at DESC
+			LIMIT 1
+		) c ON true
+		WHERE s.run_id = $1`
+
+	rows, err := e.db.QueryContext(ctx, query, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load steps of run: %w", err)
+	}
+	defer rows.Close()
+
+	sources := make(map[string]*replaySource)
+	for rows.Next() {
+		var nodeID, splitPath string
+		var status StepStatus
+		var branch *string
+		var inputJSON, outputJSON []byte
+		if err := rows.Scan(&nodeID, &splitPath, &status, &branch, &inputJSON, &outputJSON); err != nil {
+			return nil, fmt.Errorf("failed to scan step: %w", err)
+		}
+
+		// Nodes that ran per split item only report whether all items finished
+		if splitPath != "" {
+			source, ok := sources[nodeID]
+			if !ok {
+				source = &replaySource{Status: StepStatusCompleted, PerItem: true}
+				sources[nodeID] = source
+			}
+			if status != StepStatusCompleted && status != StepStatusSkipped {
+				source.Status = status
+			}
+			continue
+		}
+
+		source := &replaySource{Status: status, SelectedBranch: branch}
+		if len(inputJSON) > 0 {
+			if err := json.Unmarshal(inputJSON, &source.InputEnvelope); err != nil {
+				return nil, fmt.Errorf("failed to parse input envelope: %w", err)
+			}
+		}
+		if len(outputJSON) > 0 {
+			if err := json.Unmarshal(outputJSON, &source.OutputEnvelope); err != nil {
+				return nil, fmt.Errorf("failed to parse output envelope: %w", err)
+			}
+		}
+		sources[nodeID] = source
+	}
+
+	return sources, rows.Err()
+}
+
+// planReplay prepares the steps of a replay run. The node and the nodes
+// downstream of it stay pending. Other nodes that finished in the original
+// run take over its outcome, the rest execute again.
+func planReplay(graph *WorkflowGraph, nodeID string, sources map[string]*replaySource, input *api.Envelope[any]) error {
+	var start *WorkflowStep
+	children := make(map[uuid.UUID][]*WorkflowStep)
+	for _, step := range graph.Steps {
+		if step.NodeID == nodeID {
+			start = step
+		}
+		for _, dependencyID := range step.DependsOn {
+			children[dependencyID] = append(children[dependencyID], step)
+		}
+	}
+	if start == nil {
+		return ErrNodeNotFound
+	}
+
+	downstream := map[uuid.UUID]bool{start.ID: true}
+	pending := []*WorkflowStep{start}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, child := range children[current.ID] {
+			if !downstream[child.ID] {
+				downstream[child.ID] = true
+				pending = append(pending, child)
+			}
+		}
+	}
+
+	if source := sources[nodeID]; source != nil && source.PerItem {
+		return fmt.Errorf("%w: node %s runs per split item", ErrReplayNotPossible, nodeID)
+	}
+
+	byID := make(map[uuid.UUID]*WorkflowStep, len(graph.Steps))
+	for _, step := range graph.Steps {
+		byID[step.ID] = step
+		if downstream[step.ID] {
+			continue
+		}
+
+		source := sources[step.NodeID]
+		if source == nil {
+			continue
+		}
+		switch source.Status {
+		case StepStatusCompleted:
+			step.Status = StepStatusCompleted
+			step.SelectedBranch = source.SelectedBranch
+			step.InputEnvelope = source.InputEnvelope
+			step.OutputEnvelope = source.OutputEnvelope
+		case StepStatusSkipped:
+			step.Status = StepStatusSkipped
+		default:
+			// A split that did not finish cannot resume without its splitter
+			if source.PerItem {
+				return fmt.Errorf("%w: node %s upstream did not finish for every split item", ErrReplayNotPossible, step.NodeID)
+			}
+		}
+	}
+
+	switch {
+	case input != nil:
+		start.InputEnvelope = input
+	case sources[nodeID] != nil && sources[nodeID].InputEnvelope != nil:
+		start.InputEnvelope = sources[nodeID].InputEnvelope
+	}
+
+	// The node has to be reachable from the outcome of the nodes before it
+	if live, resolved := dependencyState(start, byID); resolved && len(start.DependsOn) > 0 && len(live) == 0 {
+		return fmt.Errorf("%w: node %s was not reached by the original run", ErrReplayNotPossible, nodeID)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// replayGraph builds a workflow that checks an order and either charges it
+// and sends a receipt, or rejects it
+func replayGraph(t *testing.T) *WorkflowGraph {
+	t.Helper()
+	approved, rejected := "true", "false"
+	graph, err := BuildWorkflowGraph(uuid.New(), &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "check", Type: "if"},
+			{ID: "charge", Type: "http_request"},
+			{ID: "receipt", Type: "email"},
+			{ID: "reject", Type: "email"},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "check", Target: "charge", SourceOutput: &approved},
+			{ID: "e2", Source: "charge", Target: "receipt"},
+			{ID: "e3", Source: "check", Target: "reject", SourceOutput: &rejected},
+		},
+	})
+	require.NoError(t, err)
+	return graph
+}
+
+func replayStep(graph *WorkflowGraph, nodeID string) *WorkflowStep {
+	for _, step := range graph.Steps {
+		if step.NodeID == nodeID {
+			return step
+		}
+	}
+	return nil
+}
+
+func TestPlanReplay(t *testing.T) {
+	branch := "true"
+	checkOutput := core.NewGenericEnvelope(map[string]any{"order": 42}, api.Trace{NodeID: "check"})
+	chargeInput := core.NewGenericEnvelope(map[string]any{"order": 42}, api.Trace{NodeID: "charge"})
+	sources := map[string]*replaySource{
+		"check":  {Status: StepStatusCompleted, SelectedBranch: &branch, OutputEnvelope: checkOutput},
+		"charge": {Status: StepStatusFailed, InputEnvelope: chargeInput},
+		"reject": {Status: StepStatusSkipped},
+	}
+
+	t.Run("reuses upstream outputs", func(t *testing.T) {
+		graph := replayGraph(t)
+		require.NoError(t, planReplay(graph, "charge", sources, nil))
+
+		check := replayStep(graph, "check")
+		assert.Equal(t, StepStatusCompleted, check.Status)
+		assert.Same(t, checkOutput, check.OutputEnvelope)
+		assert.Equal(t, &branch, check.SelectedBranch)
+		assert.Equal(t, StepStatusSkipped, replayStep(graph, "reject").Status)
+
+		charge := replayStep(graph, "charge")
+		assert.Equal(t, StepStatusPending, charge.Status)
+		assert.Same(t, chargeInput, charge.InputEnvelope)
+		assert.Equal(t, StepStatusPending, replayStep(graph, "receipt").Status)
+	})
+
+	t.Run("edited input", func(t *testing.T) {
+		graph := replayGraph(t)
+		edited := core.NewGenericEnvelope(map[string]any{"order": 43}, api.Trace{NodeID: "charge"})
+		require.NoError(t, planReplay(graph, "charge", sources, edited))
+
+		assert.Same(t, edited, replayStep(graph, "charge").InputEnvelope)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		err := planReplay(replayGraph(t), "refund", sources, nil)
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+
+	t.Run("node not reached", func(t *testing.T) {
+		err := planReplay(replayGraph(t), "reject", sources, nil)
+		assert.ErrorIs(t, err, ErrReplayNotPossible)
+	})
+
+	t.Run("unfinished upstream runs again", func(t *testing.T) {
+		graph := replayGraph(t)
+		require.NoError(t, planReplay(graph, "receipt", sources, nil))
+
+		assert.Equal(t, StepStatusPending, replayStep(graph, "charge").Status)
+		assert.Equal(t, StepStatusPending, replayStep(graph, "receipt").Status)
+	})
+
+	t.Run("per item node", func(t *testing.T) {
+		perItem := map[string]*replaySource{
+			"check":  sources["check"],
+			"charge": {Status: StepStatusCompleted, PerItem: true},
+		}
+		err := planReplay(replayGraph(t), "charge", perItem, nil)
+		assert.ErrorIs(t, err, ErrReplayNotPossible)
+	})
+
+	t.Run("unfinished per item upstream", func(t *testing.T) {
+		perItem := map[string]*replaySource{
+			"check":  sources["check"],
+			"charge": {Status: StepStatusFailed, PerItem: true},
+		}
+		err := planReplay(replayGraph(t), "receipt", perItem, nil)
+		assert.ErrorIs(t, err, ErrReplayNotPossible)
+	})
+}
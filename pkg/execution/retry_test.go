@@ -0,0 +1,74 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	codedError := func(code string) error {
+		return api.NewNodeErrorWithCode("node", "http_request", "request failed", code)
+	}
+
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		err     error
+		attempt int
+		want    bool
+	}{
+		{"unclassified error", DefaultRetryPolicy(), errors.New("boom"), 1, true},
+		{"attempts exhausted", DefaultRetryPolicy(), errors.New("boom"), 3, false},
+		{"timeout", DefaultRetryPolicy(), codedError(api.ErrorCodeTimeout), 1, true},
+		{"deadline exceeded", DefaultRetryPolicy(), fmt.Errorf("call: %w", context.DeadlineExceeded), 1, true},
+		{"upstream server error", DefaultRetryPolicy(), codedError(api.ErrorCodeUpstream), 1, true},
+		{"bad request", DefaultRetryPolicy(), codedError(api.ErrorCodeBadRequest), 1, false},
+		{"validation", DefaultRetryPolicy(), codedError(api.ErrorCodeValidation), 1, false},
+		{
+			name:    "validation listed as retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, RetryableErrors: []string{api.ErrorCodeValidation}},
+			err:     codedError(api.ErrorCodeValidation),
+			attempt: 1,
+			want:    false,
+		},
+		{
+			name:    "client error listed as retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, RetryableErrors: []string{api.ErrorCodeNotFound}},
+			err:     codedError(api.ErrorCodeNotFound),
+			attempt: 1,
+			want:    true,
+		},
+		{
+			name:    "code not listed as retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, RetryableErrors: []string{api.ErrorCodeTimeout}},
+			err:     codedError(api.ErrorCodeUpstream),
+			attempt: 1,
+			want:    false,
+		},
+		{
+			name:    "code listed as non-retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, NonRetryableErrors: []string{api.ErrorCodeRateLimited}},
+			err:     codedError(api.ErrorCodeRateLimited),
+			attempt: 1,
+			want:    false,
+		},
+		{
+			name:    "message listed as non-retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, NonRetryableErrors: []string{"boom"}},
+			err:     errors.New("boom"),
+			attempt: 1,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsRetryable(tt.err, tt.attempt))
+		})
+	}
+}
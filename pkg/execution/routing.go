@@ -0,0 +1,233 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// dependencyState evaluates the dependencies of a step. A dependency is live
+// when it completed and its edge to the step was taken. Dependencies that
+// were skipped or selected another branch are dead. resolved reports whether
+// every dependency has finished.
+func dependencyState(step *WorkflowStep, deps map[uuid.UUID]*WorkflowStep) (live []uuid.UUID, resolved bool) {
+	resolved = true
+	for _, dependencyID := range step.DependsOn {
+		dep, ok := deps[dependencyID]
+		if !ok {
+			resolved = false
+			continue
+		}
+
+		switch dep.Status {
+		case StepStatusCompleted:
+			if edgeTaken(step, dep) {
+				live = append(live, dependencyID)
+			}
+		case StepStatusSkipped:
+		default:
+			resolved = false
+		}
+	}
+
+	return live, resolved
+}
+
+// noBranch is the branch of a branching node that failed and continues. It
+// selected none of its branches, so only its unlabelled edges are followed.
+const noBranch = ""
+
+// edgeTaken reports whether the completed dependency routed to the step.
+// Error edges are only followed when the dependency failed into its error
+// output, which in turn follows nothing else.
+func edgeTaken(step *WorkflowStep, dep *WorkflowStep) bool {
+	conditions := step.BranchConditions[dep.ID]
+	if dep.SelectedBranch != nil && *dep.SelectedBranch == api.ErrorBranch {
+		return slices.Contains(conditions, api.ErrorBranch)
+	}
+	if len(conditions) == 0 {
+		return true
+	}
+	if dep.SelectedBranch == nil {
+		return slices.ContainsFunc(conditions, func(condition string) bool {
+			return condition != api.ErrorBranch
+		})
+	}
+	return slices.Contains(conditions, *dep.SelectedBranch)
+}
+
+// selectedBranch returns the branch a branching node took, or nil for nodes
+// that do not branch
+func (e *DurableExecutionEngine) selectedBranch(step *WorkflowStep, output *api.Envelope[any]) *string {
+	if output == nil || !e.isBranching(step) {
+		return nil
+	}
+
+	branch, ok := output.GetMeta(api.MetaBranch)
+	if !ok {
+		return nil
+	}
+	return &branch
+}
+
+// failedBranch returns the branch of a step that failed and continues with
+// the error mode: the error branch for the error output, no branch for
+// branching nodes, and nil for other nodes
+func (e *DurableExecutionEngine) failedBranch(step *WorkflowStep, mode ErrorMode) *string {
+	switch {
+	case mode == ErrorModeErrorOutput:
+		return stringPtr(api.ErrorBranch)
+	case e.isBranching(step):
+		return stringPtr(noBranch)
+	default:
+		return nil
+	}
+}
+
+// isBranching reports whether the node of a step selects a branch
+func (e *DurableExecutionEngine) isBranching(step *WorkflowStep) bool {
+	if e.mel == nil {
+		return false
+	}
+	def := e.mel.FindDefinition(step.NodeType)
+	return def != nil && def.Meta().Branching
+}
+
+// liveDependencies loads the dependencies of a step and returns the ones
+// whose output feeds the step, and whether all dependencies have finished
+func (e *DurableExecutionEngine) liveDependencies(ctx context.Context, step *WorkflowStep) ([]uuid.UUID, bool, error) {
+	if len(step.DependsOn) == 0 {
+		return nil, true, nil
+	}
+
+	query := `SELECT id, status, selected_branch FROM workflow_steps WHERE id = ANY($1)`
+	rows, err := e.db.QueryContext(ctx, query, pq.Array(step.DependsOn))
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	deps := make(map[uuid.UUID]*WorkflowStep, len(step.DependsOn))
+	for rows.Next() {
+		var dep WorkflowStep
+		if err := rows.Scan(&dep.ID, &dep.Status, &dep.SelectedBranch); err != nil {
+			return nil, false, err
+		}
+		deps[dep.ID] = &dep
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	live, resolved := dependencyState(step, deps)
+	return live, resolved, nil
+}
+
+// routeSuccessorsTx finds the steps that became ready after steps finished.
+// Pending successors whose dependencies all finished without a live edge are
+// marked as skipped, and the skip cascades to their own successors.
+func (e *DurableExecutionEngine) routeSuccessorsTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID, finishedStepIDs ...uuid.UUID) ([]uuid.UUID, error) {
+	steps, err := e.loadRunStepsTx(ctx, tx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*WorkflowStep, len(steps))
+	children := make(map[uuid.UUID][]*WorkflowStep)
+	for _, step := range steps {
+		byID[step.ID] = step
+		for _, dependencyID := range step.DependsOn {
+			children[dependencyID] = append(children[dependencyID], step)
+		}
+	}
+
+	var nextSteps []uuid.UUID
+	queued := make(map[uuid.UUID]bool)
+	pending := slices.Clone(finishedStepIDs)
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		if err := e.fallBackToDefaultTx(ctx, tx, byID[current], children[current]); err != nil {
+			return nil, err
+		}
+
+		for _, child := range children[current] {
+			if child.Status != StepStatusPending || queued[child.ID] {
+				continue
+			}
+
+			live, resolved := dependencyState(child, byID)
+			if !resolved {
+				continue
+			}
+
+			if len(live) > 0 {
+				queued[child.ID] = true
+				nextSteps = append(nextSteps, child.ID)
+				continue
+			}
+
+			// No dependency routed to the step, skip it and its descendants
+			if err := e.skipStepTx(ctx, tx, child.ID); err != nil {
+				return nil, err
+			}
+			child.Status = StepStatusSkipped
+			pending = append(pending, child.ID)
+		}
+	}
+
+	return nextSteps, nil
+}
+
+// fallBackToDefaultTx selects the default branch of a completed step whose
+// selected branch labels none of the edges to its children, so that values
+// without a case of their own follow the default edge
+func (e *DurableExecutionEngine) fallBackToDefaultTx(ctx context.Context, tx *sql.Tx, step *WorkflowStep, children []*WorkflowStep) error {
+	if step == nil || step.Status != StepStatusCompleted || !selectsUnknownBranch(step, children) {
+		return nil
+	}
+
+	query := `UPDATE workflow_steps SET selected_branch = $2 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, step.ID, api.DefaultBranch); err != nil {
+		return fmt.Errorf("failed to select default branch: %w", err)
+	}
+	step.SelectedBranch = stringPtr(api.DefaultBranch)
+	return nil
+}
+
+// selectsUnknownBranch reports whether a step selected a branch that labels
+// none of the edges to its children
+func selectsUnknownBranch(step *WorkflowStep, children []*WorkflowStep) bool {
+	if step.SelectedBranch == nil {
+		return false
+	}
+	switch *step.SelectedBranch {
+	case noBranch, api.ErrorBranch, api.DefaultBranch:
+		return false
+	}
+
+	for _, child := range children {
+		if slices.Contains(child.BranchConditions[step.ID], *step.SelectedBranch) {
+			return false
+		}
+	}
+	return true
+}
+
+// skipStepTx marks a pending step as skipped
+func (e *DurableExecutionEngine) skipStepTx(ctx context.Context, tx *sql.Tx, stepID uuid.UUID) error {
+	query := `
+		UPDATE workflow_steps
+		SET status = 'skipped', completed_at = NOW()
+		WHERE id = $1 AND status = 'pending'`
+	if _, err := tx.ExecContext(ctx, query, stepID); err != nil {
+		return fmt.Errorf("failed to skip step: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyState(t *testing.T) {
+	branch := func(name string) *string { return &name }
+
+	ifStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusCompleted, SelectedBranch: branch("true")}
+	plainStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusCompleted}
+	skippedStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusSkipped}
+	runningStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusRunning}
+	failedStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusCompleted, SelectedBranch: branch(api.ErrorBranch)}
+	continuedIfStep := &WorkflowStep{ID: uuid.New(), Status: StepStatusCompleted, SelectedBranch: branch(noBranch)}
+
+	deps := map[uuid.UUID]*WorkflowStep{
+		ifStep.ID:          ifStep,
+		plainStep.ID:       plainStep,
+		skippedStep.ID:     skippedStep,
+		runningStep.ID:     runningStep,
+		failedStep.ID:      failedStep,
+		continuedIfStep.ID: continuedIfStep,
+	}
+
+	tests := []struct {
+		name         string
+		step         *WorkflowStep
+		wantLive     []uuid.UUID
+		wantResolved bool
+	}{
+		{
+			name: "taken branch",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{ifStep.ID},
+				BranchConditions: map[uuid.UUID][]string{ifStep.ID: {"true"}},
+			},
+			wantLive:     []uuid.UUID{ifStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "untaken branch",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{ifStep.ID},
+				BranchConditions: map[uuid.UUID][]string{ifStep.ID: {"false"}},
+			},
+			wantResolved: true,
+		},
+		{
+			name: "unlabelled edge from branching node",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{ifStep.ID},
+			},
+			wantLive:     []uuid.UUID{ifStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "labelled edge from node without branch",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{plainStep.ID},
+				BranchConditions: map[uuid.UUID][]string{plainStep.ID: {"output"}},
+			},
+			wantLive:     []uuid.UUID{plainStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "error edge from succeeded node",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{plainStep.ID},
+				BranchConditions: map[uuid.UUID][]string{plainStep.ID: {api.ErrorBranch}},
+			},
+			wantResolved: true,
+		},
+		{
+			name: "error edge from failed node",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{failedStep.ID},
+				BranchConditions: map[uuid.UUID][]string{failedStep.ID: {api.ErrorBranch}},
+			},
+			wantLive:     []uuid.UUID{failedStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "regular edge from failed node",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{failedStep.ID},
+			},
+			wantResolved: true,
+		},
+		{
+			name: "branch of failed branching node in continue mode",
+			step: &WorkflowStep{
+				DependsOn:        []uuid.UUID{continuedIfStep.ID},
+				BranchConditions: map[uuid.UUID][]string{continuedIfStep.ID: {"true"}},
+			},
+			wantResolved: true,
+		},
+		{
+			name: "unlabelled edge from failed branching node in continue mode",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{continuedIfStep.ID},
+			},
+			wantLive:     []uuid.UUID{continuedIfStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "merge after skipped branch",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{plainStep.ID, skippedStep.ID},
+			},
+			wantLive:     []uuid.UUID{plainStep.ID},
+			wantResolved: true,
+		},
+		{
+			name: "only skipped dependencies",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{skippedStep.ID},
+			},
+			wantResolved: true,
+		},
+		{
+			name: "unfinished dependency",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{plainStep.ID, runningStep.ID},
+			},
+			wantLive:     []uuid.UUID{plainStep.ID},
+			wantResolved: false,
+		},
+		{
+			name: "unknown dependency",
+			step: &WorkflowStep{
+				DependsOn: []uuid.UUID{uuid.New()},
+			},
+			wantResolved: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			live, resolved := dependencyState(tt.step, deps)
+			assert.Equal(t, tt.wantLive, live)
+			assert.Equal(t, tt.wantResolved, resolved)
+		})
+	}
+}
+
+// branchNode is a branching node for routing tests
+type branchNode struct{}
+
+func (branchNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_branch", Label: "Branch", Category: "Test", Branching: true}
+}
+
+func (branchNode) Initialize(mel api.Mel) error { return nil }
+
+func (branchNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	return envelope, nil
+}
+
+func TestFailedBranch(t *testing.T) {
+	mel := api.NewMel()
+	mel.RegisterNodeDefinition(branchNode{})
+	mel.RegisterNodeDefinition(echoNode{})
+	engine := NewDurableExecutionEngine(nil, mel, "test-worker")
+
+	ifStep := &WorkflowStep{ID: uuid.New(), NodeType: "test_branch"}
+	plainStep := &WorkflowStep{ID: uuid.New(), NodeType: "test_echo"}
+
+	assert.Equal(t, api.ErrorBranch, *engine.failedBranch(ifStep, ErrorModeErrorOutput))
+	assert.Equal(t, api.ErrorBranch, *engine.failedBranch(plainStep, ErrorModeErrorOutput))
+	assert.Nil(t, engine.failedBranch(plainStep, ErrorModeContinue))
+
+	// A failed if node in continue mode selects neither its true nor its
+	// false branch
+	assert.Equal(t, noBranch, *engine.failedBranch(ifStep, ErrorModeContinue))
+}
+
+func TestSelectsUnknownBranch(t *testing.T) {
+	branch := func(name string) *string { return &name }
+
+	switchID := uuid.New()
+	children := []*WorkflowStep{
+		{BranchConditions: map[uuid.UUID][]string{switchID: {"a"}}},
+		{BranchConditions: map[uuid.UUID][]string{switchID: {"b"}}},
+		{BranchConditions: map[uuid.UUID][]string{switchID: {api.DefaultBranch}}},
+	}
+
+	tests := []struct {
+		name   string
+		branch *string
+		want   bool
+	}{
+		{name: "labelled case", branch: branch("a"), want: false},
+		{name: "unlabelled case", branch: branch("c"), want: true},
+		{name: "default", branch: branch(api.DefaultBranch)},
+		{name: "error output", branch: branch(api.ErrorBranch)},
+		{name: "failed in continue mode", branch: branch(noBranch)},
+		{name: "node without branch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step := &WorkflowStep{ID: switchID, Status: StepStatusCompleted, SelectedBranch: tt.branch}
+			assert.Equal(t, tt.want, selectsUnknownBranch(step, children))
+		})
+	}
+
+	// Once the default branch is selected, only the default edge is taken
+	step := &WorkflowStep{ID: switchID, Status: StepStatusCompleted, SelectedBranch: branch(api.DefaultBranch)}
+	deps := map[uuid.UUID]*WorkflowStep{switchID: step}
+	for i, child := range children {
+		child.DependsOn = []uuid.UUID{switchID}
+		live, _ := dependencyState(child, deps)
+		assert.Equal(t, i == 2, len(live) == 1)
+	}
+}
@@ -0,0 +1,164 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+)
+
+// Node config keys holding the execution settings every node supports next
+// to its own parameters
+const (
+	NodeConfigRetry          = "retry"
+	NodeConfigTimeoutSeconds = "timeoutSeconds"
+	NodeConfigOnError        = "onError"
+)
+
+// ErrorMode decides what happens when a node fails and will not be retried
+type ErrorMode string
+
+const (
+	// ErrorModeStop fails the run
+	ErrorModeStop ErrorMode = "stop"
+	// ErrorModeContinue completes the step with its input and the error
+	// recorded in the envelope errors, and follows the regular edges
+	ErrorModeContinue ErrorMode = "continue"
+	// ErrorModeErrorOutput completes the step like ErrorModeContinue but only
+	// follows the edges of the error output
+	ErrorModeErrorOutput ErrorMode = "errorOutput"
+)
+
+// NodeSettings are the per-node execution settings stored in the node config
+type NodeSettings struct {
+	Retry          *NodeRetrySettings `json:"retry,omitempty"`
+	TimeoutSeconds int                `json:"timeoutSeconds,omitempty"`
+	OnError        ErrorMode          `json:"onError,omitempty"`
+}
+
+// NodeRetrySettings override the retry policy of the run for a single node.
+// Zero values keep the setting of the run.
+type NodeRetrySettings struct {
+	MaxAttempts       int     `json:"maxAttempts,omitempty"`
+	BackoffMultiplier float64 `json:"backoffMultiplier,omitempty"`
+	InitialDelayMS    int64   `json:"initialDelayMs,omitempty"`
+	MaxDelayMS        int64   `json:"maxDelayMs,omitempty"`
+}
+
+// ParseNodeSettings reads the execution settings from a node config
+func ParseNodeSettings(config map[string]any) (NodeSettings, error) {
+	var settings NodeSettings
+
+	raw := map[string]any{}
+	for _, key := range []string{NodeConfigRetry, NodeConfigTimeoutSeconds, NodeConfigOnError} {
+		if value, ok := config[key]; ok && value != nil {
+			raw[key] = value
+		}
+	}
+	if len(raw) == 0 {
+		return settings, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return settings, fmt.Errorf("invalid node settings: %w", err)
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("invalid node settings: %w", err)
+	}
+
+	if settings.TimeoutSeconds < 0 {
+		return settings, fmt.Errorf("invalid node settings: %s must not be negative", NodeConfigTimeoutSeconds)
+	}
+	switch settings.OnError {
+	case "", ErrorModeStop, ErrorModeContinue, ErrorModeErrorOutput:
+	default:
+		return settings, fmt.Errorf("invalid node settings: unknown %s mode %q", NodeConfigOnError, settings.OnError)
+	}
+	if retry := settings.Retry; retry != nil {
+		if retry.MaxAttempts < 0 || retry.BackoffMultiplier < 0 || retry.InitialDelayMS < 0 || retry.MaxDelayMS < 0 {
+			return settings, fmt.Errorf("invalid node settings: %s values must not be negative", NodeConfigRetry)
+		}
+	}
+
+	return settings, nil
+}
+
+// RetryPolicy returns the retry policy of the run with the node overrides applied
+func (s NodeSettings) RetryPolicy(runPolicy RetryPolicy) RetryPolicy {
+	policy := runPolicy
+	if s.Retry == nil {
+		return policy
+	}
+
+	if s.Retry.MaxAttempts > 0 {
+		policy.MaxAttempts = s.Retry.MaxAttempts
+	}
+	if s.Retry.BackoffMultiplier > 0 {
+		policy.BackoffMultiplier = s.Retry.BackoffMultiplier
+	}
+	if s.Retry.InitialDelayMS > 0 {
+		policy.InitialDelayMS = s.Retry.InitialDelayMS
+	}
+	if s.Retry.MaxDelayMS > 0 {
+		policy.MaxDelayMS = s.Retry.MaxDelayMS
+	}
+	return policy
+}
+
+// ErrorMode returns the error mode of the node, which defaults to stopping the run
+func (s NodeSettings) ErrorMode() ErrorMode {
+	if s.OnError == "" {
+		return ErrorModeStop
+	}
+	return s.OnError
+}
+
+// Timeout returns the execution timeout of the node, or zero for none
+func (s NodeSettings) Timeout() time.Duration {
+	return time.Duration(s.TimeoutSeconds) * time.Second
+}
+
+// executeNode runs a node definition. When a timeout is given the context of
+// the node is cancelled once it is exceeded and a timeout error is returned,
+// even if the node does not stop on its own.
+func executeNode(ctx context.Context, def api.NodeDefinition, execCtx api.ExecutionContext, node api.Node, input *api.Envelope[any], timeout time.Duration) (*api.Envelope[any], error) {
+	if timeout <= 0 {
+		execCtx.Context = ctx
+		return def.ExecuteEnvelope(execCtx, node, input)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	execCtx.Context = ctx
+
+	type nodeResult struct {
+		output *api.Envelope[any]
+		err    error
+	}
+
+	done := make(chan nodeResult, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- nodeResult{err: fmt.Errorf("panic during node execution: %v", r)}
+			}
+		}()
+
+		output, err := def.ExecuteEnvelope(execCtx, node, input)
+		done <- nodeResult{output: output, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		return result.output, result.err
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			message := fmt.Sprintf("node execution exceeded timeout of %s", timeout)
+			return nil, api.NewNodeErrorWithCause(node.ID, node.Type, message, api.ErrorCodeTimeout, ctx.Err())
+		}
+		return nil, ctx.Err()
+	}
+}
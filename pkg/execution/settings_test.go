@@ -0,0 +1,110 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeSettings(t *testing.T) {
+	settings, err := ParseNodeSettings(map[string]any{
+		"model": "gpt-4",
+		"retry": map[string]any{
+			"maxAttempts":       float64(5),
+			"backoffMultiplier": 1.5,
+			"maxDelayMs":        float64(10000),
+		},
+		"timeoutSeconds": float64(30),
+		"onError":        "errorOutput",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, settings.Retry)
+	assert.Equal(t, 5, settings.Retry.MaxAttempts)
+	assert.Equal(t, 30*time.Second, settings.Timeout())
+	assert.Equal(t, ErrorModeErrorOutput, settings.ErrorMode())
+
+	// Unset values keep the policy of the run
+	policy := settings.RetryPolicy(DefaultRetryPolicy())
+	assert.Equal(t, 5, policy.MaxAttempts)
+	assert.Equal(t, 1.5, policy.BackoffMultiplier)
+	assert.Equal(t, DefaultRetryPolicy().InitialDelayMS, policy.InitialDelayMS)
+	assert.Equal(t, int64(10000), policy.MaxDelayMS)
+
+	settings, err = ParseNodeSettings(map[string]any{"url": "https://example.com"})
+	require.NoError(t, err)
+	assert.Nil(t, settings.Retry)
+	assert.Zero(t, settings.Timeout())
+	assert.Equal(t, ErrorModeStop, settings.ErrorMode())
+	assert.Equal(t, DefaultRetryPolicy(), settings.RetryPolicy(DefaultRetryPolicy()))
+}
+
+func TestParseNodeSettingsRejectsInvalidValues(t *testing.T) {
+	configs := map[string]map[string]any{
+		"negative timeout":   {"timeoutSeconds": float64(-1)},
+		"timeout as string":  {"timeoutSeconds": "soon"},
+		"negative attempts":  {"retry": map[string]any{"maxAttempts": float64(-2)}},
+		"retry as number":    {"retry": float64(3)},
+		"unknown error mode": {"onError": "ignore"},
+	}
+
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseNodeSettings(config)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// blockingNode waits until its context is cancelled
+type blockingNode struct{}
+
+func (blockingNode) Meta() api.NodeType {
+	return api.NodeType{Type: "test_blocking", Label: "Blocking", Category: "Test"}
+}
+
+func (blockingNode) Initialize(mel api.Mel) error { return nil }
+
+func (blockingNode) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[any]) (*api.Envelope[any], error) {
+	<-ctx.GoContext().Done()
+	return nil, ctx.GoContext().Err()
+}
+
+func TestExecuteNodeTimeout(t *testing.T) {
+	node := api.Node{ID: "slow", Type: "test_blocking"}
+
+	_, err := executeNode(t.Context(), blockingNode{}, api.ExecutionContext{}, node, nil, 10*time.Millisecond)
+	require.Error(t, err)
+	assert.Equal(t, api.ErrorCodeTimeout, api.ErrorCode(err))
+	policy := DefaultRetryPolicy()
+	assert.True(t, policy.IsRetryable(err, 1))
+
+	input := &api.Envelope[any]{Data: "hello"}
+	output, err := executeNode(t.Context(), echoNode{}, api.ExecutionContext{}, api.Node{ID: "echo", Type: "test_echo"}, input, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", output.Data.(map[string]any)["input"])
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	input := &api.Envelope[any]{
+		ID:    "input",
+		Data:  map[string]any{"order": 42},
+		Trace: api.Trace{RunID: "run", NodeID: "charge"},
+	}
+	step := &WorkflowStep{NodeID: "charge", NodeType: "http_request", InputEnvelope: input}
+	stepErr := api.NewNodeErrorWithCode("charge", "http_request", "request failed with status 400", api.ErrorCodeBadRequest)
+
+	output := errorEnvelope(step, stepErr)
+
+	// The input is passed on with the error recorded
+	assert.NotEqual(t, "input", output.ID)
+	assert.Equal(t, input.Data, output.Data)
+	assert.Equal(t, "charge", output.Trace.NodeID)
+	require.Len(t, output.Errors, 1)
+	assert.Equal(t, "charge", output.Errors[0].NodeID)
+	assert.Equal(t, "request failed with status 400", output.Errors[0].Message)
+	assert.Equal(t, api.ErrorCodeBadRequest, output.Errors[0].Code)
+	assert.Empty(t, input.Errors)
+}
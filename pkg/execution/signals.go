@@ -0,0 +1,380 @@
+package execution
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+)
+
+// waitingStep is a step parked until the signal it waits for is sent
+type waitingStep struct {
+	ID     uuid.UUID
+	RunID  uuid.UUID
+	NodeID string
+	Token  string
+	Output *api.Envelope[any]
+	// WaitUntil is when the wait times out, nil for waits without a timeout
+	WaitUntil *time.Time
+}
+
+// parkStepTx parks a step whose node waits for a signal and pauses its run.
+// The output of the node is kept until the wait ends, together with the
+// token of the URL that resumes it.
+func (e *DurableExecutionEngine) parkStepTx(ctx context.Context, tx *sql.Tx, step *WorkflowStep, output *api.Envelope[any], signal string) error {
+	token, err := newSignalToken()
+	if err != nil {
+		return err
+	}
+	output.SetMeta(api.MetaResumeURL, resumeURL(step.RunID, signal, token))
+
+	outputJSON, err := json.Marshal(output)
+	if err != nil {
+		return fmt.Errorf("failed to marshal output: %w", err)
+	}
+
+	stepQuery := `
+		UPDATE workflow_steps
+		SET status = 'waiting', output_envelope = $1, wait_signal = $2, signal_token = $3, wait_until = $4
+		WHERE id = $5`
+	if _, err := tx.ExecContext(ctx, stepQuery, outputJSON, signal, token, outputWaitUntil(output), step.ID); err != nil {
+		return fmt.Errorf("failed to park step: %w", err)
+	}
+
+	runQuery := `UPDATE workflow_runs SET status = 'paused' WHERE id = $1 AND status = 'running'`
+	if _, err := tx.ExecContext(ctx, runQuery, step.RunID); err != nil {
+		return fmt.Errorf("failed to pause run: %w", err)
+	}
+
+	return nil
+}
+
+// SignalRun sends a signal to a run. The step waiting for the signal whose
+// resume token matches continues on its signal branch, with the payload as
+// its output. It returns the ID of that step.
+func (e *DurableExecutionEngine) SignalRun(ctx context.Context, runID uuid.UUID, signal, token string, payload map[string]any) (uuid.UUID, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRunTx(ctx, tx, runID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, ErrSignalNotAwaited
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if status.IsTerminal() {
+		return uuid.Nil, ErrRunNotActive
+	}
+
+	steps, err := loadWaitingStepsTx(ctx, tx, `run_id = $1 AND wait_signal = $2`, runID, signal)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(steps) == 0 {
+		return uuid.Nil, ErrSignalNotAwaited
+	}
+
+	i := slices.IndexFunc(steps, func(step *waitingStep) bool {
+		return subtle.ConstantTimeCompare([]byte(step.Token), []byte(token)) == 1
+	})
+	if i < 0 {
+		return uuid.Nil, ErrInvalidSignalToken
+	}
+	step := steps[i]
+
+	output := signalEnvelope(step, payload)
+	if err := e.releaseStepTx(ctx, tx, status, step, output, api.SignalBranch); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit signal: %w", err)
+	}
+
+	if err := e.createCheckpoint(ctx, runID, step.ID, "post_execution", output); err != nil {
+		log.Printf("Warning: failed to create post-execution checkpoint: %v", err)
+	}
+
+	return step.ID, nil
+}
+
+// TimeoutSignals continues the steps whose wait for a signal timed out on
+// their timeout branch, with the envelope they waited with
+func (e *DurableExecutionEngine) TimeoutSignals(ctx context.Context) error {
+	query := `SELECT run_id, id FROM workflow_steps WHERE status = 'waiting' AND wait_until <= NOW()`
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to find timed out signals: %w", err)
+	}
+
+	var runIDs, stepIDs []uuid.UUID
+	for rows.Next() {
+		var runID, stepID uuid.UUID
+		if err := rows.Scan(&runID, &stepID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan timed out signal: %w", err)
+		}
+		runIDs = append(runIDs, runID)
+		stepIDs = append(stepIDs, stepID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read timed out signals: %w", err)
+	}
+
+	for i, stepID := range stepIDs {
+		if err := e.timeoutSignal(ctx, runIDs[i], stepID); err != nil {
+			return fmt.Errorf("failed to time out signal of step %s: %w", stepID, err)
+		}
+	}
+
+	return nil
+}
+
+// timeoutSignal releases a single timed out step, unless it received its
+// signal in the meantime
+func (e *DurableExecutionEngine) timeoutSignal(ctx context.Context, runID, stepID uuid.UUID) error {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRunTx(ctx, tx, runID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if status.IsTerminal() {
+		return nil
+	}
+
+	steps, err := loadWaitingStepsTx(ctx, tx, `id = $1 AND wait_until <= NOW()`, stepID)
+	if err != nil || len(steps) == 0 {
+		return err
+	}
+
+	output := resumedEnvelope(steps[0])
+	output.SetMeta(api.MetaBranch, api.TimeoutBranch)
+	if err := e.releaseStepTx(ctx, tx, status, steps[0], output, api.TimeoutBranch); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit signal timeout: %w", err)
+	}
+
+	if err := e.createCheckpoint(ctx, runID, stepID, "post_execution", output); err != nil {
+		log.Printf("Warning: failed to create post-execution checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+// releaseStepTx completes a waiting step on the given branch and queues the
+// steps following it. A paused run resumes once no other step waits for a
+// signal, together with the steps that became ready while it was paused.
+func (e *DurableExecutionEngine) releaseStepTx(ctx context.Context, tx *sql.Tx, status WorkflowRunStatus, step *waitingStep, output *api.Envelope[any], branch string) error {
+	if err := e.updateStepOutputTx(ctx, tx, step.ID, output, &branch); err != nil {
+		return fmt.Errorf("failed to update step output: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE workflow_steps SET signal_token = NULL WHERE id = $1`, step.ID); err != nil {
+		return fmt.Errorf("failed to clear signal token: %w", err)
+	}
+
+	nextSteps, err := e.routeSuccessorsTx(ctx, tx, step.RunID, step.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find next steps: %w", err)
+	}
+
+	if status == RunStatusPaused {
+		var waiting bool
+		waitingQuery := `SELECT EXISTS (SELECT 1 FROM workflow_steps WHERE run_id = $1 AND status = 'waiting')`
+		if err := tx.QueryRowContext(ctx, waitingQuery, step.RunID).Scan(&waiting); err != nil {
+			return fmt.Errorf("failed to check waiting steps: %w", err)
+		}
+		if waiting {
+			// The steps are queued once the last wait of the run is over
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE workflow_runs SET status = 'running' WHERE id = $1 AND status = 'paused'`, step.RunID); err != nil {
+			return fmt.Errorf("failed to resume run: %w", err)
+		}
+		if nextSteps, err = e.readyStepsTx(ctx, tx, step.RunID); err != nil {
+			return fmt.Errorf("failed to find ready steps: %w", err)
+		}
+	}
+
+	for _, nextStepID := range nextSteps {
+		nextItem := &QueueItem{
+			ID:          uuid.New(),
+			RunID:       step.RunID,
+			StepID:      &nextStepID,
+			QueueType:   QueueTypeExecuteStep,
+			Priority:    5,
+			AvailableAt: time.Now(),
+			MaxAttempts: 3,
+		}
+		if err := e.enqueueItemTx(ctx, tx, nextItem); err != nil {
+			return fmt.Errorf("failed to queue next step: %w", err)
+		}
+	}
+
+	if len(nextSteps) == 0 {
+		if err := e.queueRunCompletionTx(ctx, tx, step.RunID); err != nil {
+			return fmt.Errorf("failed to queue run completion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readyStepsTx returns the pending steps of a run that are not queued and
+// whose dependencies finished and routed to them
+func (e *DurableExecutionEngine) readyStepsTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID) ([]uuid.UUID, error) {
+	steps, err := e.loadRunStepsTx(ctx, tx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, err := e.queue.QueuedSteps(ctx, tx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	return readySteps(steps, queued), nil
+}
+
+// readySteps returns the pending steps that are not queued and whose
+// dependencies finished and routed to them
+func readySteps(steps []*WorkflowStep, queued map[uuid.UUID]bool) []uuid.UUID {
+	byID := make(map[uuid.UUID]*WorkflowStep, len(steps))
+	for _, step := range steps {
+		byID[step.ID] = step
+	}
+
+	var ready []uuid.UUID
+	for _, step := range steps {
+		if step.Status != StepStatusPending || queued[step.ID] {
+			continue
+		}
+		live, resolved := dependencyState(step, byID)
+		if resolved && (len(step.DependsOn) == 0 || len(live) > 0) {
+			ready = append(ready, step.ID)
+		}
+	}
+	return ready
+}
+
+// lockRunTx locks a run for routing and returns its status
+func lockRunTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID) (WorkflowRunStatus, error) {
+	var status WorkflowRunStatus
+	err := tx.QueryRowContext(ctx, `SELECT status FROM workflow_runs WHERE id = $1 FOR UPDATE`, runID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lock run: %w", err)
+	}
+	return status, nil
+}
+
+// loadWaitingStepsTx locks the waiting steps matching the condition
+func loadWaitingStepsTx(ctx context.Context, tx *sql.Tx, condition string, args ...any) ([]*waitingStep, error) {
+	query := `
+		SELECT id, run_id, node_id, COALESCE(signal_token, ''), output_envelope, wait_until
+		FROM workflow_steps
+		WHERE status = 'waiting' AND ` + condition + `
+		ORDER BY step_number, split_path
+		FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waiting steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*waitingStep
+	for rows.Next() {
+		var step waitingStep
+		var outputJSON []byte
+		var waitUntil sql.NullTime
+		if err := rows.Scan(&step.ID, &step.RunID, &step.NodeID, &step.Token, &outputJSON, &waitUntil); err != nil {
+			return nil, fmt.Errorf("failed to scan waiting step: %w", err)
+		}
+		if waitUntil.Valid {
+			step.WaitUntil = &waitUntil.Time
+		}
+		if len(outputJSON) > 0 {
+			if err := json.Unmarshal(outputJSON, &step.Output); err != nil {
+				return nil, fmt.Errorf("failed to parse output envelope: %w", err)
+			}
+		}
+		steps = append(steps, &step)
+	}
+
+	return steps, rows.Err()
+}
+
+// resumedEnvelope returns a copy of the envelope a step waited with, without
+// the metadata of the wait
+func resumedEnvelope(step *waitingStep) *api.Envelope[any] {
+	var output *api.Envelope[any]
+	if step.Output != nil {
+		output = step.Output.Clone()
+		output.ID = core.GenerateEnvelopeID()
+	} else {
+		output = core.NewGenericEnvelope(map[string]any{}, api.Trace{RunID: step.RunID.String(), NodeID: step.NodeID})
+	}
+
+	delete(output.Meta, api.MetaWaitSignal)
+	delete(output.Meta, api.MetaWaitUntil)
+	delete(output.Meta, api.MetaResumeURL)
+	return output
+}
+
+// signalEnvelope builds the output of a step that received its signal: the
+// envelope it waited with, carrying the payload of the signal
+func signalEnvelope(step *waitingStep, payload map[string]any) *api.Envelope[any] {
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	output := resumedEnvelope(step)
+	output.Data = payload
+	output.SetMeta(api.MetaBranch, api.SignalBranch)
+	return output
+}
+
+// resumeURL returns the path that sends a signal to a waiting step
+func resumeURL(runID uuid.UUID, signal, token string) string {
+	return fmt.Sprintf("/api/workflow-runs/%s/signals/%s?token=%s", runID, url.PathEscape(signal), url.QueryEscape(token))
+}
+
+// newSignalToken generates the secret token of a resume URL
+func newSignalToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate signal token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
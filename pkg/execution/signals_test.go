@@ -0,0 +1,57 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadySteps(t *testing.T) {
+	signal := api.SignalBranch
+
+	approve := &WorkflowStep{ID: uuid.New(), Status: StepStatusCompleted, SelectedBranch: &signal}
+	send := &WorkflowStep{ID: uuid.New(), Status: StepStatusPending, DependsOn: []uuid.UUID{approve.ID}}
+	queued := &WorkflowStep{ID: uuid.New(), Status: StepStatusPending, DependsOn: []uuid.UUID{approve.ID}}
+	blocked := &WorkflowStep{ID: uuid.New(), Status: StepStatusPending, DependsOn: []uuid.UUID{send.ID}}
+	entry := &WorkflowStep{ID: uuid.New(), Status: StepStatusPending}
+	waiting := &WorkflowStep{ID: uuid.New(), Status: StepStatusWaiting}
+
+	steps := []*WorkflowStep{approve, send, queued, blocked, entry, waiting}
+	ready := readySteps(steps, map[uuid.UUID]bool{queued.ID: true})
+
+	assert.Equal(t, []uuid.UUID{send.ID, entry.ID}, ready)
+}
+
+func TestSignalEnvelope(t *testing.T) {
+	parked := core.NewGenericEnvelope(map[string]any{"draft": "Dear customer"}, api.Trace{NodeID: "approve"})
+	parked.SetMeta(api.MetaWaitSignal, "approval")
+	parked.SetMeta(api.MetaWaitUntil, time.Now().Format(time.RFC3339Nano))
+	parked.SetMeta(api.MetaResumeURL, "/api/workflow-runs/1/signals/approval?token=secret")
+	parked.SetMeta("source", "draft")
+	step := &waitingStep{ID: uuid.New(), RunID: uuid.New(), NodeID: "approve", Output: parked}
+
+	output := signalEnvelope(step, map[string]any{"approved": true})
+	assert.Equal(t, map[string]any{"approved": true}, output.Data)
+	assert.NotEqual(t, parked.ID, output.ID)
+	assert.Equal(t, map[string]string{"source": "draft", api.MetaBranch: api.SignalBranch}, output.Meta)
+
+	// The parked envelope keeps its wait
+	_, waiting := parked.GetMeta(api.MetaWaitSignal)
+	assert.True(t, waiting)
+
+	// Signals without payload continue with empty data
+	output = signalEnvelope(&waitingStep{RunID: step.RunID, NodeID: "approve"}, nil)
+	assert.Equal(t, map[string]any{}, output.Data)
+}
+
+func TestResumeURL(t *testing.T) {
+	runID := uuid.MustParse("5f0c2e4e-8f4a-4b43-9a38-1d0c7f1e2a11")
+
+	assert.Equal(t,
+		"/api/workflow-runs/5f0c2e4e-8f4a-4b43-9a38-1d0c7f1e2a11/signals/refund.approval?token=abc123",
+		resumeURL(runID, "refund.approval", "abc123"))
+}
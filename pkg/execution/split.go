@@ -0,0 +1,290 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// splitExpansion describes the step changes that fan a split out into one
+// step chain per item
+type splitExpansion struct {
+	// Updated holds existing steps whose dependencies, input or status changed
+	Updated []*WorkflowStep
+	// Created holds the step instances for the second and following items
+	Created []*WorkflowStep
+	// Skipped lists the steps skipped because the split had no items
+	Skipped []uuid.UUID
+}
+
+// splitItems fans the output of a splitter out into one envelope per item.
+// Each item carries a child trace of the splitter and its split index.
+func splitItems(output *api.Envelope[any]) []*api.Envelope[any] {
+	if output == nil {
+		return nil
+	}
+
+	data, ok := output.Data.([]any)
+	if !ok {
+		data = []any{output.Data}
+	}
+
+	return core.SplitEnvelope(&api.Envelope[[]any]{
+		ID:        output.ID,
+		IssuedAt:  output.IssuedAt,
+		Version:   output.Version,
+		DataType:  "array",
+		Data:      data,
+		Binary:    output.Binary,
+		Meta:      output.Meta,
+		Variables: output.Variables,
+		Trace:     output.Trace,
+		Errors:    output.Errors,
+	})
+}
+
+// splitRegion returns the steps between a splitter and its matching
+// aggregators. Nested splitters and aggregators are part of the region.
+func splitRegion(steps []*WorkflowStep, splitter *WorkflowStep) (region []*WorkflowStep, aggregators []*WorkflowStep) {
+	children := make(map[uuid.UUID][]*WorkflowStep)
+	for _, step := range steps {
+		for _, dependencyID := range step.DependsOn {
+			children[dependencyID] = append(children[dependencyID], step)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var visit func(step *WorkflowStep, depth int)
+	visit = func(step *WorkflowStep, depth int) {
+		if seen[step.ID] {
+			return
+		}
+		seen[step.ID] = true
+
+		switch step.NodeType {
+		case core.AggregatorNodeType:
+			if depth == 0 {
+				aggregators = append(aggregators, step)
+				return
+			}
+			depth--
+		case core.SplitterNodeType:
+			depth++
+		}
+
+		region = append(region, step)
+		for _, child := range children[step.ID] {
+			visit(child, depth)
+		}
+	}
+
+	for _, child := range children[splitter.ID] {
+		visit(child, 0)
+	}
+
+	return region, aggregators
+}
+
+// expandSplit instantiates the region of a splitter once per item of its
+// output. The existing region steps become the instances of the first item.
+// Aggregators closing the region depend on the instances of all items, so they
+// run once every item finished. Without items the region is skipped and the
+// aggregators run directly after the splitter with an empty merged envelope.
+func expandSplit(steps []*WorkflowStep, splitter *WorkflowStep, output *api.Envelope[any]) *splitExpansion {
+	region, aggregators := splitRegion(steps, splitter)
+	expansion := &splitExpansion{}
+
+	inRegion := make(map[uuid.UUID]bool, len(region))
+	for _, step := range region {
+		inRegion[step.ID] = true
+	}
+
+	items := splitItems(output)
+	if len(items) == 0 {
+		for _, step := range region {
+			step.Status = StepStatusSkipped
+			expansion.Updated = append(expansion.Updated, step)
+			expansion.Skipped = append(expansion.Skipped, step.ID)
+		}
+
+		for _, aggregator := range aggregators {
+			dependsOn := []uuid.UUID{splitter.ID}
+			branchConditions := make(map[uuid.UUID][]string, len(aggregator.BranchConditions))
+			for _, dependencyID := range aggregator.DependsOn {
+				if inRegion[dependencyID] || dependencyID == splitter.ID {
+					continue
+				}
+				dependsOn = append(dependsOn, dependencyID)
+				if conditions, ok := aggregator.BranchConditions[dependencyID]; ok {
+					branchConditions[dependencyID] = conditions
+				}
+			}
+
+			aggregator.DependsOn = dependsOn
+			aggregator.BranchConditions = branchConditions
+			aggregator.InputEnvelope = emptyAggregateInput(aggregator.NodeID, splitter, output)
+			expansion.Updated = append(expansion.Updated, aggregator)
+		}
+		return expansion
+	}
+
+	// instances maps a region step to its instance for each item
+	instances := make(map[uuid.UUID][]uuid.UUID, len(region))
+	for _, step := range region {
+		ids := make([]uuid.UUID, len(items))
+		ids[0] = step.ID
+		for i := 1; i < len(items); i++ {
+			ids[i] = uuid.New()
+		}
+		instances[step.ID] = ids
+	}
+
+	for i, item := range items {
+		for _, step := range region {
+			instance := step
+			if i > 0 {
+				instance = &WorkflowStep{
+					RunID:       step.RunID,
+					NodeID:      step.NodeID,
+					NodeType:    step.NodeType,
+					StepNumber:  step.StepNumber,
+					Status:      StepStatusPending,
+					MaxAttempts: step.MaxAttempts,
+					NodeConfig:  step.NodeConfig,
+				}
+			}
+			instance.ID = instances[step.ID][i]
+			instance.SplitPath = splitPath(splitter.SplitPath, i)
+
+			dependsOn := make([]uuid.UUID, 0, len(step.DependsOn))
+			for _, dependencyID := range step.DependsOn {
+				if inRegion[dependencyID] {
+					dependencyID = instances[dependencyID][i]
+				}
+				dependsOn = append(dependsOn, dependencyID)
+
+				// Steps fed by the splitter receive their item directly
+				if dependencyID == splitter.ID {
+					instance.InputEnvelope = mergeStepInputs(step.NodeID, []*api.Envelope[any]{item})
+				}
+			}
+
+			var branchConditions map[uuid.UUID][]string
+			for dependencyID, conditions := range step.BranchConditions {
+				if branchConditions == nil {
+					branchConditions = make(map[uuid.UUID][]string, len(step.BranchConditions))
+				}
+				if inRegion[dependencyID] {
+					dependencyID = instances[dependencyID][i]
+				}
+				branchConditions[dependencyID] = conditions
+			}
+
+			instance.DependsOn = dependsOn
+			instance.BranchConditions = branchConditions
+
+			if i == 0 {
+				expansion.Updated = append(expansion.Updated, instance)
+			} else {
+				expansion.Created = append(expansion.Created, instance)
+			}
+		}
+	}
+
+	for _, aggregator := range aggregators {
+		var dependsOn []uuid.UUID
+		branchConditions := make(map[uuid.UUID][]string, len(aggregator.BranchConditions))
+		for _, dependencyID := range aggregator.DependsOn {
+			ids, ok := instances[dependencyID]
+			if !ok {
+				ids = []uuid.UUID{dependencyID}
+			}
+
+			dependsOn = append(dependsOn, ids...)
+			if conditions, ok := aggregator.BranchConditions[dependencyID]; ok {
+				for _, id := range ids {
+					branchConditions[id] = conditions
+				}
+			}
+		}
+
+		aggregator.DependsOn = dependsOn
+		aggregator.BranchConditions = branchConditions
+		expansion.Updated = append(expansion.Updated, aggregator)
+	}
+
+	return expansion
+}
+
+// splitPath returns the split path of an item nested in the given path
+func splitPath(parent string, index int) string {
+	if parent == "" {
+		return strconv.Itoa(index)
+	}
+	return parent + "." + strconv.Itoa(index)
+}
+
+// expandSplitTx fans the output of a completed splitter step out into per-item
+// steps. It returns the steps that were skipped because the split was empty.
+func (e *DurableExecutionEngine) expandSplitTx(ctx context.Context, tx *sql.Tx, splitter *WorkflowStep, output *api.Envelope[any]) ([]uuid.UUID, error) {
+	steps, err := e.loadRunStepsTx(ctx, tx, splitter.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps {
+		if step.ID == splitter.ID {
+			splitter = step
+			break
+		}
+	}
+
+	expansion := expandSplit(steps, splitter, output)
+
+	updateQuery := `
+		UPDATE workflow_steps
+		SET status = $2, input_envelope = $3, depends_on = $4, branch_conditions = $5,
+		    split_path = $6, completed_at = CASE WHEN $2 = 'skipped' THEN NOW() END
+		WHERE id = $1 AND status = 'pending'`
+
+	for _, step := range expansion.Updated {
+		var inputJSON []byte
+		if step.InputEnvelope != nil {
+			if inputJSON, err = json.Marshal(step.InputEnvelope); err != nil {
+				return nil, fmt.Errorf("failed to marshal input envelope: %w", err)
+			}
+		}
+
+		branchJSON, err := marshalBranchConditions(step.BranchConditions)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, updateQuery, step.ID, step.Status, inputJSON,
+			pq.Array(step.DependsOn), branchJSON, step.SplitPath); err != nil {
+			return nil, fmt.Errorf("failed to update step for node %s: %w", step.NodeID, err)
+		}
+	}
+
+	for _, step := range expansion.Created {
+		if err := e.insertStepTx(ctx, tx, step); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(expansion.Created) > 0 {
+		query := `UPDATE workflow_runs SET total_steps = total_steps + $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, splitter.RunID, len(expansion.Created)); err != nil {
+			return nil, fmt.Errorf("failed to update run step count: %w", err)
+		}
+	}
+
+	return expansion.Skipped, nil
+}
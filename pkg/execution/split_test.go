@@ -0,0 +1,199 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildSplitGraph builds start -> split -> fetch -> store -> collect -> done
+func buildSplitGraph(t *testing.T) (*WorkflowGraph, map[string]*WorkflowStep) {
+	t.Helper()
+
+	def := &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "start", Type: "manual_trigger"},
+			{ID: "split", Type: core.SplitterNodeType},
+			{ID: "fetch", Type: "http_request"},
+			{ID: "store", Type: "log"},
+			{ID: "collect", Type: core.AggregatorNodeType},
+			{ID: "done", Type: "log"},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "start", Target: "split"},
+			{ID: "e2", Source: "split", Target: "fetch"},
+			{ID: "e3", Source: "fetch", Target: "store"},
+			{ID: "e4", Source: "store", Target: "collect"},
+			{ID: "e5", Source: "collect", Target: "done"},
+		},
+	}
+
+	graph, err := BuildWorkflowGraph(uuid.New(), def)
+	require.NoError(t, err)
+
+	byNode := make(map[string]*WorkflowStep)
+	for _, step := range graph.Steps {
+		byNode[step.NodeID] = step
+	}
+	return graph, byNode
+}
+
+func TestSplitRegion(t *testing.T) {
+	graph, byNode := buildSplitGraph(t)
+
+	region, aggregators := splitRegion(graph.Steps, byNode["split"])
+
+	assert.Equal(t, []*WorkflowStep{byNode["fetch"], byNode["store"]}, region)
+	assert.Equal(t, []*WorkflowStep{byNode["collect"]}, aggregators)
+}
+
+func TestSplitRegionNested(t *testing.T) {
+	def := &WorkflowDefinition{
+		Nodes: []WorkflowNode{
+			{ID: "outer", Type: core.SplitterNodeType},
+			{ID: "inner", Type: core.SplitterNodeType},
+			{ID: "work", Type: "log"},
+			{ID: "innerCollect", Type: core.AggregatorNodeType},
+			{ID: "outerCollect", Type: core.AggregatorNodeType},
+		},
+		Edges: []WorkflowEdge{
+			{ID: "e1", Source: "outer", Target: "inner"},
+			{ID: "e2", Source: "inner", Target: "work"},
+			{ID: "e3", Source: "work", Target: "innerCollect"},
+			{ID: "e4", Source: "innerCollect", Target: "outerCollect"},
+		},
+	}
+
+	graph, err := BuildWorkflowGraph(uuid.New(), def)
+	require.NoError(t, err)
+
+	byNode := make(map[string]*WorkflowStep)
+	for _, step := range graph.Steps {
+		byNode[step.NodeID] = step
+	}
+
+	region, aggregators := splitRegion(graph.Steps, byNode["outer"])
+
+	assert.Equal(t, []*WorkflowStep{byNode["inner"], byNode["work"], byNode["innerCollect"]}, region)
+	assert.Equal(t, []*WorkflowStep{byNode["outerCollect"]}, aggregators)
+}
+
+func TestExpandSplit(t *testing.T) {
+	graph, byNode := buildSplitGraph(t)
+	split, fetch, store, collect := byNode["split"], byNode["fetch"], byNode["store"], byNode["collect"]
+	fetchID, storeID := fetch.ID, store.ID
+
+	output := core.NewGenericEnvelope([]any{"a", "b", "c"}, api.Trace{RunID: "run", NodeID: "split"})
+	items := splitItems(output)
+	require.Len(t, items, 3)
+
+	expansion := expandSplit(graph.Steps, split, output)
+
+	// The existing steps process the first item, new instances the others
+	require.Len(t, expansion.Created, 4)
+	assert.Empty(t, expansion.Skipped)
+	assert.Equal(t, "0", fetch.SplitPath)
+	assert.Equal(t, "0", store.SplitPath)
+
+	instances := map[string][]*WorkflowStep{
+		"fetch": {fetch},
+		"store": {store},
+	}
+	for _, step := range expansion.Created {
+		instances[step.NodeID] = append(instances[step.NodeID], step)
+	}
+
+	for i := range 3 {
+		fetchInstance := instances["fetch"][i]
+		storeInstance := instances["store"][i]
+
+		assert.Equal(t, splitPath("", i), fetchInstance.SplitPath)
+		assert.Equal(t, splitPath("", i), storeInstance.SplitPath)
+		assert.Equal(t, StepStatusPending, fetchInstance.Status)
+
+		// Each item runs its own chain
+		assert.Equal(t, []uuid.UUID{split.ID}, fetchInstance.DependsOn)
+		assert.Equal(t, []uuid.UUID{fetchInstance.ID}, storeInstance.DependsOn)
+
+		// The chain starts from the item, traced as a child of the splitter
+		require.NotNil(t, fetchInstance.InputEnvelope)
+		assert.Equal(t, []any{"a", "b", "c"}[i], fetchInstance.InputEnvelope.Data)
+		assert.Equal(t, "split", fetchInstance.InputEnvelope.Trace.ParentID)
+		assert.Equal(t, "fetch", fetchInstance.InputEnvelope.Trace.NodeID)
+		index, _ := fetchInstance.InputEnvelope.GetMeta("split_index")
+		assert.Equal(t, splitPath("", i), index)
+		assert.Nil(t, storeInstance.InputEnvelope)
+	}
+	assert.Equal(t, fetchID, instances["fetch"][0].ID)
+	assert.Equal(t, storeID, instances["store"][0].ID)
+
+	// The aggregator waits for the last step of every item
+	assert.Equal(t, []uuid.UUID{
+		instances["store"][0].ID, instances["store"][1].ID, instances["store"][2].ID,
+	}, collect.DependsOn)
+	assert.Contains(t, expansion.Updated, collect)
+}
+
+func TestExpandSplitWithoutItems(t *testing.T) {
+	graph, byNode := buildSplitGraph(t)
+	split, collect := byNode["split"], byNode["collect"]
+
+	output := core.NewGenericEnvelope([]any{}, api.Trace{RunID: "run", NodeID: "split"})
+	expansion := expandSplit(graph.Steps, split, output)
+
+	assert.Empty(t, expansion.Created)
+	assert.ElementsMatch(t, []uuid.UUID{byNode["fetch"].ID, byNode["store"].ID}, expansion.Skipped)
+	assert.Equal(t, StepStatusSkipped, byNode["fetch"].Status)
+	assert.Equal(t, StepStatusSkipped, byNode["store"].Status)
+
+	// The aggregator runs right after the splitter
+	assert.Contains(t, expansion.Updated, collect)
+	assert.Equal(t, StepStatusPending, collect.Status)
+	assert.Equal(t, []uuid.UUID{split.ID}, collect.DependsOn)
+	split.Status = StepStatusCompleted
+	live, resolved := dependencyState(collect, map[uuid.UUID]*WorkflowStep{split.ID: split})
+	assert.True(t, resolved)
+	assert.Equal(t, []uuid.UUID{split.ID}, live)
+
+	// and emits an empty merged envelope
+	require.NotNil(t, collect.InputEnvelope)
+	assert.Equal(t, "collect", collect.InputEnvelope.Trace.NodeID)
+	count, _ := collect.InputEnvelope.GetMeta("aggregated_count")
+	assert.Equal(t, "0", count)
+
+	result, err := core.NewAggregatorNode().ExecuteEnvelope(api.ExecutionContext{},
+		api.Node{ID: "collect", Type: core.AggregatorNodeType, Data: map[string]any{}}, collect.InputEnvelope)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []any{}, result.Data)
+}
+
+func TestAggregateStepInputs(t *testing.T) {
+	var envelopes []*api.Envelope[any]
+	for i, item := range []string{"a", "b"} {
+		envelope := core.NewGenericEnvelope(item, api.Trace{RunID: "run", ParentID: "split", NodeID: "store"})
+		envelope.SetMeta("split_index", splitPath("", i))
+		envelope.SetMeta("split_total", "2")
+		envelopes = append(envelopes, envelope)
+	}
+
+	input := aggregateStepInputs("collect", envelopes)
+	assert.Equal(t, []any{"a", "b"}, input.Data)
+	assert.Equal(t, "collect", input.Trace.NodeID)
+
+	_, hasIndex := input.GetMeta("split_index")
+	assert.False(t, hasIndex)
+	count, _ := input.GetMeta("aggregated_count")
+	assert.Equal(t, "2", count)
+
+	// The aggregator node emits the merged envelope as is
+	output, err := core.NewAggregatorNode().ExecuteEnvelope(api.ExecutionContext{},
+		api.Node{ID: "collect", Type: core.AggregatorNodeType, Data: map[string]any{}}, input)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, []any{"a", "b"}, output.Data)
+}
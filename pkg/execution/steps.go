@@ -0,0 +1,230 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// loadWorkflowStep loads a workflow step including its configuration and envelopes
+func loadWorkflowStep(ctx context.Context, db *sql.DB, stepID uuid.UUID) (*WorkflowStep, error) {
+	query := `
+		SELECT id, run_id, node_id, node_type, step_number, status, attempt_count,
+		       max_attempts, input_envelope, output_envelope, node_config, depends_on,
+		       branch_conditions, selected_branch, split_path, lease_epoch
+		FROM workflow_steps WHERE id = $1`
+
+	var step WorkflowStep
+	var inputJSON, outputJSON, configJSON, branchJSON []byte
+	err := db.QueryRowContext(ctx, query, stepID).Scan(
+		&step.ID, &step.RunID, &step.NodeID, &step.NodeType, &step.StepNumber, &step.Status,
+		&step.AttemptCount, &step.MaxAttempts, &inputJSON, &outputJSON, &configJSON,
+		pq.Array(&step.DependsOn), &branchJSON, &step.SelectedBranch, &step.SplitPath,
+		&step.LeaseEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(inputJSON) > 0 {
+		if err := json.Unmarshal(inputJSON, &step.InputEnvelope); err != nil {
+			return nil, fmt.Errorf("failed to parse input envelope: %w", err)
+		}
+	}
+	if len(outputJSON) > 0 {
+		if err := json.Unmarshal(outputJSON, &step.OutputEnvelope); err != nil {
+			return nil, fmt.Errorf("failed to parse output envelope: %w", err)
+		}
+	}
+	if len(configJSON) > 0 {
+		if err := json.Unmarshal(configJSON, &step.NodeConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse node config: %w", err)
+		}
+	}
+	if len(branchJSON) > 0 {
+		if err := json.Unmarshal(branchJSON, &step.BranchConditions); err != nil {
+			return nil, fmt.Errorf("failed to parse branch conditions: %w", err)
+		}
+	}
+
+	return &step, nil
+}
+
+// resolveStepInput makes sure the step has an input envelope. Steps without a
+// stored input receive the output of the given dependencies: a single
+// dependency is passed through, multiple dependencies are merged into an
+// array. The resolved envelope is persisted so that retries see the same input.
+func resolveStepInput(ctx context.Context, db *sql.DB, step *WorkflowStep, dependencies []uuid.UUID) error {
+	if step.InputEnvelope != nil || len(dependencies) == 0 {
+		return nil
+	}
+
+	query := `SELECT id, output_envelope FROM workflow_steps WHERE id = ANY($1)`
+	rows, err := db.QueryContext(ctx, query, pq.Array(dependencies))
+	if err != nil {
+		return fmt.Errorf("failed to load dependency outputs: %w", err)
+	}
+	defer rows.Close()
+
+	outputs := make(map[uuid.UUID]*api.Envelope[any], len(dependencies))
+	for rows.Next() {
+		var id uuid.UUID
+		var outputJSON []byte
+		if err := rows.Scan(&id, &outputJSON); err != nil {
+			return fmt.Errorf("failed to scan dependency output: %w", err)
+		}
+		if len(outputJSON) == 0 {
+			continue
+		}
+
+		var envelope api.Envelope[any]
+		if err := json.Unmarshal(outputJSON, &envelope); err != nil {
+			return fmt.Errorf("failed to parse dependency output: %w", err)
+		}
+		outputs[id] = &envelope
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read dependency outputs: %w", err)
+	}
+
+	// Keep the order in which dependencies were declared
+	var envelopes []*api.Envelope[any]
+	for _, dependencyID := range dependencies {
+		if envelope, ok := outputs[dependencyID]; ok {
+			envelopes = append(envelopes, envelope)
+		}
+	}
+
+	if len(envelopes) == 0 {
+		return fmt.Errorf("no outputs available for dependencies of step %s", step.ID)
+	}
+
+	if step.NodeType == core.AggregatorNodeType {
+		step.InputEnvelope = aggregateStepInputs(step.NodeID, envelopes)
+	} else {
+		step.InputEnvelope = mergeStepInputs(step.NodeID, envelopes)
+	}
+
+	inputJSON, err := json.Marshal(step.InputEnvelope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal input envelope: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `UPDATE workflow_steps SET input_envelope = $1 WHERE id = $2`, inputJSON, step.ID); err != nil {
+		return fmt.Errorf("failed to store input envelope: %w", err)
+	}
+
+	return nil
+}
+
+// mergeStepInputs builds the input envelope of a node from its dependency
+// outputs. A wait recorded by a dependency is over once the node runs, so it
+// is not passed on.
+func mergeStepInputs(nodeID string, envelopes []*api.Envelope[any]) *api.Envelope[any] {
+	var input *api.Envelope[any]
+	if len(envelopes) == 1 {
+		input = envelopes[0].Clone()
+		input.ID = core.GenerateEnvelopeID()
+		input.Trace = input.Trace.Next(nodeID)
+	} else {
+		merged := core.MergeEnvelopes(envelopes)
+		input = core.TransformEnvelope(merged, func(data []any) any { return data })
+		input.Trace = merged.Trace.Next(nodeID)
+	}
+
+	delete(input.Meta, api.MetaWaitUntil)
+	return input
+}
+
+// aggregateStepInputs merges the outputs of the split item steps feeding an
+// aggregator into a single array envelope, in split item order
+func aggregateStepInputs(nodeID string, envelopes []*api.Envelope[any]) *api.Envelope[any] {
+	merged := core.MergeEnvelopes(envelopes)
+	input := core.TransformEnvelope(merged, func(data []any) any { return data })
+	input.Trace = merged.Trace.Next(nodeID)
+
+	if input.Meta == nil {
+		input.Meta = make(map[string]string)
+	}
+	delete(input.Meta, "split_index")
+	delete(input.Meta, api.MetaWaitUntil)
+	input.Meta["aggregated_count"] = fmt.Sprintf("%d", len(envelopes))
+	input.Meta["aggregation_complete"] = "true"
+
+	return input
+}
+
+// emptyAggregateInput builds the input of an aggregator closing a split
+// without items: a merged envelope holding no items
+func emptyAggregateInput(nodeID string, splitter *WorkflowStep, output *api.Envelope[any]) *api.Envelope[any] {
+	trace := api.Trace{RunID: splitter.RunID.String(), NodeID: splitter.NodeID}
+	var variables map[string]any
+	if output != nil {
+		trace = output.Trace
+		variables = output.Variables
+	}
+
+	input := core.NewGenericEnvelope([]any{}, trace.Next(nodeID))
+	input.Variables = variables
+	input.Meta = map[string]string{
+		"aggregated_count":     "0",
+		"aggregation_complete": "true",
+	}
+	return input
+}
+
+// outputWaitUntil returns until when the nodes following a step wait, or nil
+// when its output does not pause the workflow
+func outputWaitUntil(output *api.Envelope[any]) *time.Time {
+	if output == nil {
+		return nil
+	}
+
+	value, ok := output.GetMeta(api.MetaWaitUntil)
+	if !ok {
+		return nil
+	}
+
+	waitUntil, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &waitUntil
+}
+
+// newEntryEnvelope creates the input envelope of an entry point step from the run input
+func newEntryEnvelope(run *WorkflowRun, nodeID string) *api.Envelope[any] {
+	agentID := run.AgentID.String()
+	if run.WorkflowID != nil {
+		agentID = run.WorkflowID.String()
+	}
+
+	var data any = run.InputData
+	if run.InputData == nil {
+		data = map[string]any{}
+	}
+
+	envelope := core.NewGenericEnvelope(data, api.Trace{
+		AgentID: agentID,
+		RunID:   run.ID.String(),
+		NodeID:  nodeID,
+		Step:    nodeID,
+		Attempt: 1,
+	})
+
+	if len(run.Variables) > 0 {
+		envelope.Variables = make(map[string]any, len(run.Variables))
+		for k, v := range run.Variables {
+			envelope.Variables[k] = v
+		}
+	}
+
+	return envelope
+}
@@ -0,0 +1,44 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputWaitUntil(t *testing.T) {
+	waitUntil := time.Date(2030, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	output := core.NewGenericEnvelope("data", api.Trace{NodeID: "wait"})
+	assert.Nil(t, outputWaitUntil(output))
+	assert.Nil(t, outputWaitUntil(nil))
+
+	output.SetMeta(api.MetaWaitUntil, waitUntil.Format(time.RFC3339Nano))
+	result := outputWaitUntil(output)
+	require.NotNil(t, result)
+	assert.True(t, waitUntil.Equal(*result))
+
+	output.SetMeta(api.MetaWaitUntil, "later")
+	assert.Nil(t, outputWaitUntil(output))
+}
+
+func TestMergeStepInputsEndsWait(t *testing.T) {
+	output := core.NewGenericEnvelope("data", api.Trace{NodeID: "wait"})
+	output.SetMeta(api.MetaWaitUntil, time.Now().Format(time.RFC3339Nano))
+	output.SetMeta("source", "wait")
+
+	input := mergeStepInputs("next", []*api.Envelope[any]{output})
+
+	_, waiting := input.GetMeta(api.MetaWaitUntil)
+	assert.False(t, waiting)
+	source, _ := input.GetMeta("source")
+	assert.Equal(t, "wait", source)
+
+	// The output of the waiting step keeps the wait
+	_, waiting = output.GetMeta(api.MetaWaitUntil)
+	assert.True(t, waiting)
+}
@@ -2,6 +2,7 @@ package execution
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
@@ -20,6 +21,11 @@ const (
 	RunStatusCancelled WorkflowRunStatus = "cancelled"
 )
 
+// IsTerminal reports whether a run in this status has finished for good
+func (s WorkflowRunStatus) IsTerminal() bool {
+	return s == RunStatusCompleted || s == RunStatusFailed || s == RunStatusCancelled
+}
+
 // StepStatus represents the status of a workflow step
 type StepStatus string
 
@@ -30,6 +36,7 @@ const (
 	StepStatusFailed    StepStatus = "failed"
 	StepStatusSkipped   StepStatus = "skipped"
 	StepStatusRetrying  StepStatus = "retrying"
+	StepStatusWaiting   StepStatus = "waiting"
 )
 
 // WorkerStatus represents the status of a worker
@@ -46,6 +53,7 @@ const (
 type WorkflowRun struct {
 	ID               uuid.UUID         `json:"id" db:"id"`
 	AgentID          uuid.UUID         `json:"agent_id" db:"agent_id"`
+	WorkflowID       *uuid.UUID        `json:"workflow_id,omitempty" db:"workflow_id"`
 	VersionID        uuid.UUID         `json:"version_id" db:"version_id"`
 	TriggerID        *uuid.UUID        `json:"trigger_id,omitempty" db:"trigger_id"`
 	Status           WorkflowRunStatus `json:"status" db:"status"`
@@ -63,6 +71,20 @@ type WorkflowRun struct {
 	TotalSteps       int               `json:"total_steps" db:"total_steps"`
 	CompletedSteps   int               `json:"completed_steps" db:"completed_steps"`
 	FailedSteps      int               `json:"failed_steps" db:"failed_steps"`
+	// ErrorSourceRunID references the failed run when this run was started
+	// by the error workflow of its workflow
+	ErrorSourceRunID *uuid.UUID `json:"error_source_run_id,omitempty" db:"error_source_run_id"`
+	// ReplaySourceRunID references the run this run replays from one of
+	// its nodes
+	ReplaySourceRunID *uuid.UUID `json:"replay_source_run_id,omitempty" db:"replay_source_run_id"`
+	// ParentRunID and ParentStepID reference the run and the workflow_call
+	// step that started this run as a child
+	ParentRunID  *uuid.UUID `json:"parent_run_id,omitempty" db:"parent_run_id"`
+	ParentStepID *uuid.UUID `json:"parent_step_id,omitempty" db:"parent_step_id"`
+	// IdempotencyKey deduplicates starts of the run: while the key is
+	// retained, starting another run of the workflow with the same key
+	// returns this run
+	IdempotencyKey *string `json:"idempotency_key,omitempty" db:"idempotency_key"`
 }
 
 // WorkflowStep represents a single node execution within a workflow run
@@ -86,6 +108,18 @@ type WorkflowStep struct {
 	AssignedWorkerID *string            `json:"assigned_worker_id,omitempty" db:"assigned_worker_id"`
 	WorkerHeartbeat  *time.Time         `json:"worker_heartbeat,omitempty" db:"worker_heartbeat"`
 	DependsOn        []uuid.UUID        `json:"depends_on" db:"depends_on"`
+	// BranchConditions holds the branch labels of the edges from each
+	// dependency. Dependencies without labels are followed unconditionally.
+	BranchConditions map[uuid.UUID][]string `json:"branch_conditions,omitempty" db:"branch_conditions"`
+	// SelectedBranch is the branch a branching node took
+	SelectedBranch *string `json:"selected_branch,omitempty" db:"selected_branch"`
+	// SplitPath identifies the split item a step instance processes. It is
+	// empty for steps outside of a split.
+	SplitPath string `json:"split_path,omitempty" db:"split_path"`
+	// LeaseEpoch fences the results of the step: it grows with every
+	// execution, and only the execution holding the current epoch may
+	// record a result
+	LeaseEpoch int64 `json:"lease_epoch" db:"lease_epoch"`
 }
 
 // WorkflowWorker represents a worker instance in the pool
@@ -124,6 +158,29 @@ func DefaultRetryPolicy() RetryPolicy {
 	}
 }
 
+// Errors returned by the ExecutionEngine when a run or step cannot be worked on
+var (
+	ErrRunNotActive = errors.New("workflow run is not active")
+	ErrStepNotReady = errors.New("step dependencies are not completed")
+	ErrStepFinished = errors.New("step has already finished")
+	ErrLeaseLost    = errors.New("lease is no longer held")
+)
+
+// Errors returned by the ExecutionEngine when a signal cannot be delivered
+var (
+	ErrSignalNotAwaited   = errors.New("no step is waiting for the signal")
+	ErrInvalidSignalToken = errors.New("invalid signal token")
+)
+
+// Errors returned by the ExecutionEngine when a run cannot be replayed
+var (
+	ErrNodeNotFound      = errors.New("node not found in workflow")
+	ErrReplayNotPossible = errors.New("run cannot be replayed from the node")
+)
+
+// ErrDeadLetterNotFound is returned for dead letters that do not exist
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
 // ExecutionEngine defines the interface for workflow execution
 type ExecutionEngine interface {
 	// Run management
@@ -131,10 +188,17 @@ type ExecutionEngine interface {
 	PauseRun(ctx context.Context, runID uuid.UUID) error
 	ResumeRun(ctx context.Context, runID uuid.UUID) error
 	CancelRun(ctx context.Context, runID uuid.UUID) error
+	SignalRun(ctx context.Context, runID uuid.UUID, signal, token string, payload map[string]any) (uuid.UUID, error)
+	ReplayRun(ctx context.Context, runID uuid.UUID, nodeID string, input *api.Envelope[any]) (*WorkflowRun, error)
 
 	// Step execution
+	InitializeRun(ctx context.Context, runID uuid.UUID, workerID string) ([]uuid.UUID, error)
+	FinalizeRun(ctx context.Context, runID uuid.UUID) error
+	PrepareStep(ctx context.Context, stepID uuid.UUID, workerID string) (*WorkflowStep, error)
 	ExecuteStep(ctx context.Context, step *WorkflowStep) (*api.Envelope[any], error)
+	RecordStepResult(ctx context.Context, stepID uuid.UUID, lease int64, output *api.Envelope[any], stepErr error) (*WorkResult, error)
 	RetryStep(ctx context.Context, stepID uuid.UUID) error
+	RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error
 
 	// Worker management
 	RegisterWorker(ctx context.Context, worker *WorkflowWorker) error
@@ -143,11 +207,21 @@ type ExecutionEngine interface {
 
 	// Queue management
 	ClaimWork(ctx context.Context, workerID string, maxItems int) ([]*QueueItem, error)
-	CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, result *WorkResult) error
+	WaitForWork(ctx context.Context) error
+	CompleteWork(ctx context.Context, workerID string, itemID uuid.UUID, lease int64, result *WorkResult) error
 
 	// Recovery
 	RecoverOrphanedWork(ctx context.Context, workerTimeoutDuration time.Duration) error
 	RecoverFailedRuns(ctx context.Context) error
+	TimeoutRuns(ctx context.Context) ([]uuid.UUID, error)
+	InactiveRuns(ctx context.Context, runIDs []uuid.UUID) ([]uuid.UUID, error)
+	TimeoutSignals(ctx context.Context) error
+
+	// Dead letters
+	ListDeadLetters(ctx context.Context, runID *uuid.UUID, limit, offset int) ([]*DeadLetter, int, error)
+	GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error)
+	RequeueDeadLetter(ctx context.Context, id uuid.UUID) error
+	DiscardDeadLetter(ctx context.Context, id uuid.UUID) error
 }
 
 // QueueItem represents a work item in the execution queue
@@ -164,6 +238,28 @@ type QueueItem struct {
 	AttemptCount int            `json:"attempt_count" db:"attempt_count"`
 	MaxAttempts  int            `json:"max_attempts" db:"max_attempts"`
 	Payload      map[string]any `json:"payload,omitempty" db:"payload"`
+	// LeaseEpoch grows with every claim. Only the worker holding the
+	// current epoch may complete the item.
+	LeaseEpoch int64 `json:"lease_epoch" db:"lease_epoch"`
+}
+
+// DeadLetter is a work item that ran out of attempts. It keeps the ID of the
+// queue item.
+type DeadLetter struct {
+	ID           uuid.UUID          `json:"id" db:"id"`
+	RunID        uuid.UUID          `json:"run_id" db:"run_id"`
+	StepID       *uuid.UUID         `json:"step_id,omitempty" db:"step_id"`
+	QueueType    QueueType          `json:"queue_type" db:"queue_type"`
+	Priority     int                `json:"priority" db:"priority"`
+	AttemptCount int                `json:"attempt_count" db:"attempt_count"`
+	MaxAttempts  int                `json:"max_attempts" db:"max_attempts"`
+	Payload      map[string]any     `json:"payload,omitempty" db:"payload"`
+	LastError    *string            `json:"last_error,omitempty" db:"last_error"`
+	WorkerID     *string            `json:"worker_id,omitempty" db:"worker_id"`
+	Envelope     *api.Envelope[any] `json:"envelope,omitempty" db:"envelope"`
+	// CreatedAt is when the work item was queued
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	DeadLetteredAt time.Time `json:"dead_lettered_at" db:"dead_lettered_at"`
 }
 
 // QueueType represents different types of queue items
@@ -208,34 +304,56 @@ type WorkflowGraph struct {
 	EntryPoints  []uuid.UUID      `json:"entry_points"`
 }
 
-// IsRetryable determines if an error should be retried based on the retry policy
+// IsRetryable determines if an error should be retried based on the retry
+// policy. Errors are classified by their api.ErrorCode; the policy lists may
+// name codes or exact error messages. Validation errors are never retried,
+// client errors are not retried unless the policy lists them.
 func (rp *RetryPolicy) IsRetryable(err error, attemptCount int) bool {
 	// Check attempt count
 	if attemptCount >= rp.MaxAttempts {
 		return false
 	}
 
+	code := api.ErrorCode(err)
+	if code == api.ErrorCodeValidation {
+		return false
+	}
+
 	errMsg := err.Error()
 
 	// Check non-retryable errors first
-	for _, nonRetryable := range rp.NonRetryableErrors {
-		if nonRetryable == errMsg {
-			return false
-		}
+	if matchesError(rp.NonRetryableErrors, code, errMsg) {
+		return false
 	}
 
 	// If retryable errors are specified, only retry those
 	if len(rp.RetryableErrors) > 0 {
-		for _, retryable := range rp.RetryableErrors {
-			if retryable == errMsg {
-				return true
-			}
-		}
+		return matchesError(rp.RetryableErrors, code, errMsg)
+	}
+
+	return retryableByDefault(code)
+}
+
+// retryableByDefault reports whether errors with the code are retried when
+// the policy does not list them. Unclassified errors are retried.
+func retryableByDefault(code string) bool {
+	switch code {
+	case api.ErrorCodeBadRequest, api.ErrorCodeUnauthorized, api.ErrorCodeNotFound:
 		return false
+	default:
+		return true
 	}
+}
 
-	// Default: retry most errors except for specific non-retryable ones
-	return true
+// matchesError reports whether an entry of a retry policy list names the
+// error code or the error message
+func matchesError(entries []string, code, message string) bool {
+	for _, entry := range entries {
+		if (code != "" && entry == code) || entry == message {
+			return true
+		}
+	}
+	return false
 }
 
 // CalculateRetryDelay calculates the delay before the next retry attempt
@@ -3,6 +3,7 @@ package execution
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -11,7 +12,6 @@ import (
 
 	"github.com/cedricziel/mel-agent/pkg/api"
 	"github.com/google/uuid"
-	"github.com/lib/pq"
 )
 
 // Worker represents a workflow execution worker
@@ -30,6 +30,7 @@ type Worker struct {
 	mu           sync.RWMutex
 	running      bool
 	currentSteps map[uuid.UUID]*WorkflowStep
+	stepCancels  map[uuid.UUID]context.CancelFunc
 	ctx          context.Context
 	cancel       context.CancelFunc
 
@@ -37,6 +38,7 @@ type Worker struct {
 	heartbeatInterval time.Duration
 	pollInterval      time.Duration
 	workerTimeout     time.Duration
+	databaseURL       string
 }
 
 // NewWorker creates a new workflow worker
@@ -52,9 +54,11 @@ func NewWorker(db *sql.DB, mel api.Mel, config WorkerConfig) *Worker {
 		db:                 db,
 		mel:                mel,
 		currentSteps:       make(map[uuid.UUID]*WorkflowStep),
+		stepCancels:        make(map[uuid.UUID]context.CancelFunc),
 		heartbeatInterval:  config.HeartbeatInterval,
 		pollInterval:       config.PollInterval,
 		workerTimeout:      config.WorkerTimeout,
+		databaseURL:        config.DatabaseURL,
 	}
 
 	worker.engine = NewDurableExecutionEngine(db, mel, worker.id)
@@ -69,6 +73,9 @@ type WorkerConfig struct {
 	HeartbeatInterval  time.Duration
 	PollInterval       time.Duration
 	WorkerTimeout      time.Duration
+	// DatabaseURL is used to listen for enqueued work, which the worker then
+	// claims right away. Without it the worker only polls.
+	DatabaseURL string
 }
 
 // DefaultWorkerConfig returns sensible defaults
@@ -136,6 +143,13 @@ func (w *Worker) Start(ctx context.Context) error {
 		w.recoveryLoop()
 	}()
 
+	// Cancellation goroutine
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.cancellationLoop()
+	}()
+
 	// Wait for context cancellation
 	<-w.ctx.Done()
 
@@ -178,7 +192,8 @@ func (w *Worker) Stop() error {
 	return nil
 }
 
-// heartbeatLoop maintains the worker's heartbeat
+// heartbeatLoop maintains the worker's heartbeat and the leases of the
+// steps it executes
 func (w *Worker) heartbeatLoop() {
 	ticker := time.NewTicker(w.heartbeatInterval)
 	defer ticker.Stop()
@@ -191,30 +206,79 @@ func (w *Worker) heartbeatLoop() {
 			if err := w.engine.UpdateWorkerHeartbeat(w.ctx, w.id); err != nil {
 				log.Printf("Failed to update heartbeat: %v", err)
 			}
+			w.renewStepLeases()
 		}
 	}
 }
 
-// workLoop continuously polls for and processes work
+// renewStepLeases renews the leases of the executing steps, so that steps
+// running longer than the worker timeout are not recovered as orphaned.
+// Steps whose lease was lost are stopped, another execution replaced them.
+func (w *Worker) renewStepLeases() {
+	w.mu.RLock()
+	steps := make([]*WorkflowStep, 0, len(w.currentSteps))
+	for _, step := range w.currentSteps {
+		steps = append(steps, step)
+	}
+	w.mu.RUnlock()
+
+	for _, step := range steps {
+		err := w.engine.RenewStepLease(w.ctx, w.id, step.ID, step.LeaseEpoch)
+		if errors.Is(err, ErrLeaseLost) {
+			log.Printf("Stopping step %s, its lease was lost", step.ID)
+			w.mu.RLock()
+			if cancel, ok := w.stepCancels[step.ID]; ok {
+				cancel()
+			}
+			w.mu.RUnlock()
+		} else if err != nil {
+			log.Printf("Failed to renew lease of step %s: %v", step.ID, err)
+		}
+	}
+}
+
+// workLoop claims and processes work whenever it is enqueued, and polls for it
 func (w *Worker) workLoop() {
 	ticker := time.NewTicker(w.pollInterval)
 	defer ticker.Stop()
 
+	// Claim work as soon as it is enqueued. Polling continues as a fallback
+	// for work that becomes available later, like retries and delays.
+	var listener *QueueListener
+	var notified <-chan struct{}
+	if w.databaseURL != "" {
+		var err error
+		if listener, err = NewQueueListener(w.databaseURL); err != nil {
+			log.Printf("Worker %s failed to listen for enqueued work, polling only: %v", w.id, err)
+		} else {
+			defer listener.Close()
+			notified = listener.Notified()
+		}
+	}
+
 	for {
 		select {
 		case <-w.ctx.Done():
 			return
 		case <-ticker.C:
 			w.processWork()
+		case <-notified:
+			// Renew before claiming so work enqueued meanwhile is not missed
+			notified = listener.Notified()
+			w.processWork()
 		}
 	}
 }
 
-// recoveryLoop periodically recovers orphaned work
+// recoveryLoop periodically recovers orphaned work, fails runs that exceeded
+// their timeout and continues steps whose wait for a signal timed out
 func (w *Worker) recoveryLoop() {
 	ticker := time.NewTicker(w.workerTimeout)
 	defer ticker.Stop()
 
+	timeoutTicker := time.NewTicker(w.heartbeatInterval)
+	defer timeoutTicker.Stop()
+
 	for {
 		select {
 		case <-w.ctx.Done():
@@ -223,6 +287,92 @@ func (w *Worker) recoveryLoop() {
 			if err := w.engine.RecoverOrphanedWork(w.ctx, w.workerTimeout); err != nil {
 				log.Printf("Failed to recover orphaned work: %v", err)
 			}
+		case <-timeoutTicker.C:
+			w.timeoutRuns()
+			if err := w.engine.TimeoutSignals(w.ctx); err != nil {
+				log.Printf("Failed to time out signals: %v", err)
+			}
+		}
+	}
+}
+
+// timeoutRuns fails the runs that exceeded their timeout and stops their
+// steps running on this worker
+func (w *Worker) timeoutRuns() {
+	runIDs, err := w.engine.TimeoutRuns(w.ctx)
+	if err != nil {
+		log.Printf("Failed to time out runs: %v", err)
+		return
+	}
+
+	for _, runID := range runIDs {
+		log.Printf("Workflow run %s exceeded its timeout", runID)
+		w.cancelRunSteps(runID)
+	}
+}
+
+// cancellationLoop periodically stops the steps of runs that were cancelled
+// or have otherwise finished while the steps execute
+func (w *Worker) cancellationLoop() {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.cancelInactiveRuns()
+		}
+	}
+}
+
+// cancelInactiveRuns cancels the steps of inactive runs executing on this worker
+func (w *Worker) cancelInactiveRuns() {
+	runIDs := w.currentRunIDs()
+	if len(runIDs) == 0 {
+		return
+	}
+
+	inactive, err := w.engine.InactiveRuns(w.ctx, runIDs)
+	if err != nil {
+		log.Printf("Failed to check for inactive runs: %v", err)
+		return
+	}
+
+	for _, runID := range inactive {
+		log.Printf("Stopping steps of inactive run %s", runID)
+		w.cancelRunSteps(runID)
+	}
+}
+
+// currentRunIDs returns the runs of the steps executing on this worker
+func (w *Worker) currentRunIDs() []uuid.UUID {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	seen := make(map[uuid.UUID]bool)
+	var runIDs []uuid.UUID
+	for _, step := range w.currentSteps {
+		if !seen[step.RunID] {
+			seen[step.RunID] = true
+			runIDs = append(runIDs, step.RunID)
+		}
+	}
+	return runIDs
+}
+
+// cancelRunSteps cancels the context of the steps of a run executing on this worker
+func (w *Worker) cancelRunSteps(runID uuid.UUID) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	for stepID, step := range w.currentSteps {
+		if step.RunID != runID {
+			continue
+		}
+		if cancel, ok := w.stepCancels[stepID]; ok {
+			cancel()
 		}
 	}
 }
@@ -265,7 +415,10 @@ func (w *Worker) processQueueItem(item *QueueItem) {
 		duration := time.Since(start)
 
 		// Complete the work item
-		if err := w.engine.CompleteWork(w.ctx, w.id, item.ID, result); err != nil {
+		err := w.engine.CompleteWork(w.ctx, w.id, item.ID, item.LeaseEpoch, result)
+		if errors.Is(err, ErrLeaseLost) {
+			log.Printf("Discarding result of work item %s, its claim was recovered", item.ID)
+		} else if err != nil {
 			log.Printf("Failed to complete work item %s: %v", item.ID, err)
 		}
 
@@ -289,38 +442,15 @@ func (w *Worker) processQueueItem(item *QueueItem) {
 
 // processStartRun processes a workflow run start
 func (w *Worker) processStartRun(item *QueueItem) *WorkResult {
-	// Load the workflow run
-	run, err := w.loadWorkflowRun(item.RunID)
-	if err != nil {
-		return &WorkResult{
-			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to load run: %v", err)),
-		}
+	nextSteps, err := w.engine.InitializeRun(w.ctx, item.RunID, w.id)
+	if errors.Is(err, ErrRunNotActive) {
+		log.Printf("Skipping start of inactive run %s", item.RunID)
+		return &WorkResult{Success: true}
 	}
-
-	// Load the workflow graph
-	graph, err := w.loadWorkflowGraph(run.AgentID, run.VersionID)
 	if err != nil {
 		return &WorkResult{
 			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to load workflow graph: %v", err)),
-		}
-	}
-
-	// Create steps for the workflow
-	steps, err := w.createWorkflowSteps(run, graph)
-	if err != nil {
-		return &WorkResult{
-			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to create workflow steps: %v", err)),
-		}
-	}
-
-	// Queue the entry point steps
-	var nextSteps []uuid.UUID
-	for _, step := range steps {
-		if len(step.DependsOn) == 0 { // Entry point steps have no dependencies
-			nextSteps = append(nextSteps, step.ID)
+			Error:   stringPtr(fmt.Sprintf("failed to initialize run: %v", err)),
 		}
 	}
 
@@ -339,65 +469,66 @@ func (w *Worker) processExecuteStep(item *QueueItem) *WorkResult {
 		}
 	}
 
-	// Load the step
-	step, err := w.loadWorkflowStep(*item.StepID)
-	if err != nil {
-		return &WorkResult{
-			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to load step: %v", err)),
-		}
-	}
-
-	// Check dependencies
-	ready, err := w.areStepDependenciesReady(step)
-	if err != nil {
-		return &WorkResult{
-			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to check dependencies: %v", err)),
-		}
-	}
-
-	if !ready {
+	// Load the step and resolve its input
+	step, err := w.engine.PrepareStep(w.ctx, *item.StepID, w.id)
+	switch {
+	case errors.Is(err, ErrRunNotActive), errors.Is(err, ErrStepFinished):
+		// Drop work for runs that are no longer active and for steps that
+		// were already handled by another queue item
+		return &WorkResult{Success: true}
+	case errors.Is(err, ErrStepNotReady):
 		// Dependencies not ready, requeue for later
 		return &WorkResult{
 			Success:     false,
 			ShouldRetry: true,
 			RetryDelay:  durationPtr(30 * time.Second),
 		}
+	case err != nil:
+		return &WorkResult{
+			Success: false,
+			Error:   stringPtr(fmt.Sprintf("failed to prepare step: %v", err)),
+		}
 	}
 
-	// Add step to current steps
+	// Add step to current steps. Its context is cancelled when the run is
+	// stopped while the step executes.
+	stepCtx, cancel := context.WithCancel(w.ctx)
+	defer cancel()
+
 	w.mu.Lock()
 	w.currentSteps[step.ID] = step
+	w.stepCancels[step.ID] = cancel
 	w.mu.Unlock()
 
 	defer func() {
 		w.mu.Lock()
 		delete(w.currentSteps, step.ID)
+		delete(w.stepCancels, step.ID)
 		w.mu.Unlock()
 	}()
 
-	// Execute the step
-	output, err := w.engine.ExecuteStep(w.ctx, step)
+	// Execute the step and record its outcome
+	output, execErr := w.engine.ExecuteStep(stepCtx, step)
+	result, err := w.engine.RecordStepResult(w.ctx, step.ID, step.LeaseEpoch, output, execErr)
+	if errors.Is(err, ErrRunNotActive) {
+		// The run was cancelled or failed while the step executed
+		log.Printf("Discarding result of step %s of inactive run %s", step.ID, step.RunID)
+		return &WorkResult{Success: true}
+	}
+	if errors.Is(err, ErrLeaseLost) {
+		// The step was recovered and handed to another execution, whose
+		// result is the one that counts
+		log.Printf("Discarding result of step %s, its lease was lost", step.ID)
+		return &WorkResult{Success: true}
+	}
 	if err != nil {
 		return &WorkResult{
-			Success:     false,
-			Error:       stringPtr(fmt.Sprintf("step execution failed: %v", err)),
-			ShouldRetry: true,
+			Success: false,
+			Error:   stringPtr(fmt.Sprintf("failed to record step result: %v", err)),
 		}
 	}
 
-	// Find next steps
-	nextSteps, err := w.findNextSteps(step.RunID, step.ID)
-	if err != nil {
-		log.Printf("Failed to find next steps: %v", err)
-	}
-
-	return &WorkResult{
-		Success:    true,
-		OutputData: map[string]any{"envelope": output},
-		NextSteps:  nextSteps,
-	}
+	return result
 }
 
 // processRetryStep processes a step retry
@@ -408,97 +539,16 @@ func (w *Worker) processRetryStep(item *QueueItem) *WorkResult {
 
 // processCompleteRun processes workflow run completion
 func (w *Worker) processCompleteRun(item *QueueItem) *WorkResult {
-	// Update run status to completed
-	query := `UPDATE workflow_runs SET status = 'completed', completed_at = NOW() WHERE id = $1`
-	if _, err := w.db.ExecContext(w.ctx, query, item.RunID); err != nil {
+	if err := w.engine.FinalizeRun(w.ctx, item.RunID); err != nil {
 		return &WorkResult{
 			Success: false,
-			Error:   stringPtr(fmt.Sprintf("failed to complete run: %v", err)),
+			Error:   stringPtr(err.Error()),
 		}
 	}
 
 	return &WorkResult{Success: true}
 }
 
-// Helper methods
-
-func (w *Worker) loadWorkflowRun(runID uuid.UUID) (*WorkflowRun, error) {
-	query := `SELECT id, agent_id, version_id, status FROM workflow_runs WHERE id = $1`
-	row := w.db.QueryRowContext(w.ctx, query, runID)
-
-	var run WorkflowRun
-	if err := row.Scan(&run.ID, &run.AgentID, &run.VersionID, &run.Status); err != nil {
-		return nil, err
-	}
-	return &run, nil
-}
-
-func (w *Worker) loadWorkflowStep(stepID uuid.UUID) (*WorkflowStep, error) {
-	query := `SELECT id, run_id, node_id, node_type, status, depends_on FROM workflow_steps WHERE id = $1`
-	row := w.db.QueryRowContext(w.ctx, query, stepID)
-
-	var step WorkflowStep
-	if err := row.Scan(&step.ID, &step.RunID, &step.NodeID, &step.NodeType, &step.Status, pq.Array(&step.DependsOn)); err != nil {
-		return nil, err
-	}
-	return &step, nil
-}
-
-func (w *Worker) loadWorkflowGraph(agentID, versionID uuid.UUID) (*WorkflowGraph, error) {
-	// This would load the workflow definition and convert it to a WorkflowGraph
-	// Implementation depends on how workflows are stored
-	return &WorkflowGraph{}, nil
-}
-
-func (w *Worker) createWorkflowSteps(run *WorkflowRun, graph *WorkflowGraph) ([]*WorkflowStep, error) {
-	// This would create WorkflowStep records for each node in the graph
-	// Implementation depends on workflow graph structure
-	return []*WorkflowStep{}, nil
-}
-
-func (w *Worker) areStepDependenciesReady(step *WorkflowStep) (bool, error) {
-	if len(step.DependsOn) == 0 {
-		return true, nil
-	}
-
-	// Check if all dependencies are completed
-	query := `
-		SELECT COUNT(*) FROM workflow_steps 
-		WHERE id = ANY($1) AND status = 'completed'`
-
-	var completedCount int
-	row := w.db.QueryRowContext(w.ctx, query, pq.Array(step.DependsOn))
-	if err := row.Scan(&completedCount); err != nil {
-		return false, err
-	}
-
-	return completedCount == len(step.DependsOn), nil
-}
-
-func (w *Worker) findNextSteps(runID, completedStepID uuid.UUID) ([]uuid.UUID, error) {
-	// Find steps that depend on the completed step
-	query := `
-		SELECT id FROM workflow_steps 
-		WHERE run_id = $1 AND $2 = ANY(depends_on) AND status = 'pending'`
-
-	rows, err := w.db.QueryContext(w.ctx, query, runID, completedStepID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var nextSteps []uuid.UUID
-	for rows.Next() {
-		var stepID uuid.UUID
-		if err := rows.Scan(&stepID); err != nil {
-			continue
-		}
-		nextSteps = append(nextSteps, stepID)
-	}
-
-	return nextSteps, nil
-}
-
 // Helper functions
 func stringPtr(s string) *string                 { return &s }
 func durationPtr(d time.Duration) *time.Duration { return &d }
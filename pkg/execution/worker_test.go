@@ -0,0 +1,76 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerCancelRunSteps(t *testing.T) {
+	worker := NewWorker(nil, api.NewMel(), DefaultWorkerConfig())
+
+	timedOutRun, otherRun := uuid.New(), uuid.New()
+	contexts := make(map[uuid.UUID]context.Context)
+	for _, runID := range []uuid.UUID{timedOutRun, otherRun} {
+		step := &WorkflowStep{ID: uuid.New(), RunID: runID}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		worker.currentSteps[step.ID] = step
+		worker.stepCancels[step.ID] = cancel
+		contexts[runID] = ctx
+	}
+
+	worker.cancelRunSteps(timedOutRun)
+
+	assert.ErrorIs(t, contexts[timedOutRun].Err(), context.Canceled)
+	assert.NoError(t, contexts[otherRun].Err())
+}
+
+// leaseEngine is an execution engine that renews only the current leases of
+// steps
+type leaseEngine struct {
+	ExecutionEngine
+	leases  map[uuid.UUID]int64
+	renewed []uuid.UUID
+}
+
+func (e *leaseEngine) RenewStepLease(ctx context.Context, workerID string, stepID uuid.UUID, lease int64) error {
+	if e.leases[stepID] != lease {
+		return ErrLeaseLost
+	}
+	e.renewed = append(e.renewed, stepID)
+	return nil
+}
+
+func TestWorkerRenewStepLeases(t *testing.T) {
+	worker := NewWorker(nil, api.NewMel(), DefaultWorkerConfig())
+	worker.ctx = context.Background()
+
+	current := &WorkflowStep{ID: uuid.New(), LeaseEpoch: 2}
+	lost := &WorkflowStep{ID: uuid.New(), LeaseEpoch: 1}
+	engine := &leaseEngine{
+		ExecutionEngine: NewMockExecutionEngine(),
+		leases:          map[uuid.UUID]int64{current.ID: 2, lost.ID: 2},
+	}
+	worker.engine = engine
+
+	contexts := make(map[uuid.UUID]context.Context)
+	for _, step := range []*WorkflowStep{current, lost} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		worker.currentSteps[step.ID] = step
+		worker.stepCancels[step.ID] = cancel
+		contexts[step.ID] = ctx
+	}
+
+	worker.renewStepLeases()
+
+	assert.Equal(t, []uuid.UUID{current.ID}, engine.renewed)
+	assert.NoError(t, contexts[current.ID].Err())
+	assert.ErrorIs(t, contexts[lost.ID].Err(), context.Canceled, "steps that lost their lease are stopped")
+}
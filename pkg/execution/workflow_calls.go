@@ -0,0 +1,210 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
+)
+
+// checkWorkflowCall verifies that the workflow a step calls can be started.
+// A call to an unknown or undeployed workflow fails the step like an error of
+// its node, so that the retry policy and error mode of the node apply.
+func (e *DurableExecutionEngine) checkWorkflowCall(ctx context.Context, step *WorkflowStep, output *api.Envelope[any]) error {
+	if output == nil {
+		return nil
+	}
+	target, ok := output.GetMeta(api.MetaCallWorkflow)
+	if !ok {
+		return nil
+	}
+
+	workflowID, err := uuid.Parse(target)
+	if err != nil {
+		return api.NewNodeErrorWithCode(step.NodeID, step.NodeType,
+			fmt.Sprintf("invalid workflow ID %q", target), api.ErrorCodeValidation)
+	}
+
+	var deployed bool
+	query := `SELECT EXISTS (SELECT 1 FROM workflow_versions WHERE workflow_id = $1 AND is_current = true)`
+	if err := e.db.QueryRowContext(ctx, query, workflowID).Scan(&deployed); err != nil {
+		return fmt.Errorf("failed to load called workflow: %w", err)
+	}
+	if !deployed {
+		return api.NewNodeErrorWithCode(step.NodeID, step.NodeType,
+			fmt.Sprintf("workflow %s has no deployed version", workflowID), api.ErrorCodeNotFound)
+	}
+
+	return nil
+}
+
+// startChildRunTx starts the workflow a step calls as a child run of the
+// step, with the data of the output as its input. The call is removed from
+// the output, so that steps passing the envelope on do not call the workflow
+// again, and replaced by the ID of the child run.
+func (e *DurableExecutionEngine) startChildRunTx(ctx context.Context, tx *sql.Tx, step *WorkflowStep, output *api.Envelope[any], target string) error {
+	workflowID, err := uuid.Parse(target)
+	if err != nil {
+		return fmt.Errorf("invalid workflow ID %q: %w", target, err)
+	}
+
+	var versionID uuid.UUID
+	if err := tx.QueryRowContext(ctx,
+		`SELECT id FROM workflow_versions WHERE workflow_id = $1 AND is_current = true`,
+		workflowID).Scan(&versionID); err != nil {
+		return fmt.Errorf("failed to load called workflow version: %w", err)
+	}
+
+	input := envelopeDataMap(output)
+
+	// Children of calls that wait for their return run no longer than the
+	// caller waits
+	timeoutSeconds := DefaultRunTimeoutSeconds
+	if waitUntil := outputWaitUntil(output); waitUntil != nil {
+		timeoutSeconds = max(int(math.Ceil(time.Until(*waitUntil).Seconds())), 1)
+	}
+
+	child := &WorkflowRun{
+		ID:             uuid.New(),
+		WorkflowID:     &workflowID,
+		VersionID:      versionID,
+		Status:         RunStatusPending,
+		InputData:      input,
+		Variables:      map[string]any{},
+		TimeoutSeconds: timeoutSeconds,
+		RetryPolicy:    DefaultRetryPolicy(),
+		ParentRunID:    &step.RunID,
+		ParentStepID:   &step.ID,
+	}
+	if err := e.createRunTx(ctx, tx, child); err != nil {
+		return fmt.Errorf("failed to start called workflow: %w", err)
+	}
+
+	delete(output.Meta, api.MetaCallWorkflow)
+	output.SetMeta(api.MetaChildRunID, child.ID.String())
+	return nil
+}
+
+// returnToParentTx resumes the step that waits for a child run to return,
+// with the data of the output of the returning step. Returns of runs without
+// a parent, after the parent stopped waiting, or after an earlier return are
+// ignored.
+func (e *DurableExecutionEngine) returnToParentTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID, output *api.Envelope[any]) error {
+	status, step, err := waitingParentTx(ctx, tx, runID)
+	if err != nil || step == nil {
+		return err
+	}
+
+	return e.releaseStepTx(ctx, tx, status, step, returnEnvelope(step, output), api.SignalBranch)
+}
+
+// failParentTx fails the step that waits for a child run which failed, was
+// cancelled or timed out, with the error of the child. The error mode of the
+// calling node decides whether the parent run fails or continues with the
+// error. The call is not retried, that would start the workflow again. Steps
+// whose wait is over are left to continue on their timeout branch.
+func (e *DurableExecutionEngine) failParentTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID, message, code string) error {
+	status, step, err := waitingParentTx(ctx, tx, runID)
+	if err != nil || step == nil {
+		return err
+	}
+	if step.WaitUntil != nil && !step.WaitUntil.After(time.Now()) {
+		return nil
+	}
+
+	var nodeType string
+	var configJSON []byte
+	if err := tx.QueryRowContext(ctx,
+		`SELECT node_type, node_config FROM workflow_steps WHERE id = $1`,
+		step.ID).Scan(&nodeType, &configJSON); err != nil {
+		return fmt.Errorf("failed to load calling step: %w", err)
+	}
+	var config map[string]any
+	if len(configJSON) > 0 {
+		if err := json.Unmarshal(configJSON, &config); err != nil {
+			return fmt.Errorf("failed to decode node config: %w", err)
+		}
+	}
+
+	stepErr := api.NewNodeErrorWithCode(step.NodeID, nodeType, message, code)
+	errorJSON, _ := json.Marshal(map[string]any{
+		"error":     stepErr.Error(),
+		"code":      code,
+		"child_run": runID.String(),
+		"timestamp": time.Now(),
+	})
+
+	settings, _ := ParseNodeSettings(config)
+	mode := settings.ErrorMode()
+	if mode == ErrorModeStop {
+		stepQuery := `
+			UPDATE workflow_steps
+			SET status = 'failed', error_details = $2, signal_token = NULL, completed_at = NOW()
+			WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, stepQuery, step.ID, errorJSON); err != nil {
+			return fmt.Errorf("failed to fail calling step: %w", err)
+		}
+		errMsg := stepErr.Error()
+		return e.failRunTx(ctx, tx, step.RunID, &step.ID, &errMsg, code)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE workflow_steps SET error_details = $2 WHERE id = $1`, step.ID, errorJSON); err != nil {
+		return fmt.Errorf("failed to update step error: %w", err)
+	}
+
+	output := resumedEnvelope(step)
+	output.AddError(step.NodeID, stepErr.Error(), stepErr)
+	branch := noBranch
+	if mode == ErrorModeErrorOutput {
+		branch = api.ErrorBranch
+	}
+	return e.releaseStepTx(ctx, tx, status, step, output, branch)
+}
+
+// waitingParentTx locks the parent run of a child run and returns its status
+// and the step waiting for the child to return. The step is nil for runs
+// without a parent, and when the parent no longer waits for the run.
+func waitingParentTx(ctx context.Context, tx *sql.Tx, runID uuid.UUID) (WorkflowRunStatus, *waitingStep, error) {
+	var parentRunID, parentStepID uuid.NullUUID
+	if err := tx.QueryRowContext(ctx,
+		`SELECT parent_run_id, parent_step_id FROM workflow_runs WHERE id = $1`,
+		runID).Scan(&parentRunID, &parentStepID); err != nil {
+		return "", nil, fmt.Errorf("failed to load parent run: %w", err)
+	}
+	if !parentRunID.Valid || !parentStepID.Valid {
+		return "", nil, nil
+	}
+
+	status, err := lockRunTx(ctx, tx, parentRunID.UUID)
+	if err == sql.ErrNoRows {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if status.IsTerminal() {
+		return status, nil, nil
+	}
+
+	steps, err := loadWaitingStepsTx(ctx, tx, `id = $1 AND wait_signal = $2`, parentStepID.UUID, api.WorkflowReturnSignal)
+	if err != nil || len(steps) == 0 {
+		return status, nil, err
+	}
+	return status, steps[0], nil
+}
+
+// returnEnvelope builds the output of a workflow call whose child run
+// returned: the envelope it waited with, carrying the returned data
+func returnEnvelope(step *waitingStep, returned *api.Envelope[any]) *api.Envelope[any] {
+	output := resumedEnvelope(step)
+	output.Data = returned.Data
+	output.DataType = returned.DataType
+	output.SetMeta(api.MetaBranch, api.SignalBranch)
+	return output
+}
@@ -0,0 +1,33 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnEnvelope(t *testing.T) {
+	childRunID := uuid.New().String()
+	parked := core.NewGenericEnvelope(map[string]any{"order": 42}, api.Trace{NodeID: "call"})
+	parked.SetMeta(api.MetaWaitSignal, api.WorkflowReturnSignal)
+	parked.SetMeta(api.MetaResumeURL, "/api/workflow-runs/1/signals/workflow_return?token=secret")
+	parked.SetMeta(api.MetaChildRunID, childRunID)
+	step := &waitingStep{ID: uuid.New(), RunID: uuid.New(), NodeID: "call", Output: parked}
+
+	returned := core.NewGenericEnvelope(map[string]any{"status": "success", "data": map[string]any{"total": 99}}, api.Trace{NodeID: "return"})
+	returned.DataType = "object"
+	returned.SetMeta(api.MetaReturnStatus, "success")
+
+	output := returnEnvelope(step, returned)
+	assert.Equal(t, returned.Data, output.Data)
+	assert.Equal(t, "object", output.DataType)
+	assert.NotEqual(t, parked.ID, output.ID)
+	assert.Equal(t, "call", output.Trace.NodeID)
+
+	// The call keeps its child run, but neither the wait nor the return
+	// status of the child, which would resume the caller of the parent
+	assert.Equal(t, map[string]string{api.MetaChildRunID: childRunID, api.MetaBranch: api.SignalBranch}, output.Meta)
+}
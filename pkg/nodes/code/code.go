@@ -105,7 +105,7 @@ func (d *codeDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node
 
 	// Execute with timeout
 	// Create cancellable context for runtime execution
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	timeoutCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Duration(timeout)*time.Second)
 	defer cancel()
 
 	resultChan := make(chan codeExecutionResult, 1)
@@ -148,7 +148,7 @@ func (d *codeDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node
 		return resultEnvelope, nil
 
 	case <-timeoutCtx.Done():
-		return nil, api.NewNodeError(node.ID, "code", "execution timeout exceeded")
+		return nil, api.NewNodeErrorWithCause(node.ID, "code", "execution timeout exceeded", api.ErrorCodeTimeout, timeoutCtx.Err())
 	}
 }
 
@@ -35,6 +35,7 @@ import (
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/variable_get"
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/variable_list"
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/variable_set"
+	_ "github.com/cedricziel/mel-agent/pkg/nodes/wait_for_signal"
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/webhook"
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/workflow_call"
 	_ "github.com/cedricziel/mel-agent/pkg/nodes/workflow_return"
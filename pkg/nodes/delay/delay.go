@@ -1,11 +1,18 @@
 package delay
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
 )
 
+// Delay modes
+const (
+	modeDuration = "duration"
+	modeUntil    = "until"
+)
+
 // delayDefinition provides the built-in "Delay" node.
 type delayDefinition struct{}
 
@@ -16,26 +23,63 @@ func (delayDefinition) Meta() api.NodeType {
 		Label:    "Delay",
 		Category: "Control",
 		Parameters: []api.ParameterDefinition{
-			api.NewNumberParameter("duration", "Duration (ms)", true).
+			api.NewEnumParameter("mode", "Mode", []string{modeDuration, modeUntil}, false).
+				WithDefault(modeDuration).
+				WithGroup("Settings").
+				WithDescription("Wait for a duration or until a point in time"),
+			api.NewNumberParameter("duration", "Duration (ms)", false).
 				WithDefault(1000).
 				WithGroup("Settings").
+				WithVisibilityCondition("mode=='duration'").
 				WithDescription("Duration to pause execution in milliseconds"),
+			api.NewStringParameter("until", "Wait Until", false).
+				WithGroup("Settings").
+				WithVisibilityCondition("mode=='until'").
+				WithDescription("RFC 3339 timestamp to pause execution until, e.g. 2025-01-01T09:00:00Z"),
 		},
 	}
 }
 
-// ExecuteEnvelope pauses execution for the specified duration using envelopes.
+// ExecuteEnvelope passes the envelope on and records until when the workflow
+// pauses. The node does not sleep: the execution engine holds back the
+// following nodes until the wait is over, without occupying a worker.
 func (d delayDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
-	dur, ok := node.Data["duration"].(float64)
-	if ok {
-		time.Sleep(time.Duration(dur) * time.Millisecond)
+	waitUntil, err := waitUntil(node, time.Now())
+	if err != nil {
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, err.Error(), api.ErrorCodeValidation)
 	}
 
 	result := envelope.Clone()
 	result.Trace = envelope.Trace.Next(node.ID)
+	result.SetMeta(api.MetaWaitUntil, waitUntil.UTC().Format(time.RFC3339Nano))
 	return result, nil
 }
 
+// waitUntil returns the time the workflow waits for
+func waitUntil(node api.Node, now time.Time) (time.Time, error) {
+	mode, _ := node.Data["mode"].(string)
+	switch mode {
+	case "", modeDuration:
+		dur, _ := node.Data["duration"].(float64)
+		if dur < 0 {
+			dur = 0
+		}
+		return now.Add(time.Duration(dur) * time.Millisecond), nil
+	case modeUntil:
+		until, _ := node.Data["until"].(string)
+		if until == "" {
+			return time.Time{}, fmt.Errorf("delay: missing until parameter")
+		}
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("delay: invalid until timestamp %q: %w", until, err)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("delay: unknown mode %q", mode)
+	}
+}
+
 func (delayDefinition) Initialize(mel api.Mel) error {
 	// No initialization needed for this node.
 	return nil
@@ -21,23 +21,36 @@ func TestDelayDefinition_Meta(t *testing.T) {
 	if meta.Category != "Control" {
 		t.Errorf("expected category 'Control', got %s", meta.Category)
 	}
-	if len(meta.Parameters) != 1 {
-		t.Errorf("expected 1 parameter, got %d", len(meta.Parameters))
+	if len(meta.Parameters) != 3 {
+		t.Fatalf("expected 3 parameters, got %d", len(meta.Parameters))
 	}
 
-	param := meta.Parameters[0]
+	mode := meta.Parameters[0]
+	if mode.Name != "mode" {
+		t.Errorf("expected parameter name 'mode', got %s", mode.Name)
+	}
+	if mode.Default != modeDuration {
+		t.Errorf("expected default mode %q, got %v", modeDuration, mode.Default)
+	}
+
+	param := meta.Parameters[1]
 	if param.Name != "duration" {
 		t.Errorf("expected parameter name 'duration', got %s", param.Name)
 	}
 	if param.Type != "number" {
 		t.Errorf("expected parameter type 'number', got %s", param.Type)
 	}
-	if !param.Required {
-		t.Error("expected parameter to be required")
-	}
 	if param.Default != 1000 {
 		t.Errorf("expected default value 1000, got %v", param.Default)
 	}
+
+	until := meta.Parameters[2]
+	if until.Name != "until" {
+		t.Errorf("expected parameter name 'until', got %s", until.Name)
+	}
+	if until.VisibilityCondition != "mode=='until'" {
+		t.Errorf("expected until to be visible in until mode, got %q", until.VisibilityCondition)
+	}
 }
 
 func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
@@ -53,8 +66,8 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 		node           api.Node
 		input          interface{}
 		expectedOutput interface{}
-		minDuration    time.Duration
-		maxDuration    time.Duration
+		minWait        time.Duration
+		maxWait        time.Duration
 	}{
 		{
 			name: "valid duration",
@@ -62,13 +75,13 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 				ID:   "delay-node",
 				Type: "delay",
 				Data: map[string]interface{}{
-					"duration": float64(100),
+					"duration": float64(60000),
 				},
 			},
 			input:          "test input",
 			expectedOutput: "test input",
-			minDuration:    90 * time.Millisecond,
-			maxDuration:    150 * time.Millisecond,
+			minWait:        59 * time.Second,
+			maxWait:        61 * time.Second,
 		},
 		{
 			name: "zero duration",
@@ -81,8 +94,8 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 			},
 			input:          "test input",
 			expectedOutput: "test input",
-			minDuration:    0,
-			maxDuration:    10 * time.Millisecond,
+			minWait:        -time.Second,
+			maxWait:        time.Second,
 		},
 		{
 			name: "missing duration",
@@ -93,8 +106,8 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 			},
 			input:          "test input",
 			expectedOutput: "test input",
-			minDuration:    0,
-			maxDuration:    10 * time.Millisecond,
+			minWait:        -time.Second,
+			maxWait:        time.Second,
 		},
 		{
 			name: "invalid duration type",
@@ -107,8 +120,23 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 			},
 			input:          "test input",
 			expectedOutput: "test input",
-			minDuration:    0,
-			maxDuration:    10 * time.Millisecond,
+			minWait:        -time.Second,
+			maxWait:        time.Second,
+		},
+		{
+			name: "until timestamp",
+			node: api.Node{
+				ID:   "delay-node",
+				Type: "delay",
+				Data: map[string]interface{}{
+					"mode":  "until",
+					"until": time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339),
+				},
+			},
+			input:          "test input",
+			expectedOutput: "test input",
+			minWait:        47 * time.Hour,
+			maxWait:        49 * time.Hour,
 		},
 	}
 
@@ -142,11 +170,21 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 				t.Errorf("ExecuteEnvelope() output = %v, expected %v", outputEnvelope.Data, tt.expectedOutput)
 			}
 
-			if duration < tt.minDuration {
-				t.Errorf("ExecuteEnvelope() duration %v is less than expected minimum %v", duration, tt.minDuration)
+			// The node must not block the worker
+			if duration > 100*time.Millisecond {
+				t.Errorf("ExecuteEnvelope() took %v, expected it to return immediately", duration)
 			}
-			if duration > tt.maxDuration {
-				t.Errorf("ExecuteEnvelope() duration %v is greater than expected maximum %v", duration, tt.maxDuration)
+
+			value, ok := outputEnvelope.GetMeta(api.MetaWaitUntil)
+			if !ok {
+				t.Fatal("ExecuteEnvelope() did not record until when to wait")
+			}
+			waitUntil, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				t.Fatalf("invalid wait until timestamp %q: %v", value, err)
+			}
+			if wait := time.Until(waitUntil); wait < tt.minWait || wait > tt.maxWait {
+				t.Errorf("expected a wait between %v and %v, got %v", tt.minWait, tt.maxWait, wait)
 			}
 
 			// Verify trace is properly updated
@@ -157,6 +195,28 @@ func TestDelayDefinition_ExecuteEnvelope(t *testing.T) {
 	}
 }
 
+func TestDelayDefinition_ExecuteEnvelopeInvalidUntil(t *testing.T) {
+	def := delayDefinition{}
+
+	for _, data := range []map[string]interface{}{
+		{"mode": "until"},
+		{"mode": "until", "until": "tomorrow"},
+		{"mode": "forever"},
+	} {
+		node := api.Node{ID: "delay-node", Type: "delay", Data: data}
+		input := core.NewEnvelope[interface{}]("test input", api.Trace{NodeID: node.ID})
+
+		_, err := def.ExecuteEnvelope(api.ExecutionContext{}, node, input)
+		if err == nil {
+			t.Errorf("expected an error for %v", data)
+			continue
+		}
+		if code := api.ErrorCode(err); code != api.ErrorCodeValidation {
+			t.Errorf("expected a validation error for %v, got %q", data, code)
+		}
+	}
+}
+
 func TestDelayDefinition_Initialize(t *testing.T) {
 	def := delayDefinition{}
 	err := def.Initialize(nil)
@@ -1,7 +1,6 @@
 package httprequest
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,6 +11,13 @@ import (
 	"github.com/cedricziel/mel-agent/pkg/api"
 )
 
+// Values of the failOnStatus parameter
+const (
+	failOnServerErrors = "server"
+	failOnAllErrors    = "all"
+	failOnNever        = "never"
+)
+
 type httpRequestDefinition struct{}
 
 func (httpRequestDefinition) Meta() api.NodeType {
@@ -64,6 +70,10 @@ func (httpRequestDefinition) Meta() api.NodeType {
 			api.NewBooleanParameter("verifySSL", "Verify SSL", false).
 				WithDefault(true).
 				WithGroup("Advanced"),
+			api.NewEnumParameter("failOnStatus", "Fail on Status", []string{failOnServerErrors, failOnAllErrors, failOnNever}, false).
+				WithDefault(failOnServerErrors).
+				WithGroup("Advanced").
+				WithDescription("Error statuses that fail the step: server (5xx, retried), all (4xx and 5xx) or never. Other statuses are returned as data"),
 		},
 	}
 }
@@ -77,7 +87,7 @@ func (d httpRequestDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node ap
 	// Get required parameters
 	url, ok := config["url"].(string)
 	if !ok || url == "" {
-		return nil, fmt.Errorf("url is required")
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, "url is required", api.ErrorCodeValidation)
 	}
 
 	method, ok := config["method"].(string)
@@ -171,9 +181,28 @@ func (d httpRequestDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node ap
 		Timeout: time.Duration(timeout) * time.Second,
 	}
 
-	httpResp, err := ctx.Mel.HTTPRequest(context.Background(), httpReq)
+	httpResp, err := ctx.Mel.HTTPRequest(ctx.GoContext(), httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		code := api.ErrorCode(err)
+		if code == "" {
+			code = api.ErrorCodeNetwork
+		}
+		return nil, api.NewNodeErrorWithCause(node.ID, node.Type, fmt.Sprintf("request failed: %v", err), code, err)
+	}
+
+	// By default only 5xx responses fail the step, so that the retry policy
+	// retries them. 4xx responses are returned as data for the workflow to
+	// handle, unless all error statuses fail the step.
+	failOnStatus, _ := config["failOnStatus"].(string)
+	if failOnStatus == "" {
+		failOnStatus = failOnServerErrors
+	}
+	if (failOnStatus == failOnAllErrors && httpResp.StatusCode >= 400) ||
+		(failOnStatus == failOnServerErrors && httpResp.StatusCode >= 500) {
+		if code := api.ErrorCodeForStatus(httpResp.StatusCode); code != "" {
+			message := fmt.Sprintf("request failed with status %d", httpResp.StatusCode)
+			return nil, api.NewNodeErrorWithCode(node.ID, node.Type, message, code)
+		}
 	}
 
 	// Try to parse response as JSON, fallback to string
@@ -638,6 +638,61 @@ func TestHttpRequestExecuteEnvelope_NonJSONResponse(t *testing.T) {
 	}
 }
 
+func TestHttpRequestExecuteEnvelope_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		config    map[string]interface{}
+		wantCode  string
+		wantError bool
+	}{
+		{name: "server error fails by default", status: http.StatusServiceUnavailable, wantCode: api.ErrorCodeUpstream, wantError: true},
+		{name: "client error returned by default", status: http.StatusBadRequest},
+		{name: "client error fails with all", status: http.StatusBadRequest, config: map[string]interface{}{"failOnStatus": "all"}, wantCode: api.ErrorCodeBadRequest, wantError: true},
+		{name: "not found fails with all", status: http.StatusNotFound, config: map[string]interface{}{"failOnStatus": "all"}, wantCode: api.ErrorCodeNotFound, wantError: true},
+		{name: "server error fails with all", status: http.StatusBadGateway, config: map[string]interface{}{"failOnStatus": "all"}, wantCode: api.ErrorCodeUpstream, wantError: true},
+		{name: "server error returned with never", status: http.StatusServiceUnavailable, config: map[string]interface{}{"failOnStatus": "never"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			data := map[string]interface{}{"url": server.URL, "method": "GET"}
+			for key, value := range tt.config {
+				data[key] = value
+			}
+
+			def := httpRequestDefinition{}
+			ctx := api.ExecutionContext{AgentID: "test-agent", RunID: "test-run", Mel: api.NewMel()}
+			node := api.Node{ID: "test-node", Type: "http_request", Data: data}
+			inputEnvelope := core.NewEnvelope(interface{}(nil), api.Trace{NodeID: node.ID})
+
+			outputEnvelope, err := def.ExecuteEnvelope(ctx, node, inputEnvelope)
+			if !tt.wantError {
+				if err != nil {
+					t.Fatalf("ExecuteEnvelope failed: %v", err)
+				}
+				resultMap := outputEnvelope.Data.(map[string]interface{})
+				if resultMap["status"] != tt.status {
+					t.Errorf("Expected status %d, got %v", tt.status, resultMap["status"])
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("Expected error for error status")
+			}
+			if code := api.ErrorCode(err); code != tt.wantCode {
+				t.Errorf("Expected error code %q, got %q", tt.wantCode, code)
+			}
+		})
+	}
+}
+
 func TestHttpRequestInitialize(t *testing.T) {
 	def := httpRequestDefinition{}
 	err := def.Initialize(nil)
@@ -1,6 +1,9 @@
 package http_response
 
 import (
+	"encoding/json"
+	"fmt"
+
 	api "github.com/cedricziel/mel-agent/pkg/api"
 )
 
@@ -15,15 +18,47 @@ func (httpResponseDefinition) Meta() api.NodeType {
 		Category: "Integration",
 		Parameters: []api.ParameterDefinition{
 			api.NewNumberParameter("statusCode", "Status Code", true).WithDefault(200).WithGroup("Settings"),
-			api.NewStringParameter("body", "Body", false).WithDefault("").WithGroup("Settings"),
+			api.NewObjectParameter("headers", "Headers", false).WithGroup("Settings").WithDescription("Response headers"),
+			api.NewStringParameter("body", "Body", false).WithDefault("").WithGroup("Settings").WithDescription("Response body; the input data is sent as JSON when empty"),
 		},
 	}
 }
 
-// ExecuteEnvelope returns the input unchanged (response handled elsewhere).
+// ExecuteEnvelope records the response to the request that started the run
+// and passes the input on unchanged.
 func (d httpResponseDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
+	response := api.RunResponse{StatusCode: 200, Body: envelope.Data}
+
+	switch code := node.Data["statusCode"].(type) {
+	case float64:
+		response.StatusCode = int(code)
+	case int:
+		response.StatusCode = code
+	}
+	if response.StatusCode < 100 || response.StatusCode > 599 {
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type,
+			fmt.Sprintf("invalid status code %d", response.StatusCode), api.ErrorCodeValidation)
+	}
+
+	if headers, ok := node.Data["headers"].(map[string]interface{}); ok && len(headers) > 0 {
+		response.Headers = make(map[string]string, len(headers))
+		for name, value := range headers {
+			response.Headers[name] = fmt.Sprint(value)
+		}
+	}
+
+	if body, ok := node.Data["body"].(string); ok && body != "" {
+		response.Body = body
+	}
+
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		return nil, api.NewNodeErrorWithCause(node.ID, node.Type, "response body is not serializable", api.ErrorCodeValidation, err)
+	}
+
 	result := envelope.Clone()
 	result.Trace = envelope.Trace.Next(node.ID)
+	result.SetMeta(api.MetaHTTPResponse, string(responseJSON))
 	return result, nil
 }
 
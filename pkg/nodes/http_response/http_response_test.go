@@ -0,0 +1,72 @@
+package http_response
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+)
+
+func TestHTTPResponseDefinition_ExecuteEnvelope(t *testing.T) {
+	def := httpResponseDefinition{}
+	inputData := map[string]interface{}{"text": "Deployed"}
+
+	tests := []struct {
+		name    string
+		data    map[string]interface{}
+		want    api.RunResponse
+		wantErr bool
+	}{
+		{
+			name: "input as body",
+			data: map[string]interface{}{"statusCode": float64(201)},
+			want: api.RunResponse{StatusCode: 201, Body: inputData},
+		},
+		{
+			name: "configured body and headers",
+			data: map[string]interface{}{
+				"statusCode": float64(200),
+				"headers":    map[string]interface{}{"Content-Type": "text/plain", "X-Count": float64(3)},
+				"body":       "ok",
+			},
+			want: api.RunResponse{StatusCode: 200, Headers: map[string]string{"Content-Type": "text/plain", "X-Count": "3"}, Body: "ok"},
+		},
+		{
+			name: "default status",
+			data: map[string]interface{}{},
+			want: api.RunResponse{StatusCode: 200, Body: inputData},
+		},
+		{
+			name:    "invalid status",
+			data:    map[string]interface{}{"statusCode": float64(42)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := api.Node{ID: "respond", Type: "http_response", Data: tt.data}
+			envelope := &api.Envelope[interface{}]{Data: inputData, DataType: "object", Trace: api.Trace{NodeID: "previous"}}
+
+			result, err := def.ExecuteEnvelope(api.ExecutionContext{}, node, envelope)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The input is passed on with the response recorded
+			assert.Equal(t, inputData, result.Data)
+			assert.Equal(t, "respond", result.Trace.NodeID)
+
+			responseJSON, ok := result.GetMeta(api.MetaHTTPResponse)
+			require.True(t, ok)
+			var response api.RunResponse
+			require.NoError(t, json.Unmarshal([]byte(responseJSON), &response))
+			assert.Equal(t, tt.want, response)
+		})
+	}
+}
@@ -68,6 +68,9 @@ func (d ifDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, e
 	resultEnvelope.Trace = envelope.Trace.Next(node.ID)
 	resultEnvelope.Data = result
 	resultEnvelope.DataType = "object"
+	if ifResult, ok := result.(*IfResult); ok {
+		resultEnvelope.SetMeta(api.MetaBranch, ifResult.Branch)
+	}
 
 	return resultEnvelope, nil
 }
@@ -155,6 +155,10 @@ func TestIfNode_BasicConditionsExecuteEnvelope(t *testing.T) {
 				t.Errorf("Expected matched %v, got %v", tt.expectedMatch, ifResult.Matched)
 			}
 
+			if branch, ok := outputEnvelope.GetMeta(api.MetaBranch); !ok || branch != tt.expectedBranch {
+				t.Errorf("Expected branch meta %q, got %q", tt.expectedBranch, branch)
+			}
+
 			// Verify trace is properly updated
 			if outputEnvelope.Trace.NodeID != node.ID {
 				t.Errorf("Expected trace NodeID %s, got %s", node.ID, outputEnvelope.Trace.NodeID)
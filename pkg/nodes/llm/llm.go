@@ -1,7 +1,6 @@
 package llm
 
 import (
-	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -44,14 +43,14 @@ func (d llmDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node,
 	// Resolve connection
 	connID, ok := node.Data["connectionId"].(string)
 	if !ok || connID == "" {
-		return nil, errors.New("llm: missing connectionId parameter")
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, "llm: missing connectionId parameter", api.ErrorCodeValidation)
 	}
 	// Load connection secret and config
 	var secretJSON, configJSON []byte
 	err := db.DB.QueryRow(`SELECT secret, config FROM connections WHERE id = $1`, connID).Scan(&secretJSON, &configJSON)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("llm: connection %s not found", connID)
+			return nil, api.NewNodeErrorWithCode(node.ID, node.Type, fmt.Sprintf("llm: connection %s not found", connID), api.ErrorCodeValidation)
 		}
 		return nil, fmt.Errorf("llm: load connection error: %w", err)
 	}
@@ -60,10 +59,10 @@ func (d llmDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node,
 		ApiKey string `json:"apiKey"`
 	}
 	if err := json.Unmarshal(secretJSON, &sec); err != nil {
-		return nil, fmt.Errorf("llm: invalid connection secret: %w", err)
+		return nil, api.NewNodeErrorWithCause(node.ID, node.Type, fmt.Sprintf("llm: invalid connection secret: %v", err), api.ErrorCodeValidation, err)
 	}
 	if sec.ApiKey == "" {
-		return nil, errors.New("llm: apiKey missing in connection secret")
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, "llm: apiKey missing in connection secret", api.ErrorCodeValidation)
 	}
 	// Determine model
 	model, _ := node.Data["model"].(string)
@@ -93,12 +92,12 @@ func (d llmDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node,
 	}
 	// Call OpenAI
 	client := openai.NewClient(sec.ApiKey)
-	resp, err := client.CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{
+	resp, err := client.CreateChatCompletion(ctx.GoContext(), openai.ChatCompletionRequest{
 		Model:    model,
 		Messages: msgs,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("llm: chat completion error: %w", err)
+		return nil, api.NewNodeErrorWithCause(node.ID, node.Type, fmt.Sprintf("llm: chat completion error: %v", err), completionErrorCode(err), err)
 	}
 	if len(resp.Choices) == 0 {
 		return nil, errors.New("llm: no response choices")
@@ -111,6 +110,24 @@ func (d llmDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node,
 	return result, nil
 }
 
+// completionErrorCode classifies a failed chat completion by the HTTP status
+// the provider answered with. Requests that never got an answer are network
+// errors.
+func completionErrorCode(err error) string {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode > 0 {
+		return api.ErrorCodeForStatus(apiErr.HTTPStatusCode)
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) && reqErr.HTTPStatusCode > 0 {
+		return api.ErrorCodeForStatus(reqErr.HTTPStatusCode)
+	}
+	if code := api.ErrorCode(err); code != "" {
+		return code
+	}
+	return api.ErrorCodeNetwork
+}
+
 func (llmDefinition) Initialize(mel api.Mel) error {
 	return nil
 }
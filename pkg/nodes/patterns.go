@@ -0,0 +1,13 @@
+package nodes
+
+import (
+	api "github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+)
+
+// Register the split and aggregate pattern nodes, which the durable engine
+// fans out and in across workflow steps.
+func init() {
+	api.RegisterNodeDefinition(&core.SplitterNode{})
+	api.RegisterNodeDefinition(core.NewAggregatorNode())
+}
@@ -1,9 +1,17 @@
 package switch_node
 
 import (
+	"fmt"
+	"strings"
+
 	api "github.com/cedricziel/mel-agent/pkg/api"
 )
 
+// defaultBranch is selected when the expression does not resolve to a value.
+// Values without an edge of their own take it as well, the engine falls back
+// to it.
+const defaultBranch = api.DefaultBranch
+
 // switchDefinition provides the built-in "Switch" node.
 type switchDefinition struct{}
 
@@ -15,18 +23,53 @@ func (switchDefinition) Meta() api.NodeType {
 		Category:  "Control",
 		Branching: true,
 		Parameters: []api.ParameterDefinition{
-			api.NewStringParameter("expression", "Expression", true).WithGroup("Settings"),
+			api.NewStringParameter("expression", "Expression", true).
+				WithGroup("Settings").
+				WithDescription("Path of the input value that names the branch to take (e.g. 'input.status')"),
 		},
 	}
 }
 
-// ExecuteEnvelope returns the input unchanged (branching logic handled elsewhere).
+// ExecuteEnvelope returns the input unchanged and selects the branch named by
+// the value the expression resolves to.
 func (d switchDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
 	result := envelope.Clone()
 	result.Trace = envelope.Trace.Next(node.ID)
+
+	expression, _ := node.Data["expression"].(string)
+	result.SetMeta(api.MetaBranch, selectBranch(expression, envelope.Data))
 	return result, nil
 }
 
+// selectBranch resolves a dotted path against the input data and returns the
+// value as branch name. Unresolvable paths select the default branch.
+func selectBranch(expression string, data interface{}) string {
+	path := strings.TrimSpace(expression)
+	if path == "" {
+		return defaultBranch
+	}
+
+	current := data
+	for i, part := range strings.Split(path, ".") {
+		if i == 0 && part == "input" {
+			continue
+		}
+
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return defaultBranch
+		}
+		if current, ok = m[part]; !ok {
+			return defaultBranch
+		}
+	}
+
+	if current == nil {
+		return defaultBranch
+	}
+	return fmt.Sprint(current)
+}
+
 func (switchDefinition) Initialize(mel api.Mel) error {
 	return nil
 }
@@ -0,0 +1,86 @@
+package switch_node
+
+import (
+	"testing"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+)
+
+func TestSwitchNode_SelectsBranch(t *testing.T) {
+	def := switchDefinition{}
+
+	tests := []struct {
+		name           string
+		expression     string
+		input          interface{}
+		expectedBranch string
+	}{
+		{
+			name:           "top level value",
+			expression:     "input.status",
+			input:          map[string]interface{}{"status": "approved"},
+			expectedBranch: "approved",
+		},
+		{
+			name:           "path without input prefix",
+			expression:     "order.type",
+			input:          map[string]interface{}{"order": map[string]interface{}{"type": "express"}},
+			expectedBranch: "express",
+		},
+		{
+			name:           "numeric value",
+			expression:     "input.code",
+			input:          map[string]interface{}{"code": 2},
+			expectedBranch: "2",
+		},
+		{
+			name:           "missing value",
+			expression:     "input.missing",
+			input:          map[string]interface{}{"status": "approved"},
+			expectedBranch: "default",
+		},
+		{
+			name:           "non object input",
+			expression:     "input.status",
+			input:          "plain",
+			expectedBranch: "default",
+		},
+		{
+			name:           "empty expression",
+			expression:     "",
+			input:          map[string]interface{}{"status": "approved"},
+			expectedBranch: "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := api.Node{
+				ID:   "switch-1",
+				Type: "switch",
+				Data: map[string]interface{}{"expression": tt.expression},
+			}
+			ctx := api.ExecutionContext{AgentID: "test-agent", RunID: "test-run"}
+			trace := api.Trace{AgentID: ctx.AgentID, RunID: ctx.RunID, NodeID: node.ID, Step: node.ID, Attempt: 1}
+			inputEnvelope := core.NewEnvelope(tt.input, trace)
+
+			outputEnvelope, err := def.ExecuteEnvelope(ctx, node, inputEnvelope)
+			if err != nil {
+				t.Fatalf("ExecuteEnvelope() error = %v", err)
+			}
+
+			branch, ok := outputEnvelope.GetMeta(api.MetaBranch)
+			if !ok {
+				t.Fatal("Expected branch meta to be set")
+			}
+			if branch != tt.expectedBranch {
+				t.Errorf("Expected branch %q, got %q", tt.expectedBranch, branch)
+			}
+
+			if outputEnvelope.Trace.NodeID != node.ID {
+				t.Errorf("Expected trace NodeID %s, got %s", node.ID, outputEnvelope.Trace.NodeID)
+			}
+		})
+	}
+}
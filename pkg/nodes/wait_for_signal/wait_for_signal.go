@@ -0,0 +1,85 @@
+package wait_for_signal
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+)
+
+// signalNamePattern restricts signal names to characters that are safe in the
+// resume URL
+var signalNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// waitForSignalDefinition provides the built-in "Wait for Signal" node.
+type waitForSignalDefinition struct{}
+
+// Meta returns metadata for the Wait for Signal node.
+func (waitForSignalDefinition) Meta() api.NodeType {
+	return api.NodeType{
+		Type:      "wait_for_signal",
+		Label:     "Wait for Signal",
+		Category:  "Control",
+		Branching: true,
+		Parameters: []api.ParameterDefinition{
+			api.NewStringParameter("signal", "Signal", true).
+				WithDefault("approval").
+				WithGroup("Settings").
+				WithDescription("Name of the signal to wait for, e.g. approval"),
+			api.NewNumberParameter("timeout", "Timeout (s)", false).
+				WithDefault(0).
+				WithGroup("Settings").
+				WithDescription("Seconds to wait before taking the timeout branch, 0 waits indefinitely"),
+		},
+	}
+}
+
+// ExecuteEnvelope passes the envelope on and records the signal the workflow
+// waits for. The execution engine parks the run until the signal is sent,
+// then continues with its payload on the signal branch, or with this
+// envelope on the timeout branch.
+func (d waitForSignalDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
+	signal, timeout, err := waitSettings(node)
+	if err != nil {
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, err.Error(), api.ErrorCodeValidation)
+	}
+
+	result := envelope.Clone()
+	result.Trace = envelope.Trace.Next(node.ID)
+	result.SetMeta(api.MetaWaitSignal, signal)
+	if timeout > 0 {
+		result.SetMeta(api.MetaWaitUntil, time.Now().Add(timeout).UTC().Format(time.RFC3339Nano))
+	}
+	return result, nil
+}
+
+// waitSettings returns the signal the node waits for and its timeout, zero
+// for none
+func waitSettings(node api.Node) (string, time.Duration, error) {
+	signal, _ := node.Data["signal"].(string)
+	if signal == "" {
+		return "", 0, fmt.Errorf("wait_for_signal: missing signal parameter")
+	}
+	if !signalNamePattern.MatchString(signal) {
+		return "", 0, fmt.Errorf("wait_for_signal: invalid signal name %q", signal)
+	}
+
+	timeout, _ := node.Data["timeout"].(float64)
+	if timeout < 0 {
+		return "", 0, fmt.Errorf("wait_for_signal: timeout must not be negative")
+	}
+	return signal, time.Duration(timeout * float64(time.Second)), nil
+}
+
+func (waitForSignalDefinition) Initialize(mel api.Mel) error {
+	// No initialization needed for this node.
+	return nil
+}
+
+func init() {
+	api.RegisterNodeDefinition(waitForSignalDefinition{})
+}
+
+// assert that waitForSignalDefinition implements the interface
+var _ api.NodeDefinition = (*waitForSignalDefinition)(nil)
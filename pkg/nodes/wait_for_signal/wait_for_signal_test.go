@@ -0,0 +1,104 @@
+package wait_for_signal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/cedricziel/mel-agent/pkg/core"
+)
+
+func TestWaitForSignalDefinition_Meta(t *testing.T) {
+	meta := waitForSignalDefinition{}.Meta()
+
+	if meta.Type != "wait_for_signal" {
+		t.Errorf("expected type 'wait_for_signal', got %s", meta.Type)
+	}
+	if !meta.Branching {
+		t.Error("expected the node to branch into its signal and timeout outputs")
+	}
+	if len(meta.Parameters) != 2 {
+		t.Fatalf("expected 2 parameters, got %d", len(meta.Parameters))
+	}
+	if meta.Parameters[0].Name != "signal" || !meta.Parameters[0].Required {
+		t.Errorf("expected a required signal parameter, got %+v", meta.Parameters[0])
+	}
+	if meta.Parameters[1].Name != "timeout" {
+		t.Errorf("expected parameter name 'timeout', got %s", meta.Parameters[1].Name)
+	}
+}
+
+func TestWaitForSignalDefinition_ExecuteEnvelope(t *testing.T) {
+	def := waitForSignalDefinition{}
+	ctx := api.ExecutionContext{AgentID: "test-agent", RunID: "test-run"}
+	input := core.NewEnvelope[interface{}]("draft", api.Trace{RunID: ctx.RunID, NodeID: "draft"})
+
+	t.Run("without timeout", func(t *testing.T) {
+		node := api.Node{ID: "approve", Type: "wait_for_signal", Data: map[string]interface{}{"signal": "approval"}}
+
+		output, err := def.ExecuteEnvelope(ctx, node, input)
+		if err != nil {
+			t.Fatalf("ExecuteEnvelope() error = %v", err)
+		}
+		if output.Data != "draft" {
+			t.Errorf("expected the input to be passed on, got %v", output.Data)
+		}
+		if signal, _ := output.GetMeta(api.MetaWaitSignal); signal != "approval" {
+			t.Errorf("expected to wait for signal 'approval', got %q", signal)
+		}
+		if _, ok := output.GetMeta(api.MetaWaitUntil); ok {
+			t.Error("expected no timeout to be recorded")
+		}
+		if output.Trace.NodeID != "approve" {
+			t.Errorf("expected trace NodeID 'approve', got %s", output.Trace.NodeID)
+		}
+	})
+
+	t.Run("with timeout", func(t *testing.T) {
+		node := api.Node{ID: "approve", Type: "wait_for_signal", Data: map[string]interface{}{
+			"signal":  "approval",
+			"timeout": float64(3600),
+		}}
+
+		output, err := def.ExecuteEnvelope(ctx, node, input)
+		if err != nil {
+			t.Fatalf("ExecuteEnvelope() error = %v", err)
+		}
+
+		value, ok := output.GetMeta(api.MetaWaitUntil)
+		if !ok {
+			t.Fatal("expected the timeout to be recorded")
+		}
+		waitUntil, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			t.Fatalf("invalid wait until timestamp %q: %v", value, err)
+		}
+		if wait := time.Until(waitUntil); wait < 59*time.Minute || wait > 61*time.Minute {
+			t.Errorf("expected the wait to time out in an hour, got %v", wait)
+		}
+	})
+}
+
+func TestWaitForSignalDefinition_ExecuteEnvelopeInvalid(t *testing.T) {
+	def := waitForSignalDefinition{}
+	input := core.NewEnvelope[interface{}]("draft", api.Trace{})
+
+	tests := map[string]map[string]interface{}{
+		"missing signal":   {},
+		"invalid signal":   {"signal": "approve/reject"},
+		"negative timeout": {"signal": "approval", "timeout": float64(-1)},
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			node := api.Node{ID: "approve", Type: "wait_for_signal", Data: data}
+			_, err := def.ExecuteEnvelope(api.ExecutionContext{}, node, input)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if code := api.ErrorCode(err); code != api.ErrorCodeValidation {
+				t.Errorf("expected a validation error, got %q", code)
+			}
+		})
+	}
+}
@@ -20,6 +20,9 @@ func (webhookDefinition) Meta() api.NodeType {
 			api.NewEnumParameter("mode", "Mode", []string{"async", "sync"}, true).WithDefault("async").WithGroup("Execution").WithDescription("Async (enqueue run) or Sync (inline) execution"),
 			api.NewNumberParameter("statusCode", "Response Status", false).WithDefault(202).WithGroup("Response").WithVisibilityCondition("mode=='sync'").WithDescription("HTTP status code returned by trigger"),
 			api.NewStringParameter("responseBody", "Response Body", false).WithDefault("").WithGroup("Response").WithVisibilityCondition("mode=='sync'").WithDescription("HTTP body returned by trigger"),
+			api.NewNumberParameter("responseTimeout", "Response Timeout", false).WithDefault(30).WithGroup("Response").WithVisibilityCondition("mode=='sync'").WithDescription("Seconds to wait for an HTTP Response node or the end of the run"),
+			api.NewStringParameter("idempotencyHeader", "Idempotency Header", false).WithDefault("").WithGroup("Idempotency").WithDescription("Request header holding the delivery ID; repeated deliveries return the existing run"),
+			api.NewStringParameter("idempotencyPath", "Idempotency Body Path", false).WithDefault("").WithGroup("Idempotency").WithDescription("Dot-separated path to the delivery ID in a JSON body"),
 		},
 	}
 }
@@ -21,7 +21,7 @@ func TestWebhookDefinition_Meta(t *testing.T) {
 	assert.True(t, meta.EntryPoint, "webhook should be marked as an entry point")
 
 	// Test parameters
-	assert.Len(t, meta.Parameters, 5)
+	assert.Len(t, meta.Parameters, 8)
 
 	// Check parameter names and properties
 	paramMap := make(map[string]api.ParameterDefinition)
@@ -78,6 +78,21 @@ func TestWebhookDefinition_Meta(t *testing.T) {
 	assert.Equal(t, "", responseBodyParam.Default)
 	assert.Equal(t, "Response", responseBodyParam.Group)
 	assert.Equal(t, "mode=='sync'", responseBodyParam.VisibilityCondition)
+
+	// Test responseTimeout parameter
+	responseTimeoutParam, exists := paramMap["responseTimeout"]
+	require.True(t, exists)
+	assert.Equal(t, 30, responseTimeoutParam.Default)
+	assert.Equal(t, "Response", responseTimeoutParam.Group)
+	assert.Equal(t, "mode=='sync'", responseTimeoutParam.VisibilityCondition)
+
+	// Test idempotency parameters
+	for _, name := range []string{"idempotencyHeader", "idempotencyPath"} {
+		param, exists := paramMap[name]
+		require.True(t, exists, name)
+		assert.False(t, param.Required)
+		assert.Equal(t, "Idempotency", param.Group)
+	}
 }
 
 func TestWebhookDefinition_ExecuteEnvelope(t *testing.T) {
@@ -1,12 +1,12 @@
 package workflow_call
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
+	"github.com/google/uuid"
 )
 
 type workflowCallDefinition struct{}
@@ -46,11 +46,20 @@ func (workflowCallDefinition) Meta() api.NodeType {
 	}
 }
 
-// ExecuteEnvelope calls another workflow and optionally waits for response.
+// ExecuteEnvelope calls another workflow with the call data. The execution
+// engine starts the workflow as a child run of the step. In sync mode the
+// step waits until the child returns and continues with the returned data,
+// or with the call data on the timeout branch once the timeout passed. The
+// step fails with the error of the child when it fails, is cancelled or times
+// out, and the child runs no longer than the step waits.
 func (d workflowCallDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
 	targetWorkflowId, ok := node.Data["targetWorkflowId"].(string)
 	if !ok || targetWorkflowId == "" {
-		return nil, fmt.Errorf("targetWorkflowId is required")
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type, "targetWorkflowId is required", api.ErrorCodeValidation)
+	}
+	if _, err := uuid.Parse(targetWorkflowId); err != nil {
+		return nil, api.NewNodeErrorWithCode(node.ID, node.Type,
+			fmt.Sprintf("invalid targetWorkflowId %q", targetWorkflowId), api.ErrorCodeValidation)
 	}
 
 	callMode, _ := node.Data["callMode"].(string)
@@ -88,38 +97,14 @@ func (d workflowCallDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node a
 		callData["sourceTrace"] = envelope.Trace
 	}
 
-	// Use the platform's workflow calling capability
-	workflowReq := api.WorkflowCallRequest{
-		TargetWorkflowID: targetWorkflowId,
-		CallData:         callData,
-		CallMode:         callMode,
-		TimeoutSeconds:   int(timeoutSeconds),
-		SourceContext:    ctx,
-	}
-
-	response, err := ctx.Mel.CallWorkflow(context.Background(), workflowReq)
-	if err != nil {
-		return nil, fmt.Errorf("workflow call failed: %w", err)
-	}
-
 	result := envelope.Clone()
 	result.Trace = envelope.Trace.Next(node.ID)
 	result.DataType = "object"
-	result.Data = map[string]interface{}{
-		"callInfo": map[string]interface{}{
-			"callId":           response.CallID,
-			"targetWorkflowId": targetWorkflowId,
-			"callMode":         callMode,
-			"calledAt":         time.Now().Format(time.RFC3339),
-			"sourceWorkflow":   ctx.AgentID,
-			"sourceRun":        ctx.RunID,
-			"sourceNode":       node.ID,
-			"status":           response.Status,
-			"completedAt":      response.CompletedAt.Format(time.RFC3339),
-		},
-		"response": response.Data,
-		"message":  response.Message,
-		"success":  response.Status == "success" || response.Status == "sent",
+	result.Data = callData
+	result.SetMeta(api.MetaCallWorkflow, targetWorkflowId)
+	if callMode == "sync" {
+		result.SetMeta(api.MetaWaitSignal, api.WorkflowReturnSignal)
+		result.SetMeta(api.MetaWaitUntil, time.Now().Add(time.Duration(timeoutSeconds*float64(time.Second))).UTC().Format(time.RFC3339Nano))
 	}
 
 	return result, nil
@@ -1,9 +1,6 @@
 package workflow_call
 
 import (
-	"encoding/json"
-	"net/http"
-	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -32,25 +29,12 @@ func TestWorkflowCallDefinition_Meta(t *testing.T) {
 }
 
 func TestWorkflowCallDefinition_ExecuteEnvelope(t *testing.T) {
-	// Create a mock server for workflow calls
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response := map[string]interface{}{
-			"runId":   "test-run-123",
-			"status":  "triggered",
-			"message": "Workflow started successfully",
-		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
-	}))
-	defer server.Close()
-
 	def := workflowCallDefinition{}
 
 	// Test with missing targetWorkflowId
 	ctx := api.ExecutionContext{
 		AgentID: "test-agent",
 		RunID:   "test-run",
-		Mel:     api.NewMelWithConfig(30*time.Second, server.URL), // Use mock server
 	}
 
 	node := api.Node{
@@ -79,35 +63,68 @@ func TestWorkflowCallDefinition_ExecuteEnvelope(t *testing.T) {
 		t.Error("Expected error for missing targetWorkflowId")
 	}
 
-	// Test with valid targetWorkflowId
 	node.Data["targetWorkflowId"] = "target-workflow-123"
+	_, err = def.ExecuteEnvelope(ctx, node, envelope)
+	if err == nil {
+		t.Error("Expected error for invalid targetWorkflowId")
+	}
+
+	// Test with valid targetWorkflowId
+	targetID := "5f0c2e4e-8f4a-4b43-9a38-1d0c7f1e2a11"
+	node.Data["targetWorkflowId"] = targetID
 	node.Data["callMode"] = "async"
+	node.Data["callData"] = `{"order": 42}`
+	node.Data["passCurrentData"] = true
 
 	result, err := def.ExecuteEnvelope(ctx, node, envelope)
 	if err != nil {
-		t.Errorf("Unexpected error: %v", err)
-	}
-
-	if result == nil {
-		t.Error("Expected result to be non-nil")
+		t.Fatalf("Unexpected error: %v", err)
 	}
 
 	if result.DataType != "object" {
 		t.Errorf("Expected DataType 'object', got '%s'", result.DataType)
 	}
 
-	// Check result data structure
-	if data, ok := result.Data.(map[string]interface{}); ok {
-		if callInfo, exists := data["callInfo"]; !exists {
-			t.Error("Expected callInfo in result data")
-		} else if callInfoMap, ok := callInfo.(map[string]interface{}); ok {
-			if targetId, exists := callInfoMap["targetWorkflowId"]; !exists {
-				t.Error("Expected targetWorkflowId in callInfo")
-			} else if targetId != "target-workflow-123" {
-				t.Errorf("Expected targetWorkflowId 'target-workflow-123', got '%v'", targetId)
-			}
-		}
-	} else {
-		t.Error("Expected result data to be a map")
+	// The engine starts the target workflow with the call data
+	if target, ok := result.GetMeta(api.MetaCallWorkflow); !ok || target != targetID {
+		t.Errorf("Expected call of workflow '%s', got '%s'", targetID, target)
+	}
+	if _, waiting := result.GetMeta(api.MetaWaitSignal); waiting {
+		t.Error("Expected async call not to wait")
+	}
+
+	data, ok := result.Data.(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected result data to be a map")
+	}
+	if data["order"] != float64(42) {
+		t.Errorf("Expected order 42 in call data, got '%v'", data["order"])
+	}
+	if data["sourceRunId"] != ctx.RunID {
+		t.Errorf("Expected sourceRunId '%s', got '%v'", ctx.RunID, data["sourceRunId"])
+	}
+
+	// Sync calls wait for the called workflow to return until the timeout
+	node.Data["callMode"] = "sync"
+	node.Data["timeoutSeconds"] = float64(60)
+
+	result, err = def.ExecuteEnvelope(ctx, node, envelope)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if signal, ok := result.GetMeta(api.MetaWaitSignal); !ok || signal != api.WorkflowReturnSignal {
+		t.Errorf("Expected wait for signal '%s', got '%s'", api.WorkflowReturnSignal, signal)
+	}
+	waitUntil, ok := result.GetMeta(api.MetaWaitUntil)
+	if !ok {
+		t.Fatal("Expected sync call to time out")
+	}
+	deadline, err := time.Parse(time.RFC3339Nano, waitUntil)
+	if err != nil {
+		t.Fatalf("Invalid wait deadline: %v", err)
+	}
+	if d := time.Until(deadline); d < 55*time.Second || d > 65*time.Second {
+		t.Errorf("Expected timeout in about 60s, got %v", d)
 	}
 }
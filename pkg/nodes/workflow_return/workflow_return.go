@@ -1,9 +1,7 @@
 package workflow_return
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/cedricziel/mel-agent/pkg/api"
@@ -35,20 +33,17 @@ func (workflowReturnDefinition) Meta() api.NodeType {
 				WithDefault("").
 				WithGroup("Response").
 				WithDescription("Optional message to include with the response"),
-			api.NewBooleanParameter("terminateWorkflow", "Terminate Workflow", false).
-				WithDefault(true).
-				WithGroup("Execution").
-				WithDescription("Whether to stop the current workflow after returning"),
 		},
 	}
 }
 
-// ExecuteEnvelope returns data to a calling workflow and optionally terminates current execution.
+// ExecuteEnvelope returns data to the calling workflow. When the run was
+// started by a workflow_call node, the execution engine resumes the waiting
+// call with the data of the returned envelope.
 func (d workflowReturnDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node api.Node, envelope *api.Envelope[interface{}]) (*api.Envelope[interface{}], error) {
 	returnCurrentData, _ := node.Data["returnCurrentData"].(bool)
 	returnStatus, _ := node.Data["returnStatus"].(string)
 	returnMessage, _ := node.Data["returnMessage"].(string)
-	terminateWorkflow, _ := node.Data["terminateWorkflow"].(bool)
 
 	if returnStatus == "" {
 		returnStatus = "success"
@@ -75,80 +70,23 @@ func (d workflowReturnDefinition) ExecuteEnvelope(ctx api.ExecutionContext, node
 		returnData["workflowTrace"] = envelope.Trace
 	}
 
-	// Extract calling workflow information from current data
-	var callId string
-	if currentData, ok := envelope.Data.(map[string]interface{}); ok {
-		if id, exists := currentData["callId"]; exists {
-			if idStr, ok := id.(string); ok {
-				callId = idStr
-			}
-		}
+	response := map[string]interface{}{
+		"status":     returnStatus,
+		"data":       returnData,
+		"success":    returnStatus == "success",
+		"returnedAt": time.Now().Format(time.RFC3339),
+		"sourceRun":  ctx.RunID,
+		"sourceNode": node.ID,
 	}
-
-	// Use the platform's workflow return capability if this workflow was called by another workflow
-	if callId != "" {
-		err := ctx.Mel.ReturnToWorkflow(context.Background(), callId, returnData, returnStatus)
-		if err != nil {
-			return nil, fmt.Errorf("workflow return failed: %w", err)
-		}
-
-		result := envelope.Clone()
-		result.Trace = envelope.Trace.Next(node.ID)
-		result.DataType = "object"
-		result.Data = map[string]interface{}{
-			"returnResponse": map[string]interface{}{
-				"returnId":          fmt.Sprintf("return-%d", time.Now().UnixNano()),
-				"returnedAt":        time.Now().Format(time.RFC3339),
-				"sourceWorkflow":    ctx.AgentID,
-				"sourceRun":         ctx.RunID,
-				"sourceNode":        node.ID,
-				"status":            returnStatus,
-				"callId":            callId,
-				"terminateWorkflow": terminateWorkflow,
-			},
-			"data":       returnData,
-			"message":    fmt.Sprintf("Workflow return sent with status: %s", returnStatus),
-			"success":    true,
-			"terminated": terminateWorkflow,
-		}
-
-		if terminateWorkflow {
-			result.Data.(map[string]interface{})["__terminate_workflow"] = true
-		}
-
-		return result, nil
-	}
-
-	// Fallback implementation
-	returnResponse := map[string]interface{}{
-		"returnId":          fmt.Sprintf("return-%d", time.Now().UnixNano()),
-		"returnedAt":        time.Now().Format(time.RFC3339),
-		"sourceWorkflow":    ctx.AgentID,
-		"sourceRun":         ctx.RunID,
-		"sourceNode":        node.ID,
-		"status":            returnStatus,
-		"data":              returnData,
-		"terminateWorkflow": terminateWorkflow,
-		"mode":              "fallback",
-	}
-
 	if returnMessage != "" {
-		returnResponse["message"] = returnMessage
+		response["message"] = returnMessage
 	}
 
 	result := envelope.Clone()
 	result.Trace = envelope.Trace.Next(node.ID)
 	result.DataType = "object"
-	result.Data = map[string]interface{}{
-		"returnResponse": returnResponse,
-		"message":        fmt.Sprintf("Workflow return executed with status: %s (fallback mode)", returnStatus),
-		"success":        returnStatus == "success",
-		"terminated":     terminateWorkflow,
-	}
-
-	if terminateWorkflow {
-		result.Data.(map[string]interface{})["__terminate_workflow"] = true
-	}
+	result.Data = response
+	result.SetMeta(api.MetaReturnStatus, returnStatus)
 
 	return result, nil
 }
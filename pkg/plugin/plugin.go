@@ -1,6 +1,9 @@
 package plugin
 
-import internal "github.com/cedricziel/mel-agent/internal/plugin"
+import (
+	internal "github.com/cedricziel/mel-agent/internal/plugin"
+	"github.com/cedricziel/mel-agent/pkg/execution"
+)
 
 // Plugin is the base interface for all plugins.
 type Plugin = internal.Plugin
@@ -28,6 +31,12 @@ func Register(p Plugin) {
 	internal.Register(p)
 }
 
+// NewWebhookTriggerPlugin creates the webhook trigger plugin starting runs
+// with the given execution engine.
+func NewWebhookTriggerPlugin(engine execution.ExecutionEngine) TriggerPlugin {
+	return internal.NewWebhookTriggerPlugin(engine)
+}
+
 // GetNodePlugin retrieves a NodePlugin by ID.
 func GetNodePlugin(id string) (NodePlugin, bool) {
 	return internal.GetNodePlugin(id)